@@ -0,0 +1,96 @@
+// cmd/migrate はスキーママイグレーションの CLI です
+//
+//	go run ./cmd/migrate up            未適用のマイグレーションをすべて適用
+//	go run ./cmd/migrate down [n]      直近 n 件（省略時 1 件）を取り消し
+//	go run ./cmd/migrate status        適用状況を表示
+//	go run ./cmd/migrate create [-go] <name>  次の番号で空のマイグレーションを作成
+package main
+
+import (
+	"backend/config"
+	"backend/migrations"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+)
+
+const usage = "usage: migrate up | down [n] | status | create [-go] <name>"
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal(usage)
+	}
+
+	// create は DB に接続せずにファイルだけ作る
+	if os.Args[1] == "create" {
+		fs := flag.NewFlagSet("create", flag.ExitOnError)
+		asGo := fs.Bool("go", false, "create a Go migration instead of SQL files")
+		dir := fs.String("dir", "migrations", "migrations directory")
+		fs.Parse(os.Args[2:])
+		if fs.NArg() != 1 {
+			log.Fatal(usage)
+		}
+		files, err := migrations.Create(*dir, fs.Arg(0), *asGo)
+		for _, f := range files {
+			fmt.Println("created", f)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	config.Initialize()
+	db := config.SetupDB()
+	migrator, err := migrations.New(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	switch os.Args[1] {
+	case "up":
+		applied, err := migrator.Up()
+		for _, m := range applied {
+			fmt.Printf("applied  %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("already up to date")
+		}
+	case "down":
+		steps := 1
+		if len(os.Args) > 2 {
+			if steps, err = strconv.Atoi(os.Args[2]); err != nil || steps < 1 {
+				log.Fatal(usage)
+			}
+		}
+		reverted, err := migrator.Down(steps)
+		for _, m := range reverted {
+			fmt.Printf("reverted %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, s := range statuses {
+			state := "pending"
+			if s.Applied {
+				state = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			if s.Missing {
+				state += " (missing in code)"
+			}
+			fmt.Printf("%04d_%-40s %s\n", s.Version, s.Name, state)
+		}
+	default:
+		log.Fatal(usage)
+	}
+}
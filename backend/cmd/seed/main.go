@@ -0,0 +1,46 @@
+// cmd/seed は職種・スキル・ジャンルの初期データを投入します
+//
+//	go run ./cmd/seed                        組み込みの seeds/taxonomy.json を投入
+//	go run ./cmd/seed -file path/to/taxonomy.json
+//
+// 既にある名前（大文字小文字は区別しない）は足りない別名・分類を補うだけなので、何度実行しても結果は同じです
+// 投入後、ユーザー・投稿などに登録済みの値を別名から正式名に揃えます
+package main
+
+import (
+	"backend/config"
+	domainTaxonomy "backend/domain/taxonomy"
+	taxonomyInfra "backend/infrastructure/taxonomy"
+	"backend/seeds"
+	"backend/services"
+	"flag"
+	"fmt"
+	"log"
+)
+
+func main() {
+	file := flag.String("file", "", "seed file (JSON). defaults to the embedded seeds/taxonomy.json")
+	flag.Parse()
+
+	taxonomy, err := seeds.LoadTaxonomy(*file)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	config.Initialize()
+	db := config.SetupDB()
+	taxonomyService := services.NewTaxonomyService(taxonomyInfra.NewTaxonomyRepo(db), nil)
+
+	byKind := taxonomy.ByKind()
+	for _, kind := range domainTaxonomy.Kinds {
+		created, err := taxonomyService.Seed(kind, byKind[kind])
+		if err != nil {
+			log.Fatalf("seed %s: %v", kind, err)
+		}
+		normalized, err := taxonomyService.NormalizeStoredValues(kind)
+		if err != nil {
+			log.Fatalf("normalize %s: %v", kind, err)
+		}
+		fmt.Printf("%-10s %d created, %d already present, %d rows normalized\n", kind, created, len(byKind[kind])-created, normalized)
+	}
+}
@@ -11,9 +11,16 @@ import (
 	"gorm.io/gorm"
 )
 
-func SetupDB() *gorm.DB {
+// UsePostgres は現在の環境で PostgreSQL を使うかどうかを返します
+// prod / development 以外（テストやローカル実行）では SQLite を使います
+func UsePostgres() bool {
 	env := os.Getenv("ENV")
-	dsn := fmt.Sprintf(
+	return env == "prod" || env == "development"
+}
+
+// PostgresDSN は環境変数から PostgreSQL の接続文字列を組み立てます
+func PostgresDSN() string {
+	return fmt.Sprintf(
 		"host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=Asia/Tokyo",
 		os.Getenv("DB_HOST"),
 		os.Getenv("DB_USER"),
@@ -21,14 +28,16 @@ func SetupDB() *gorm.DB {
 		os.Getenv("DB_NAME"),
 		os.Getenv("DB_PORT"),
 	)
+}
 
+func SetupDB() *gorm.DB {
 	var (
 		db  *gorm.DB
 		err error
 	)
 
-	if env == "prod" || env == "development" {
-		db, err = gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if UsePostgres() {
+		db, err = gorm.Open(postgres.Open(PostgresDSN()), &gorm.Config{})
 		log.Println("Setup postgresql database")
 	} else {
 		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
@@ -42,5 +51,14 @@ func SetupDB() *gorm.DB {
 	db.Config.NowFunc = func() time.Time { // この点を変更
 		return time.Now().UTC()
 	}
+
+	// SQLite のインメモリ DB はコネクションごとに別の DB になるため、1 本に固定して共有する
+	if !UsePostgres() {
+		sqlDB, err := db.DB()
+		if err != nil {
+			panic("Failed to connect database")
+		}
+		sqlDB.SetMaxOpenConns(1)
+	}
 	return db
 }
@@ -1,13 +1,17 @@
 package controllers
 
 import (
+	domainAudit "backend/domain/audit"
+	domainPortfolio "backend/domain/portfolio"
 	domainUser "backend/domain/user"
 	"backend/dto"
 	"backend/services"
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 type IPortfolioController interface {
@@ -15,6 +19,22 @@ type IPortfolioController interface {
 	GetPostsByUserID(ctx *gin.Context)
 	GetAllPosts(ctx *gin.Context)
 	GetPostByID(ctx *gin.Context)
+	GetDrafts(ctx *gin.Context)
+	GetArchived(ctx *gin.Context)
+	CreateDraft(ctx *gin.Context)
+	UpdatePost(ctx *gin.Context)
+	DeletePost(ctx *gin.Context)
+	AutosaveDraft(ctx *gin.Context)
+	AddImages(ctx *gin.Context)
+	Publish(ctx *gin.Context)
+	Schedule(ctx *gin.Context)
+	Unschedule(ctx *gin.Context)
+	Archive(ctx *gin.Context)
+	Unarchive(ctx *gin.Context)
+	GetRevisions(ctx *gin.Context)
+	GetRevision(ctx *gin.Context)
+	DiffRevisions(ctx *gin.Context)
+	RestoreRevision(ctx *gin.Context)
 }
 
 type PortfolioController struct {
@@ -49,18 +69,51 @@ func (c *PortfolioController) CreatePost(ctx *gin.Context) {
 	input.Genres = ctx.PostFormArray("genres")
 	input.Skills = ctx.PostFormArray("skills")
 
+	// 制作情報。技術スタックは techStackName / techStackVersion を同じ順に並べて送る
+	input.RepositoryURL = ctx.PostForm("repositoryUrl")
+	input.DemoURL = ctx.PostForm("demoUrl")
+	input.Role = ctx.PostForm("role")
+	input.StartDate = ctx.PostForm("startDate")
+	input.EndDate = ctx.PostForm("endDate")
+	if teamSize := ctx.PostForm("teamSize"); teamSize != "" {
+		n, err := strconv.Atoi(teamSize)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid team size"})
+			return
+		}
+		input.TeamSize = n
+	}
+	versions := ctx.PostFormArray("techStackVersion")
+	for i, name := range ctx.PostFormArray("techStackName") {
+		item := dto.TechStackInput{Name: name}
+		if i < len(versions) {
+			item.Version = versions[i]
+		}
+		input.TechStack = append(input.TechStack, item)
+	}
+
+	input.Images = imageInputsFromForm(ctx)
+	if cover := ctx.PostForm("coverImageIndex"); cover != "" {
+		n, err := strconv.Atoi(cover)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid cover image index"})
+			return
+		}
+		input.CoverImageIndex = n
+	}
+
 	// 3) 画像はmultipart.FileHeaderで受け取る
 	form, _ := ctx.MultipartForm()
 	fileHeaders := form.File["images"] // []*multipart.FileHeader
 
 	// 4) サービスに「DTO + 画像ファイル群 + userID」を渡す
-	err := c.portfolioService.CreatePost(input, fileHeaders, currentUser.ID)
+	post, err := c.portfolioService.CreatePost(input, fileHeaders, auditActor(ctx, currentUser.ID))
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, gin.H{"message": "Post created successfully"})
+	ctx.JSON(http.StatusCreated, gin.H{"message": "Post created successfully", "post": post})
 }
 
 func (c *PortfolioController) GetPostsByUserID(ctx *gin.Context) {
@@ -83,8 +136,15 @@ func (c *PortfolioController) GetPostsByUserID(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, gin.H{"posts": posts})
 }
 
+// GetAllPosts は投稿一覧を返します。クエリ (q, genre, skill, graduationYear) があれば絞り込み、sort=trending で人気順に並べます
 func (c *PortfolioController) GetAllPosts(ctx *gin.Context) {
-	portfolio, err := c.portfolioService.GetAllPosts()
+	var input dto.PostSearchInput
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	portfolio, err := c.portfolioService.SearchPosts(input)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get posts"})
 		return
@@ -94,6 +154,13 @@ func (c *PortfolioController) GetAllPosts(ctx *gin.Context) {
 }
 
 func (c *PortfolioController) GetPostByID(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
 	// URLパラメータ :id を取得
 	idStr := ctx.Param("id")
 	// 整数にパース
@@ -105,12 +172,395 @@ func (c *PortfolioController) GetPostByID(ctx *gin.Context) {
 	postID := uint(idUint64)
 
 	// サービスを呼び出して該当のPostを取得
-	post, err := c.portfolioService.GetPostByID(postID)
+	post, err := c.portfolioService.GetPostByID(currentUser.ID, postID)
 	if err != nil {
 		ctx.JSON(http.StatusNotFound, gin.H{"error": "Post not found", "details": err.Error()})
 		return
 	}
 
-	// 取得したPostをレスポンスとして返す
+	// 取得したPostをレスポンスとして返す。更新するときは ETag を If-Match に送る
+	setETag(ctx, post.Version)
+	ctx.JSON(http.StatusOK, gin.H{"post": post})
+}
+
+// GetDrafts はログイン中のユーザーの下書きと予約投稿の一覧を返します
+func (c *PortfolioController) GetDrafts(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	posts, err := c.portfolioService.GetDrafts(currentUser.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get drafts"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"posts": posts})
+}
+
+// GetArchived はログイン中のユーザーの公開終了した投稿の一覧を返します
+func (c *PortfolioController) GetArchived(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	posts, err := c.portfolioService.GetArchived(currentUser.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get archived posts"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"posts": posts})
+}
+
+func (c *PortfolioController) CreateDraft(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.PostContentInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	post, err := c.portfolioService.CreateDraft(auditActor(ctx, currentUser.ID), input)
+	if err != nil {
+		respondPostError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"post": post})
+}
+
+func (c *PortfolioController) UpdatePost(ctx *gin.Context) {
+	c.saveContent(ctx, c.portfolioService.UpdatePost)
+}
+
+// DeletePost は投稿を削除します。削除できるのは投稿者だけです
+func (c *PortfolioController) DeletePost(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	if err := c.portfolioService.DeletePost(auditActor(ctx, currentUser.ID), id); err != nil {
+		respondPostError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Post deleted successfully"})
+}
+
+// AutosaveDraft は編集画面からの途中保存です。下書きだけが対象で、版は残しません
+func (c *PortfolioController) AutosaveDraft(ctx *gin.Context) {
+	c.saveContent(ctx, c.portfolioService.AutosaveDraft)
+}
+
+// saveContent は内容の保存です。読み込んだときの ETag を If-Match に送る必要があり、
+// ほかの操作が先に保存していた場合は 412 で今の投稿と ETag を返す
+func (c *PortfolioController) saveContent(ctx *gin.Context,
+	save func(domainAudit.Actor, uint, int, dto.PostContentInput) (*domainPortfolio.Post, error)) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return
+	}
+
+	var input dto.PostContentInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	post, err := save(auditActor(ctx, currentUser.ID), id, version, input)
+	if errors.Is(err, domainPortfolio.ErrVersionConflict) {
+		c.respondPostConflict(ctx, currentUser.ID, id, err)
+		return
+	}
+	if err != nil {
+		respondPostError(ctx, err)
+		return
+	}
+
+	setETag(ctx, post.Version)
 	ctx.JSON(http.StatusOK, gin.H{"post": post})
 }
+
+// respondPostConflict はほかの操作が先に投稿を保存していた場合に、412 で今の投稿と ETag を返します
+func (c *PortfolioController) respondPostConflict(ctx *gin.Context, userID, id uint, err error) {
+	current, getErr := c.portfolioService.GetPostByID(userID, id)
+	if getErr != nil {
+		respondPostError(ctx, getErr)
+		return
+	}
+	setETag(ctx, current.Version)
+	ctx.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error(), "post": current})
+}
+
+// AddImages は multipart の images を投稿の末尾に追加します。説明は imageAltText / imageCaption で送る
+// 内容の保存と同じく、読み込んだときの ETag を If-Match に送る
+func (c *PortfolioController) AddImages(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return
+	}
+	if err := ctx.Request.ParseMultipartForm(32 << 20); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Failed to parse multipart form", "details": err.Error()})
+		return
+	}
+	form, _ := ctx.MultipartForm()
+
+	post, err := c.portfolioService.AddImages(auditActor(ctx, currentUser.ID), id, version, form.File["images"], imageInputsFromForm(ctx))
+	if errors.Is(err, domainPortfolio.ErrVersionConflict) {
+		c.respondPostConflict(ctx, currentUser.ID, id, err)
+		return
+	}
+	if err != nil {
+		respondPostError(ctx, err)
+		return
+	}
+
+	setETag(ctx, post.Version)
+	ctx.JSON(http.StatusOK, gin.H{"post": post})
+}
+
+func (c *PortfolioController) Publish(ctx *gin.Context) {
+	c.changeStatus(ctx, c.portfolioService.Publish)
+}
+
+// Schedule は下書きを publishAt に公開する予約投稿にします
+func (c *PortfolioController) Schedule(ctx *gin.Context) {
+	var input dto.SchedulePostInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.changeStatus(ctx, func(actor domainAudit.Actor, id uint) (*domainPortfolio.Post, error) {
+		return c.portfolioService.Schedule(actor, id, input.PublishAt)
+	})
+}
+
+func (c *PortfolioController) Unschedule(ctx *gin.Context) {
+	c.changeStatus(ctx, c.portfolioService.Unschedule)
+}
+
+func (c *PortfolioController) Archive(ctx *gin.Context) {
+	c.changeStatus(ctx, c.portfolioService.Archive)
+}
+
+func (c *PortfolioController) Unarchive(ctx *gin.Context) {
+	c.changeStatus(ctx, c.portfolioService.Unarchive)
+}
+
+func (c *PortfolioController) changeStatus(ctx *gin.Context,
+	change func(domainAudit.Actor, uint) (*domainPortfolio.Post, error)) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	post, err := change(auditActor(ctx, currentUser.ID), id)
+	if err != nil {
+		respondPostError(ctx, err)
+		return
+	}
+
+	setETag(ctx, post.Version)
+	ctx.JSON(http.StatusOK, gin.H{"post": post})
+}
+
+// GetRevisions は投稿の版の一覧を新しい順に返します（本人のみ）
+func (c *PortfolioController) GetRevisions(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	revisions, err := c.portfolioService.GetRevisions(currentUser.ID, id)
+	if err != nil {
+		respondPostError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"revisions": revisions})
+}
+
+func (c *PortfolioController) GetRevision(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+	number, ok := parseIDParam(ctx, "number")
+	if !ok {
+		return
+	}
+
+	revision, err := c.portfolioService.GetRevision(currentUser.ID, id, int(number))
+	if err != nil {
+		respondPostError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"revision": revision})
+}
+
+// DiffRevisions は ?against= の版（省略時は直前の版）から :number の版への差分を返します
+func (c *PortfolioController) DiffRevisions(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+	number, ok := parseIDParam(ctx, "number")
+	if !ok {
+		return
+	}
+	against := 0
+	if raw := ctx.Query("against"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid against"})
+			return
+		}
+		against = n
+	}
+
+	diff, err := c.portfolioService.DiffRevisions(currentUser.ID, id, int(number), against)
+	if err != nil {
+		respondPostError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"diff": diff})
+}
+
+// RestoreRevision は投稿を過去の版に戻します。読み込んだときの ETag を If-Match に送る
+func (c *PortfolioController) RestoreRevision(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+	number, ok := parseIDParam(ctx, "number")
+	if !ok {
+		return
+	}
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return
+	}
+
+	post, err := c.portfolioService.RestoreRevision(auditActor(ctx, currentUser.ID), id, version, int(number))
+	if errors.Is(err, domainPortfolio.ErrVersionConflict) {
+		c.respondPostConflict(ctx, currentUser.ID, id, err)
+		return
+	}
+	if err != nil {
+		respondPostError(ctx, err)
+		return
+	}
+
+	setETag(ctx, post.Version)
+	ctx.JSON(http.StatusOK, gin.H{"post": post})
+}
+
+// imageInputsFromForm は images と同じ順に imageAltText / imageCaption で送られた画像の説明を読み取ります
+func imageInputsFromForm(ctx *gin.Context) []dto.PostImageInput {
+	var images []dto.PostImageInput
+	captions := ctx.PostFormArray("imageCaption")
+	for i, alt := range ctx.PostFormArray("imageAltText") {
+		image := dto.PostImageInput{AltText: alt}
+		if i < len(captions) {
+			image.Caption = captions[i]
+		}
+		images = append(images, image)
+	}
+	return images
+}
+
+func respondPostError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	case errors.Is(err, services.ErrForbidden):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "Only the author of the post can do this"})
+	case errors.Is(err, domainPortfolio.ErrInvalidTransition):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, domainPortfolio.ErrVersionConflict):
+		// If-Match のない操作（公開・アーカイブなど）が、読み込みから保存までの間にほかの操作と重なった
+		ctx.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	domainAnalytics "backend/domain/analytics"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type IAnalyticsController interface {
+	RecordView(ctx *gin.Context)
+	GetInsights(ctx *gin.Context)
+}
+
+type AnalyticsController struct {
+	analyticsService services.IAnalyticsService
+}
+
+func NewAnalyticsController(analyticsService services.IAnalyticsService) IAnalyticsController {
+	return &AnalyticsController{analyticsService: analyticsService}
+}
+
+// RecordView は投稿・プロフィールの閲覧を記録します。未ログインの閲覧者からも受け付ける
+func (c *AnalyticsController) RecordView(ctx *gin.Context) {
+	var input dto.RecordViewInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	visit := domainAnalytics.Visit{
+		TargetType: domainAnalytics.TargetType(input.Type),
+		TargetID:   input.ID,
+		IP:         ctx.ClientIP(),
+		UserAgent:  ctx.Request.UserAgent(),
+		Referrer:   input.Referrer,
+		At:         time.Now(),
+	}
+	if user, exists := ctx.Get("user"); exists {
+		visit.ViewerID = user.(*domainUser.UserModel).ID
+	}
+
+	if err := c.analyticsService.RecordView(visit); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to record view"})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetInsights は本人の投稿・プロフィールの閲覧の集計を返します（1 時間ごとの集計のため直近の閲覧は遅れて反映される）
+func (c *AnalyticsController) GetInsights(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var query dto.InsightsQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	insights, err := c.analyticsService.GetInsights(currentUser.ID, query.Days, time.Now())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get insights"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"insights": insights})
+}
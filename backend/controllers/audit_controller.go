@@ -0,0 +1,66 @@
+// controllers/audit_controller.go
+
+package controllers
+
+import (
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IAuditController interface {
+	GetMySecurityEvents(ctx *gin.Context)
+	SearchAuditLogs(ctx *gin.Context)
+}
+
+type AuditController struct {
+	auditService services.IAuditService
+}
+
+func NewAuditController(auditService services.IAuditService) IAuditController {
+	return &AuditController{auditService: auditService}
+}
+
+// GetMySecurityEvents はログイン履歴やプロフィール変更など、本人のアカウントのセキュリティ履歴を返します
+func (c *AuditController) GetMySecurityEvents(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.PageInput
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	events, total, err := c.auditService.GetSecurityEvents(currentUser.ID, input.Page, input.PerPage)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get security events"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"events": events, "total": total})
+}
+
+// SearchAuditLogs は運営向けに全ユーザーの監査ログを検索します
+func (c *AuditController) SearchAuditLogs(ctx *gin.Context) {
+	var input dto.AuditLogSearchInput
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	entries, total, err := c.auditService.SearchEntries(input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search audit logs"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"entries": entries, "total": total})
+}
@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	domainAudit "backend/domain/audit"
+	domainUser "backend/domain/user"
 	"backend/dto"
 	"backend/services"
 	"context"
@@ -37,10 +39,11 @@ type IAuthController interface {
 type AuthController struct {
 	services          services.IAuthService
 	emailService      services.IEmailService
+	auditService      services.IAuditService
 	googleOauthConfig *oauth2.Config
 }
 
-func NewAuthController(service services.IAuthService, emailService services.IEmailService) IAuthController {
+func NewAuthController(service services.IAuthService, emailService services.IEmailService, auditService services.IAuditService) IAuthController {
 	backendURL := os.Getenv("BACKEND_URL")
 	googleOauthConfig := &oauth2.Config{
 		RedirectURL:  backendURL + "/auth/google/callback",
@@ -53,6 +56,7 @@ func NewAuthController(service services.IAuthService, emailService services.IEma
 	return &AuthController{
 		services:          service,
 		emailService:      emailService,
+		auditService:      auditService,
 		googleOauthConfig: googleOauthConfig,
 	}
 }
@@ -70,6 +74,7 @@ func (c *AuthController) SignUp(ctx *gin.Context) {
 	if err != nil {
 		if err.Error() == "user already exists" {
 			jwtToken, tokenExpiry, err := c.services.Login(input.Email, input.Password, false)
+			c.recordLogin(ctx, input.Email, err)
 			if err != nil {
 				ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid credentials"})
 				return
@@ -127,6 +132,8 @@ func (c *AuthController) VerifyAccount(ctx *gin.Context) {
 		return
 	}
 
+	c.recordAudit(ctx, user.ID, domainAudit.ActionAccountVerified, nil)
+
 	if err := c.emailService.SendWelcomeEmail(user.Email); err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send welcome email"})
 		return
@@ -156,6 +163,7 @@ func (c *AuthController) Login(ctx *gin.Context) {
 	}
 
 	jwtToken, tokenExpiry, err := c.services.Login(input.Email, input.Password, input.RememberMe)
+	c.recordLogin(ctx, input.Email, err)
 	if err != nil {
 		if err.Error() == "user not found" {
 			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -176,6 +184,10 @@ func (c *AuthController) Logout(ctx *gin.Context) {
 		return
 	}
 
+	if user, exists := ctx.Get("user"); exists {
+		c.recordAudit(ctx, user.(*domainUser.UserModel).ID, domainAudit.ActionLogout, nil)
+	}
+
 	cookieDomain := os.Getenv("COOKIE_DOMAIN")
 	// クッキー削除
 	ctx.SetCookie("jwt-token", "", -1, "/", cookieDomain, false, true)
@@ -231,6 +243,7 @@ func (c *AuthController) GoogleCallback(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to find or create user"})
 		return
 	}
+	c.recordAudit(ctx, user.ID, domainAudit.ActionGoogleLogin, map[string]interface{}{"rememberMe": rememberMe})
 
 	if err := c.emailService.SendWelcomeEmail(user.Email); err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send welcome email"})
@@ -280,6 +293,11 @@ func (c *AuthController) RequestPasswordReset(ctx *gin.Context) {
 	}
 
 	resetToken, err := c.services.GeneratePasswordResetToken(input.Email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.recordAuditByEmail(ctx, input.Email, domainAudit.ActionPasswordResetRequested, map[string]interface{}{"result": "unknown_email"})
+	} else if err == nil {
+		c.recordAuditByEmail(ctx, input.Email, domainAudit.ActionPasswordResetRequested, nil)
+	}
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			ctx.JSON(http.StatusNotFound, gin.H{"error": "そのアカウントは無効です。"})
@@ -322,6 +340,7 @@ func (c *AuthController) ResetPassword(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "パスワードの更新に失敗しました"})
 		return
 	}
+	c.recordAudit(ctx, user.ID, domainAudit.ActionPasswordResetCompleted, nil)
 
 	if err := c.emailService.SendPasswordResetConfirmationEmail(user.Email); err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "パスワードリセット完了メールの送信に失敗しました"})
@@ -362,3 +381,30 @@ func (c *AuthController) CheckResetToken(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, gin.H{"message": "トークンは有効です。"})
 }
+
+// recordLogin はパスワードでのログインの成否を監査ログに残します
+func (c *AuthController) recordLogin(ctx *gin.Context, email string, loginErr error) {
+	if loginErr == nil {
+		c.recordAuditByEmail(ctx, email, domainAudit.ActionLoginSucceeded, map[string]interface{}{"method": "password"})
+		return
+	}
+	reason := "invalid_credentials"
+	if errors.Is(loginErr, gorm.ErrRecordNotFound) {
+		reason = "unknown_email"
+	}
+	c.recordAuditByEmail(ctx, email, domainAudit.ActionLoginFailed, map[string]interface{}{"method": "password", "reason": reason})
+}
+
+// recordAudit は本人のアカウントに対する操作を記録します。記録に失敗してもレスポンスは止めません
+func (c *AuthController) recordAudit(ctx *gin.Context, userID uint, action domainAudit.Action, detail interface{}) {
+	if err := c.auditService.Record(auditActor(ctx, userID), action, "user", userID, detail); err != nil {
+		log.Printf("Error recording audit log (%s): %v", action, err)
+	}
+}
+
+// recordAuditByEmail はログイン前でユーザーをメールアドレスでしか特定できない操作を記録します
+func (c *AuthController) recordAuditByEmail(ctx *gin.Context, email string, action domainAudit.Action, detail map[string]interface{}) {
+	if err := c.auditService.RecordByEmail(auditActor(ctx, 0), action, email, detail); err != nil {
+		log.Printf("Error recording audit log (%s): %v", action, err)
+	}
+}
@@ -0,0 +1,248 @@
+// controllers/career_controller.go
+
+package controllers
+
+import (
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type ICareerController interface {
+	GetEducations(ctx *gin.Context)
+	CreateEducation(ctx *gin.Context)
+	UpdateEducation(ctx *gin.Context)
+	DeleteEducation(ctx *gin.Context)
+	ReorderEducations(ctx *gin.Context)
+
+	GetExperiences(ctx *gin.Context)
+	CreateExperience(ctx *gin.Context)
+	UpdateExperience(ctx *gin.Context)
+	DeleteExperience(ctx *gin.Context)
+}
+
+type CareerController struct {
+	careerService services.ICareerService
+}
+
+func NewCareerController(careerService services.ICareerService) ICareerController {
+	return &CareerController{careerService: careerService}
+}
+
+func (c *CareerController) GetEducations(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	educations, err := c.careerService.GetEducations(currentUser.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get educations"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"educations": educations})
+}
+
+func (c *CareerController) CreateEducation(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.EducationInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	education, err := c.careerService.CreateEducation(auditActor(ctx, currentUser.ID), input)
+	if err != nil {
+		respondCareerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"education": education})
+}
+
+func (c *CareerController) UpdateEducation(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.EducationInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	education, err := c.careerService.UpdateEducation(auditActor(ctx, currentUser.ID), id, input)
+	if err != nil {
+		respondCareerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"education": education})
+}
+
+func (c *CareerController) DeleteEducation(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	if err := c.careerService.DeleteEducation(auditActor(ctx, currentUser.ID), id); err != nil {
+		respondCareerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Education deleted successfully"})
+}
+
+func (c *CareerController) ReorderEducations(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.ReorderEducationsInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	educations, err := c.careerService.ReorderEducations(auditActor(ctx, currentUser.ID), input.IDs)
+	if err != nil {
+		respondCareerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"educations": educations})
+}
+
+func (c *CareerController) GetExperiences(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	experiences, err := c.careerService.GetExperiences(currentUser.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get experiences"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"experiences": experiences})
+}
+
+func (c *CareerController) CreateExperience(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.ExperienceInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	experience, err := c.careerService.CreateExperience(auditActor(ctx, currentUser.ID), input)
+	if err != nil {
+		respondCareerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"experience": experience})
+}
+
+func (c *CareerController) UpdateExperience(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.ExperienceInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	experience, err := c.careerService.UpdateExperience(auditActor(ctx, currentUser.ID), id, input)
+	if err != nil {
+		respondCareerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"experience": experience})
+}
+
+func (c *CareerController) DeleteExperience(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	if err := c.careerService.DeleteExperience(auditActor(ctx, currentUser.ID), id); err != nil {
+		respondCareerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Experience deleted successfully"})
+}
+
+func respondCareerError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	case errors.Is(err, domainUser.ErrVersionConflict):
+		// 学歴から求めるプロフィールの学校名を、ほかの操作が先に保存していた。学歴の変更は取り消したので送り直せばよい
+		ctx.JSON(http.StatusConflict, gin.H{"error": "Profile was updated by another request. Please retry"})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
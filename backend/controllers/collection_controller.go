@@ -0,0 +1,373 @@
+package controllers
+
+import (
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type ICollectionController interface {
+	GetMyCollections(ctx *gin.Context)
+	GetUserCollections(ctx *gin.Context)
+	GetCollection(ctx *gin.Context)
+	CreateCollection(ctx *gin.Context)
+	UpdateCollection(ctx *gin.Context)
+	DeleteCollection(ctx *gin.Context)
+	ReorderCollections(ctx *gin.Context)
+	SetPosts(ctx *gin.Context)
+	AddPost(ctx *gin.Context)
+	RemovePost(ctx *gin.Context)
+	GetFeatured(ctx *gin.Context)
+	Feature(ctx *gin.Context)
+	Unfeature(ctx *gin.Context)
+	ReorderFeatured(ctx *gin.Context)
+}
+
+type CollectionController struct {
+	collectionService services.ICollectionService
+}
+
+func NewCollectionController(collectionService services.ICollectionService) ICollectionController {
+	return &CollectionController{collectionService: collectionService}
+}
+
+func (c *CollectionController) GetMyCollections(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	collections, err := c.collectionService.GetMyCollections(currentUser.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get collections"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collections": collections})
+}
+
+// GetUserCollections は他のユーザーのプロフィールに表示するコレクションを返します
+func (c *CollectionController) GetUserCollections(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	userID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	collections, err := c.collectionService.GetUserCollections(currentUser.ID, userID)
+	if err != nil {
+		respondCollectionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collections": collections})
+}
+
+func (c *CollectionController) GetCollection(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	collection, err := c.collectionService.GetCollection(currentUser.ID, id)
+	if err != nil {
+		respondCollectionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collection": collection})
+}
+
+func (c *CollectionController) CreateCollection(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.CollectionInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	collection, err := c.collectionService.CreateCollection(auditActor(ctx, currentUser.ID), input)
+	if err != nil {
+		respondCollectionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"collection": collection})
+}
+
+func (c *CollectionController) UpdateCollection(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.CollectionInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	collection, err := c.collectionService.UpdateCollection(auditActor(ctx, currentUser.ID), id, input)
+	if err != nil {
+		respondCollectionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collection": collection})
+}
+
+func (c *CollectionController) DeleteCollection(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	if err := c.collectionService.DeleteCollection(auditActor(ctx, currentUser.ID), id); err != nil {
+		respondCollectionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Collection deleted"})
+}
+
+func (c *CollectionController) ReorderCollections(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.ReorderCollectionsInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	collections, err := c.collectionService.ReorderCollections(auditActor(ctx, currentUser.ID), input.IDs)
+	if err != nil {
+		respondCollectionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collections": collections})
+}
+
+// SetPosts はコレクションの投稿を表示順に丸ごと置き換えます（並び替えもこれで行う）
+func (c *CollectionController) SetPosts(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.CollectionPostsInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	collection, err := c.collectionService.SetPosts(auditActor(ctx, currentUser.ID), id, input.PostIDs)
+	if err != nil {
+		respondCollectionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collection": collection})
+}
+
+func (c *CollectionController) AddPost(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.AddCollectionPostInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	collection, err := c.collectionService.AddPost(auditActor(ctx, currentUser.ID), id, input.PostID)
+	if err != nil {
+		respondCollectionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collection": collection})
+}
+
+func (c *CollectionController) RemovePost(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+	postID, ok := parseIDParam(ctx, "postId")
+	if !ok {
+		return
+	}
+
+	collection, err := c.collectionService.RemovePost(auditActor(ctx, currentUser.ID), id, postID)
+	if err != nil {
+		respondCollectionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collection": collection})
+}
+
+// GetFeatured はトップページに表示する特集のコレクションを返します
+func (c *CollectionController) GetFeatured(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	collections, err := c.collectionService.GetFeatured(currentUser.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get featured collections"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collections": collections})
+}
+
+func (c *CollectionController) Feature(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	collection, err := c.collectionService.Feature(auditActor(ctx, currentUser.ID), id)
+	if err != nil {
+		respondCollectionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collection": collection})
+}
+
+func (c *CollectionController) Unfeature(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	collection, err := c.collectionService.Unfeature(auditActor(ctx, currentUser.ID), id)
+	if err != nil {
+		respondCollectionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collection": collection})
+}
+
+func (c *CollectionController) ReorderFeatured(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.ReorderCollectionsInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	collections, err := c.collectionService.ReorderFeatured(auditActor(ctx, currentUser.ID), input.IDs)
+	if err != nil {
+		respondCollectionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collections": collections})
+}
+
+func respondCollectionError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	case errors.Is(err, services.ErrPostNotCollectable):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
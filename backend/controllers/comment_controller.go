@@ -0,0 +1,81 @@
+// controllers/comment_controller.go
+
+package controllers
+
+import (
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type ICommentController interface {
+	CreateComment(ctx *gin.Context)
+	GetComments(ctx *gin.Context)
+}
+
+type CommentController struct {
+	commentService services.ICommentService
+}
+
+func NewCommentController(commentService services.ICommentService) ICommentController {
+	return &CommentController{commentService: commentService}
+}
+
+func (c *CommentController) CreateComment(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	postID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	var input dto.CreateCommentInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	comment, err := c.commentService.CreateComment(uint(postID), currentUser.ID, input.Body)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"comment": comment})
+}
+
+func (c *CommentController) GetComments(ctx *gin.Context) {
+	postID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	comments, err := c.commentService.GetCommentsByPostID(uint(postID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get comments"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"comments": comments})
+}
@@ -0,0 +1,122 @@
+package controllers
+
+import (
+	domainEngagement "backend/domain/engagement"
+	domainUser "backend/domain/user"
+	"backend/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type IEngagementController interface {
+	Like(ctx *gin.Context)
+	Unlike(ctx *gin.Context)
+	Bookmark(ctx *gin.Context)
+	Unbookmark(ctx *gin.Context)
+	GetSummary(ctx *gin.Context)
+	GetBookmarks(ctx *gin.Context)
+}
+
+type EngagementController struct {
+	engagementService services.IEngagementService
+}
+
+func NewEngagementController(engagementService services.IEngagementService) IEngagementController {
+	return &EngagementController{engagementService: engagementService}
+}
+
+func (c *EngagementController) Like(ctx *gin.Context) {
+	c.react(ctx, domainEngagement.KindLike, true)
+}
+
+func (c *EngagementController) Unlike(ctx *gin.Context) {
+	c.react(ctx, domainEngagement.KindLike, false)
+}
+
+func (c *EngagementController) Bookmark(ctx *gin.Context) {
+	c.react(ctx, domainEngagement.KindBookmark, true)
+}
+
+func (c *EngagementController) Unbookmark(ctx *gin.Context) {
+	c.react(ctx, domainEngagement.KindBookmark, false)
+}
+
+// react はいいね・ブックマークの付け外しをまとめて扱います。何度呼んでも結果は同じ
+func (c *EngagementController) react(ctx *gin.Context, kind domainEngagement.Kind, on bool) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	postID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var summary *domainEngagement.Summary
+	var err error
+	if on {
+		summary, err = c.engagementService.React(currentUser.ID, postID, kind)
+	} else {
+		summary, err = c.engagementService.Unreact(currentUser.ID, postID, kind)
+	}
+	if err != nil {
+		respondEngagementError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"engagement": summary})
+}
+
+func (c *EngagementController) GetSummary(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	postID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	summary, err := c.engagementService.GetSummary(currentUser.ID, postID)
+	if err != nil {
+		respondEngagementError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"engagement": summary})
+}
+
+func (c *EngagementController) GetBookmarks(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	posts, err := c.engagementService.GetBookmarks(currentUser.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get bookmarks"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"posts": posts})
+}
+
+func respondEngagementError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
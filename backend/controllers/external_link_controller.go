@@ -0,0 +1,47 @@
+// controllers/external_link_controller.go
+
+package controllers
+
+import (
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IExternalLinkController interface {
+	UpdateLinks(ctx *gin.Context)
+}
+
+type ExternalLinkController struct {
+	externalLinkService services.IExternalLinkService
+}
+
+func NewExternalLinkController(externalLinkService services.IExternalLinkService) IExternalLinkController {
+	return &ExternalLinkController{externalLinkService: externalLinkService}
+}
+
+func (c *ExternalLinkController) UpdateLinks(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.UpdateExternalLinksInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	links, err := c.externalLinkService.UpdateLinks(auditActor(ctx, currentUser.ID), input)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"links": links})
+}
@@ -0,0 +1,84 @@
+// controllers/github_import_controller.go
+
+package controllers
+
+import (
+	domainGitHub "backend/domain/github"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IGitHubImportController interface {
+	GetRepositories(ctx *gin.Context)
+	ImportRepositories(ctx *gin.Context)
+}
+
+type GitHubImportController struct {
+	gitHubImportService services.IGitHubImportService
+}
+
+func NewGitHubImportController(gitHubImportService services.IGitHubImportService) IGitHubImportController {
+	return &GitHubImportController{gitHubImportService: gitHubImportService}
+}
+
+// GetRepositories はプロフィールの GitHub アカウントの公開リポジトリと、スキルの候補を返します
+func (c *GitHubImportController) GetRepositories(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	preview, err := c.gitHubImportService.Preview(currentUser.ID)
+	if err != nil {
+		respondGitHubImportError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"github": preview})
+}
+
+// ImportRepositories は選んだリポジトリを下書きの投稿として取り込みます
+func (c *GitHubImportController) ImportRepositories(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.ImportGitHubReposInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	posts, err := c.gitHubImportService.ImportRepositories(auditActor(ctx, currentUser.ID), input.Repositories)
+	if err != nil {
+		respondGitHubImportError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"posts": posts})
+}
+
+func respondGitHubImportError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, domainGitHub.ErrUserNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, domainGitHub.ErrRateLimited):
+		ctx.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrGitHubNotConfigured):
+		ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrGitHubLinkMissing), errors.Is(err, services.ErrGitHubRepoNotFound):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusBadGateway, gin.H{"error": "Failed to fetch repositories from GitHub"})
+	}
+}
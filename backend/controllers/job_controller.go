@@ -0,0 +1,323 @@
+// controllers/job_controller.go
+
+package controllers
+
+import (
+	domainJob "backend/domain/job"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type IJobController interface {
+	CreateJobPosting(ctx *gin.Context)
+	UpdateJobPosting(ctx *gin.Context)
+	DeleteJobPosting(ctx *gin.Context)
+	PublishJobPosting(ctx *gin.Context)
+	CloseJobPosting(ctx *gin.Context)
+	GetJobPosting(ctx *gin.Context)
+	GetOrganizationJobPostings(ctx *gin.Context)
+	SearchJobPostings(ctx *gin.Context)
+	Apply(ctx *gin.Context)
+	GetMyApplications(ctx *gin.Context)
+	GetJobApplications(ctx *gin.Context)
+	UpdateApplicationStatus(ctx *gin.Context)
+}
+
+type JobController struct {
+	jobService services.IJobService
+}
+
+func NewJobController(jobService services.IJobService) IJobController {
+	return &JobController{jobService: jobService}
+}
+
+func (c *JobController) CreateJobPosting(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.JobPostingInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	posting, err := c.jobService.CreateJobPosting(currentUser.ID, input)
+	if err != nil {
+		respondJobError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"job": posting})
+}
+
+func (c *JobController) UpdateJobPosting(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	jobID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+	var input dto.JobPostingInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	posting, err := c.jobService.UpdateJobPosting(currentUser.ID, jobID, input)
+	if err != nil {
+		respondJobError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"job": posting})
+}
+
+func (c *JobController) DeleteJobPosting(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	jobID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	if err := c.jobService.DeleteJobPosting(currentUser.ID, jobID); err != nil {
+		respondJobError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Job posting deleted"})
+}
+
+func (c *JobController) PublishJobPosting(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	jobID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	posting, err := c.jobService.PublishJobPosting(currentUser.ID, jobID)
+	if err != nil {
+		respondJobError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"job": posting})
+}
+
+func (c *JobController) CloseJobPosting(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	jobID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	posting, err := c.jobService.CloseJobPosting(currentUser.ID, jobID)
+	if err != nil {
+		respondJobError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"job": posting})
+}
+
+func (c *JobController) GetJobPosting(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	jobID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	posting, err := c.jobService.GetJobPosting(currentUser.ID, jobID)
+	if err != nil {
+		respondJobError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"job": posting})
+}
+
+func (c *JobController) GetOrganizationJobPostings(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	orgID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	postings, err := c.jobService.GetOrganizationJobPostings(currentUser.ID, orgID)
+	if err != nil {
+		respondJobError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"jobs": postings})
+}
+
+func (c *JobController) SearchJobPostings(ctx *gin.Context) {
+	var input dto.JobSearchInput
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	postings, err := c.jobService.SearchJobPostings(input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search jobs"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"jobs": postings})
+}
+
+func (c *JobController) Apply(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	jobID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+	var input dto.ApplyJobInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	application, err := c.jobService.Apply(currentUser.ID, jobID, input)
+	if err != nil {
+		respondJobError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"application": application})
+}
+
+func (c *JobController) GetMyApplications(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	applications, err := c.jobService.GetMyApplications(currentUser.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get applications"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"applications": applications})
+}
+
+func (c *JobController) GetJobApplications(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	jobID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	applications, err := c.jobService.GetJobApplications(currentUser.ID, jobID)
+	if err != nil {
+		respondJobError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"applications": applications})
+}
+
+func (c *JobController) UpdateApplicationStatus(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	applicationID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+	var input dto.UpdateApplicationStatusInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	application, err := c.jobService.UpdateApplicationStatus(currentUser.ID, applicationID, domainJob.ApplicationStatus(input.Status))
+	if err != nil {
+		respondJobError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"application": application})
+}
+
+// respondJobError はサービス層のエラーを HTTP ステータスに変換して返します
+// ドメインの検証エラーは 400 として返します
+func respondJobError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	case errors.Is(err, services.ErrForbidden):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	case errors.Is(err, services.ErrOrganizationNotApproved):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrAlreadyApplied):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
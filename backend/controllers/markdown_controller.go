@@ -0,0 +1,40 @@
+// controllers/markdown_controller.go
+
+package controllers
+
+import (
+	"backend/dto"
+	"backend/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IMarkdownController interface {
+	Render(ctx *gin.Context)
+}
+
+type MarkdownController struct {
+	markdownService services.IMarkdownService
+}
+
+func NewMarkdownController(markdownService services.IMarkdownService) IMarkdownController {
+	return &MarkdownController{markdownService: markdownService}
+}
+
+// Render は説明文・自己紹介のエディタ用に、保存時と同じ規則で変換した HTML を返します
+func (c *MarkdownController) Render(ctx *gin.Context) {
+	var input dto.RenderMarkdownInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	html, err := c.markdownService.Preview(input.Source)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"html": html})
+}
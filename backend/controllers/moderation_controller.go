@@ -0,0 +1,253 @@
+// controllers/moderation_controller.go
+
+package controllers
+
+import (
+	domainModeration "backend/domain/moderation"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type IModerationController interface {
+	CreateReport(ctx *gin.Context)
+
+	GetReports(ctx *gin.Context)
+	CloseReport(ctx *gin.Context)
+	HidePost(ctx *gin.Context)
+	UnhidePost(ctx *gin.Context)
+	SuspendUser(ctx *gin.Context)
+	UnsuspendUser(ctx *gin.Context)
+	AddNote(ctx *gin.Context)
+	GetNotes(ctx *gin.Context)
+}
+
+type ModerationController struct {
+	moderationService services.IModerationService
+}
+
+func NewModerationController(moderationService services.IModerationService) IModerationController {
+	return &ModerationController{moderationService: moderationService}
+}
+
+func (c *ModerationController) CreateReport(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.CreateReportInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := c.moderationService.CreateReport(currentUser.ID, input)
+	if err != nil {
+		respondModerationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"report": report})
+}
+
+func (c *ModerationController) GetReports(ctx *gin.Context) {
+	var input dto.ReportListInput
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reports, total, err := c.moderationService.GetReports(input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get reports"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"reports": reports, "total": total})
+}
+
+func (c *ModerationController) CloseReport(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.CloseReportInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := c.moderationService.CloseReport(auditActor(ctx, currentUser.ID), id, input)
+	if err != nil {
+		respondModerationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"report": report})
+}
+
+func (c *ModerationController) HidePost(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.ModerationReasonInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	post, err := c.moderationService.HidePost(auditActor(ctx, currentUser.ID), id, input.Reason)
+	if err != nil {
+		respondModerationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"post": post})
+}
+
+func (c *ModerationController) UnhidePost(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	post, err := c.moderationService.UnhidePost(auditActor(ctx, currentUser.ID), id)
+	if err != nil {
+		respondModerationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"post": post})
+}
+
+func (c *ModerationController) SuspendUser(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.ModerationReasonInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.moderationService.SuspendUser(auditActor(ctx, currentUser.ID), id, input.Reason); err != nil {
+		respondModerationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "User suspended successfully"})
+}
+
+func (c *ModerationController) UnsuspendUser(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	if err := c.moderationService.UnsuspendUser(auditActor(ctx, currentUser.ID), id); err != nil {
+		respondModerationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "User unsuspended successfully"})
+}
+
+func (c *ModerationController) AddNote(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.CreateModerationNoteInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	note, err := c.moderationService.AddNote(auditActor(ctx, currentUser.ID), input)
+	if err != nil {
+		respondModerationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"note": note})
+}
+
+// GetNotes は ?targetType=user&targetId=1 のように対象を指定して運営メモを返します
+func (c *ModerationController) GetNotes(ctx *gin.Context) {
+	targetID, err := strconv.ParseUint(ctx.Query("targetId"), 10, 64)
+	if err != nil || targetID == 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid targetId"})
+		return
+	}
+
+	notes, err := c.moderationService.GetNotes(domainModeration.TargetType(ctx.Query("targetType")), uint(targetID))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get notes"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"notes": notes})
+}
+
+func respondModerationError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	case errors.Is(err, services.ErrAlreadyReported):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,85 @@
+// controllers/notification_controller.go
+
+package controllers
+
+import (
+	domainUser "backend/domain/user"
+	"backend/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type INotificationController interface {
+	GetNotifications(ctx *gin.Context)
+	MarkAsRead(ctx *gin.Context)
+	MarkAllAsRead(ctx *gin.Context)
+}
+
+type NotificationController struct {
+	notificationService services.INotificationService
+}
+
+func NewNotificationController(notificationService services.INotificationService) INotificationController {
+	return &NotificationController{notificationService: notificationService}
+}
+
+func (c *NotificationController) GetNotifications(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	notifications, unread, err := c.notificationService.GetNotifications(currentUser.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get notifications"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"notifications": notifications, "unreadCount": unread})
+}
+
+func (c *NotificationController) MarkAsRead(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	if err := c.notificationService.MarkAsRead(currentUser.ID, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Notification not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update notification"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Notification marked as read"})
+}
+
+func (c *NotificationController) MarkAllAsRead(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	if err := c.notificationService.MarkAllAsRead(currentUser.ID); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update notifications"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "All notifications marked as read"})
+}
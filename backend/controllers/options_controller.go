@@ -3,80 +3,117 @@
 package controllers
 
 import (
+	domainTaxonomy "backend/domain/taxonomy"
+	"backend/dto"
 	"backend/services"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	defaultSuggestions = 10
+	maxSuggestions     = 30
+)
+
 type IOptionsController interface {
 	GetJobTypes(ctx *gin.Context)
 	GetSkills(ctx *gin.Context)
+	SuggestSkills(ctx *gin.Context)
 	GetGenre(ctx *gin.Context)
 }
 
 type OptionsController struct {
-	jobTypeService services.IJobTypeService
-	skillService   services.ISkillService
-	genreService   services.IGenreService
+	taxonomyService services.ITaxonomyService
 }
 
-func NewOptionsController(
-	jobTypeService services.IJobTypeService,
-	skillService services.ISkillService,
-	genreService services.IGenreService,
-) IOptionsController {
-	return &OptionsController{
-		jobTypeService: jobTypeService,
-		skillService:   skillService,
-		genreService:   genreService,
-	}
+func NewOptionsController(taxonomyService services.ITaxonomyService) IOptionsController {
+	return &OptionsController{taxonomyService: taxonomyService}
 }
 
 func (c *OptionsController) GetJobTypes(ctx *gin.Context) {
-	jobTypes, err := c.jobTypeService.GetAllJobTypes()
+	jobTypeNames, err := c.optionNames(domainTaxonomy.KindJobType)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get job types"})
 		return
 	}
 
-	// `Name` フィールドのみを返すようにマッピング
-	var jobTypeNames []string
-	for _, jt := range jobTypes {
-		jobTypeNames = append(jobTypeNames, jt.Name)
-	}
-
 	ctx.JSON(http.StatusOK, gin.H{"jobTypes": jobTypeNames})
 }
 
+// GetSkills はスキル名の一覧と、分類ごとにまとめた一覧を返します
 func (c *OptionsController) GetSkills(ctx *gin.Context) {
-	skills, err := c.skillService.GetAllSkills()
+	skills, err := c.taxonomyService.GetOptions(domainTaxonomy.KindSkill)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get skills"})
 		return
 	}
 
-	// `Name` フィールドのみを返すようにマッピング
 	var skillNames []string
+	byCategory := make(map[domainTaxonomy.SkillCategory][]string)
 	for _, skill := range skills {
 		skillNames = append(skillNames, skill.Name)
+		byCategory[skill.Category] = append(byCategory[skill.Category], skill.Name)
+	}
+	categories := make([]gin.H, 0, len(domainTaxonomy.SkillCategories))
+	for _, category := range domainTaxonomy.SkillCategories {
+		if names := byCategory[category]; len(names) > 0 {
+			categories = append(categories, gin.H{"category": category, "skills": names})
+		}
 	}
 
-	ctx.JSON(http.StatusOK, gin.H{"skills": skillNames})
+	ctx.JSON(http.StatusOK, gin.H{"skills": skillNames, "categories": categories})
 }
 
-func (c *OptionsController) GetGenre(ctx *gin.Context) {
-	genres, err := c.genreService.GetAllGenre()
+// SuggestSkills は GET /options/skills/suggest?q= の入力補完です
+func (c *OptionsController) SuggestSkills(ctx *gin.Context) {
+	var input dto.SuggestInput
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	limit := input.Limit
+	if limit < 1 || limit > maxSuggestions {
+		limit = defaultSuggestions
+	}
+
+	suggestions, err := c.taxonomyService.Suggest(domainTaxonomy.KindSkill, input.Query, limit)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get genres"})
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to suggest skills"})
 		return
 	}
 
-	// `Name` フィールドのみを返すようにマッピング
-	var genreNames []string
-	for _, genre := range genres {
-		genreNames = append(genreNames, genre.Name)
+	results := make([]gin.H, 0, len(suggestions))
+	for _, s := range suggestions {
+		results = append(results, gin.H{
+			"id":           s.Option.ID,
+			"name":         s.Option.Name,
+			"category":     s.Option.Category,
+			"matchedAlias": s.MatchedAlias,
+		})
+	}
+	ctx.JSON(http.StatusOK, gin.H{"suggestions": results})
+}
+
+func (c *OptionsController) GetGenre(ctx *gin.Context) {
+	genreNames, err := c.optionNames(domainTaxonomy.KindGenre)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get genres"})
+		return
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{"genres": genreNames})
 }
+
+// optionNames は廃止済みを除いた選択肢の `Name` フィールドのみを表示順に返します
+func (c *OptionsController) optionNames(kind domainTaxonomy.Kind) ([]string, error) {
+	options, err := c.taxonomyService.GetOptions(kind)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, o := range options {
+		names = append(names, o.Name)
+	}
+	return names, nil
+}
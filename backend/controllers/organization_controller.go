@@ -0,0 +1,181 @@
+// controllers/organization_controller.go
+
+package controllers
+
+import (
+	domainOrganization "backend/domain/organization"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type IOrganizationController interface {
+	CreateOrganization(ctx *gin.Context)
+	GetMyOrganizations(ctx *gin.Context)
+	AddMember(ctx *gin.Context)
+	SetViewDisclosure(ctx *gin.Context)
+	GetPendingOrganizations(ctx *gin.Context)
+	ApproveOrganization(ctx *gin.Context)
+}
+
+type OrganizationController struct {
+	organizationService services.IOrganizationService
+}
+
+func NewOrganizationController(organizationService services.IOrganizationService) IOrganizationController {
+	return &OrganizationController{organizationService: organizationService}
+}
+
+func (c *OrganizationController) CreateOrganization(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.CreateOrganizationInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	org, err := c.organizationService.CreateOrganization(auditActor(ctx, currentUser.ID), input)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"organization": org})
+}
+
+func (c *OrganizationController) GetMyOrganizations(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	orgs, err := c.organizationService.GetMyOrganizations(currentUser.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get organizations"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"organizations": orgs})
+}
+
+func (c *OrganizationController) AddMember(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	orgID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.AddOrganizationMemberInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.organizationService.AddMember(auditActor(ctx, currentUser.ID), orgID, input); err != nil {
+		switch {
+		case errors.Is(err, services.ErrForbidden):
+			ctx.JSON(http.StatusForbidden, gin.H{"error": "Only the owner can add members"})
+		case errors.Is(err, services.ErrUserNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		case errors.Is(err, services.ErrAlreadyMember), errors.Is(err, services.ErrOrganizationNotApproved):
+			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add member"})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"message": "Member added"})
+}
+
+// SetViewDisclosure は採用担当として閲覧したとき、学生に企業名を知らせるかを設定します
+func (c *OrganizationController) SetViewDisclosure(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	orgID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.ViewDisclosureInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.organizationService.SetViewDisclosure(auditActor(ctx, currentUser.ID), orgID, *input.Enabled); err != nil {
+		if errors.Is(err, services.ErrForbidden) {
+			ctx.JSON(http.StatusForbidden, gin.H{"error": "Only members can change this setting"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update view disclosure"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"discloseViews": *input.Enabled})
+}
+
+// GetPendingOrganizations は運営向けに審査待ちの企業アカウントを返します
+func (c *OrganizationController) GetPendingOrganizations(ctx *gin.Context) {
+	orgs, err := c.organizationService.GetPendingOrganizations()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get organizations"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"organizations": orgs})
+}
+
+// ApproveOrganization は運営が企業アカウントを承認し、メンバーを採用担当にします
+func (c *OrganizationController) ApproveOrganization(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	orgID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	org, err := c.organizationService.ApproveOrganization(auditActor(ctx, currentUser.ID), orgID)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Organization not found"})
+		case errors.Is(err, domainOrganization.ErrAlreadyApproved):
+			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to approve organization"})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"organization": org})
+}
@@ -0,0 +1,60 @@
+// controllers/params.go
+
+package controllers
+
+import (
+	domainAudit "backend/domain/audit"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// parseIDParam は URL パラメータの ID を uint に変換します
+// 不正な値の場合は 400 を返し、false を返します
+func parseIDParam(ctx *gin.Context, name string) (uint, bool) {
+	id, err := strconv.ParseUint(ctx.Param(name), 10, 64)
+	if err != nil || id == 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + name})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// auditActor は監査ログに残す操作者とリクエスト元 (IP, User-Agent) をまとめます
+func auditActor(ctx *gin.Context, userID uint) domainAudit.Actor {
+	return domainAudit.Actor{
+		UserID:    userID,
+		IP:        ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+	}
+}
+
+// setETag は版番号を ETag ヘッダーに設定します。更新の If-Match にはこの値をそのまま送る
+func setETag(ctx *gin.Context, version int) {
+	ctx.Header("ETag", fmt.Sprintf(`"%d"`, version))
+}
+
+// ifMatchVersion は If-Match ヘッダーの ETag を版番号に変換します
+// ヘッダーがない場合は 428 を返し、false を返します。"*" は版を問わない（0 を返す）
+// 版番号として読めない ETag（弱い ETag・複数の ETag）はどの版とも一致しないものとして -1 を返す
+func ifMatchVersion(ctx *gin.Context) (int, bool) {
+	value := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if value == "" {
+		ctx.JSON(http.StatusPreconditionRequired, gin.H{"error": "If-Match header is required"})
+		return 0, false
+	}
+	if value == "*" {
+		return 0, true
+	}
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return -1, true
+	}
+	version, err := strconv.Atoi(value[1 : len(value)-1])
+	if err != nil || version <= 0 {
+		return -1, true
+	}
+	return version, true
+}
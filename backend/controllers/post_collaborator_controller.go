@@ -0,0 +1,201 @@
+package controllers
+
+import (
+	domainAudit "backend/domain/audit"
+	domainPortfolio "backend/domain/portfolio"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type IPostCollaboratorController interface {
+	Invite(ctx *gin.Context)
+	GetCollaborators(ctx *gin.Context)
+	GetInvitations(ctx *gin.Context)
+	Accept(ctx *gin.Context)
+	Decline(ctx *gin.Context)
+	UpdateContribution(ctx *gin.Context)
+	Remove(ctx *gin.Context)
+}
+
+type PostCollaboratorController struct {
+	collaboratorService services.IPostCollaboratorService
+}
+
+func NewPostCollaboratorController(collaboratorService services.IPostCollaboratorService) IPostCollaboratorController {
+	return &PostCollaboratorController{collaboratorService: collaboratorService}
+}
+
+// Invite は投稿者が共同制作者を招待します（ユーザー ID かメールアドレスで指定）
+func (c *PostCollaboratorController) Invite(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.InviteCollaboratorInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	collaborator, err := c.collaboratorService.Invite(auditActor(ctx, currentUser.ID), id, input)
+	if err != nil {
+		respondCollaboratorError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"collaborator": collaborator})
+}
+
+func (c *PostCollaboratorController) GetCollaborators(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	collaborators, err := c.collaboratorService.GetCollaborators(currentUser.ID, id)
+	if err != nil {
+		respondCollaboratorError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collaborators": collaborators})
+}
+
+// GetInvitations はログイン中のユーザーへの招待中の依頼を返します
+func (c *PostCollaboratorController) GetInvitations(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	invitations, err := c.collaboratorService.GetInvitations(currentUser.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get invitations"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"invitations": invitations})
+}
+
+// Accept は招待を承諾します。本人の担当と貢献内容を同時に登録できます
+func (c *PostCollaboratorController) Accept(ctx *gin.Context) {
+	c.saveContribution(ctx, c.collaboratorService.Accept)
+}
+
+func (c *PostCollaboratorController) UpdateContribution(ctx *gin.Context) {
+	c.saveContribution(ctx, c.collaboratorService.UpdateContribution)
+}
+
+func (c *PostCollaboratorController) saveContribution(ctx *gin.Context,
+	save func(domainAudit.Actor, uint, dto.ContributionInput) (*domainPortfolio.Collaborator, error)) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.ContributionInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	collaborator, err := save(auditActor(ctx, currentUser.ID), id, input)
+	if err != nil {
+		respondCollaboratorError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"collaborator": collaborator})
+}
+
+func (c *PostCollaboratorController) Decline(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	if err := c.collaboratorService.Decline(auditActor(ctx, currentUser.ID), id); err != nil {
+		respondCollaboratorError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Invitation declined"})
+}
+
+// Remove は投稿者が共同制作者を外します。:userId に自分を指定すると共同制作者から抜けます
+func (c *PostCollaboratorController) Remove(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+	userID, ok := parseIDParam(ctx, "userId")
+	if !ok {
+		return
+	}
+
+	if err := c.collaboratorService.Remove(auditActor(ctx, currentUser.ID), id, userID); err != nil {
+		respondCollaboratorError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Collaborator removed"})
+}
+
+func respondCollaboratorError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	case errors.Is(err, services.ErrUserNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+	case errors.Is(err, services.ErrForbidden):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "Only the author of the post can do this"})
+	case errors.Is(err, services.ErrAlreadyCollaborator), errors.Is(err, domainPortfolio.ErrInvalidTransition):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	domainUser "backend/domain/user"
+	"backend/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IPostImportController interface {
+	Import(ctx *gin.Context)
+}
+
+type PostImportController struct {
+	postImportService services.IPostImportService
+}
+
+func NewPostImportController(postImportService services.IPostImportService) IPostImportController {
+	return &PostImportController{postImportService: postImportService}
+}
+
+// Import は manifest.json と画像を入れた ZIP（フォームの file）から投稿を下書きとして一括で取り込みます
+// 1 件でも取り込めれば 201 で、取り込めなかった投稿は errors に理由を入れて返す
+func (c *PostImportController) Import(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	file, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "File is required"})
+		return
+	}
+
+	result, err := c.postImportService.Import(auditActor(ctx, currentUser.ID), file)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	status := http.StatusCreated
+	if len(result.Imported) == 0 {
+		status = http.StatusUnprocessableEntity
+	}
+	ctx.JSON(status, gin.H{"imported": result.Imported, "errors": result.Errors})
+}
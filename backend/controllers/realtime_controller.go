@@ -0,0 +1,117 @@
+// controllers/realtime_controller.go
+
+package controllers
+
+import (
+	domainRealtime "backend/domain/realtime"
+	domainUser "backend/domain/user"
+	"backend/services"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// heartbeatInterval はプロキシにアイドル切断されないよう送るコメント行の間隔です
+const heartbeatInterval = 25 * time.Second
+
+type IRealtimeController interface {
+	Stream(ctx *gin.Context)
+}
+
+type RealtimeController struct {
+	realtimeService services.IRealtimeService
+}
+
+func NewRealtimeController(realtimeService services.IRealtimeService) IRealtimeController {
+	return &RealtimeController{realtimeService: realtimeService}
+}
+
+// Stream は Server-Sent Events でログインユーザー宛てのイベントを配信します
+// 再接続時はブラウザが送る Last-Event-ID 以降のイベントを先に再送します
+func (c *RealtimeController) Stream(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	// EventSource は独自ヘッダーを付けられないので、初回接続用にクエリでも受け付ける
+	lastEventIDStr := ctx.GetHeader("Last-Event-ID")
+	if lastEventIDStr == "" {
+		lastEventIDStr = ctx.Query("lastEventId")
+	}
+	var lastEventID uint
+	if lastEventIDStr != "" {
+		id, err := strconv.ParseUint(lastEventIDStr, 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid Last-Event-ID"})
+			return
+		}
+		lastEventID = uint(id)
+	}
+
+	sub, err := c.realtimeService.Subscribe(currentUser.ID, lastEventID)
+	if err != nil {
+		if errors.Is(err, services.ErrTooManyConnections) {
+			ctx.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many connections"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to subscribe"})
+		return
+	}
+	defer c.realtimeService.Unsubscribe(sub)
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no") // nginx のバッファリングを無効化
+	ctx.Status(http.StatusOK)
+
+	// 切断時にブラウザが再接続するまでの待ち時間 (ms)
+	fmt.Fprint(ctx.Writer, "retry: 3000\n\n")
+
+	sentID := lastEventID
+	if sub.Reset {
+		// id を付けないので、ライブ配信が届くまでは Last-Event-ID は据え置きになる
+		fmt.Fprintf(ctx.Writer, "event: %s\ndata: {}\n\n", domainRealtime.EventReset)
+	}
+	for _, e := range sub.Backlog {
+		writeEvent(ctx, e)
+		sentID = e.ID
+	}
+	ctx.Writer.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+		case e, ok := <-sub.Events:
+			if !ok {
+				// サーバー側から切断された（受信遅延など）。クライアントは再接続する
+				return
+			}
+			// 再送分と重複したイベントは読み飛ばす
+			if e.ID <= sentID {
+				continue
+			}
+			writeEvent(ctx, e)
+			sentID = e.ID
+			ctx.Writer.Flush()
+		case <-heartbeat.C:
+			fmt.Fprint(ctx.Writer, ": heartbeat\n\n")
+			ctx.Writer.Flush()
+		}
+	}
+}
+
+func writeEvent(ctx *gin.Context, e *domainRealtime.Event) {
+	fmt.Fprintf(ctx.Writer, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, e.Data)
+}
@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	domainUser "backend/domain/user"
+	"backend/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type IRecommendationController interface {
+	GetSimilar(ctx *gin.Context)
+	GetRecommended(ctx *gin.Context)
+}
+
+type RecommendationController struct {
+	recommendationService services.IRecommendationService
+}
+
+func NewRecommendationController(recommendationService services.IRecommendationService) IRecommendationController {
+	return &RecommendationController{recommendationService: recommendationService}
+}
+
+// GetSimilar は投稿詳細の下に表示する似ている投稿を返します
+func (c *RecommendationController) GetSimilar(ctx *gin.Context) {
+	if _, exists := ctx.Get("user"); !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	postID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	posts, err := c.recommendationService.GetSimilar(postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get similar posts"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"posts": posts})
+}
+
+func (c *RecommendationController) GetRecommended(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	posts, err := c.recommendationService.GetRecommended(currentUser.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get recommended posts"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"posts": posts})
+}
@@ -0,0 +1,147 @@
+// controllers/recruit_controller.go
+
+package controllers
+
+import (
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultCandidatesPerPage = 20
+	maxCandidatesPerPage     = 100
+)
+
+type IRecruitController interface {
+	SearchCandidates(ctx *gin.Context)
+}
+
+type RecruitController struct {
+	recruitService services.IRecruitService
+}
+
+func NewRecruitController(recruitService services.IRecruitService) IRecruitController {
+	return &RecruitController{recruitService: recruitService}
+}
+
+func (c *RecruitController) SearchCandidates(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.CandidateSearchInput
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := c.recruitService.SearchCandidates(currentUser.ID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "Job not found"})
+		case errors.Is(err, services.ErrForbidden):
+			ctx.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search candidates"})
+		}
+		return
+	}
+
+	// CSV は検索結果をページングせずに上限まで出力する
+	if input.Format == "csv" {
+		writeCandidatesCSV(ctx, result)
+		return
+	}
+
+	candidates := result.Candidates
+	page := input.Page
+	if page < 1 {
+		page = 1
+	}
+	perPage := input.PerPage
+	if perPage < 1 {
+		perPage = defaultCandidatesPerPage
+	}
+	if perPage > maxCandidatesPerPage {
+		perPage = maxCandidatesPerPage
+	}
+	start := (page - 1) * perPage
+	if start > len(candidates) {
+		start = len(candidates)
+	}
+	end := start + perPage
+	if end > len(candidates) {
+		end = len(candidates)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"candidates": candidates[start:end],
+		"total":      result.Total,
+		"truncated":  result.Truncated,
+		"limit":      result.Limit,
+		"page":       page,
+		"perPage":    perPage,
+	})
+}
+
+// writeCandidatesCSV は候補者一覧を Excel でも文字化けしない BOM 付き UTF-8 の CSV で返します
+// 上限で切り詰めた場合はヘッダーと末尾の注記行で知らせる
+func writeCandidatesCSV(ctx *gin.Context, result *services.CandidateSearchResult) {
+	filename := fmt.Sprintf("candidates_%s.csv", time.Now().Format("20060102150405"))
+	ctx.Header("Content-Type", "text/csv; charset=utf-8")
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	ctx.Header("X-Total-Count", strconv.Itoa(result.Total))
+	ctx.Header("X-Truncated", strconv.FormatBool(result.Truncated))
+	ctx.Status(http.StatusOK)
+
+	ctx.Writer.Write([]byte("\xEF\xBB\xBF"))
+	w := csv.NewWriter(ctx.Writer)
+	w.Write([]string{"ID", "氏名", "氏名（カナ）", "学校名", "学部・学科", "研究室", "卒業年", "希望職種", "スキル", "作品ジャンル", "作品数", "関連度", "一致スキル"})
+	for _, c := range result.Candidates {
+		u := c.User
+		writeCSVRow(w, []string{
+			strconv.FormatUint(uint64(u.ID), 10),
+			u.LastName + " " + u.FirstName,
+			u.LastNameKana + " " + u.FirstNameKana,
+			u.SchoolName,
+			u.Department,
+			u.Laboratory,
+			u.GraduationYear,
+			strings.Join(u.DesiredJobTypes, " / "),
+			strings.Join(c.AllSkills(), " / "),
+			strings.Join(c.PostGenres, " / "),
+			strconv.Itoa(c.PostCount),
+			strconv.FormatFloat(c.Score, 'f', 2, 64),
+			strings.Join(c.MatchedSkills, " / "),
+		})
+	}
+	if result.Truncated {
+		w.Write([]string{fmt.Sprintf("※ 該当 %d 人のうち、関連度の高い上位 %d 人のみ出力しています", result.Total, len(result.Candidates))})
+	}
+	w.Flush()
+}
+
+// writeCSVRow は学生が入力した値が表計算ソフトで数式として解釈されないようにして書き込みます
+func writeCSVRow(w *csv.Writer, row []string) {
+	for i, v := range row {
+		if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+			row[i] = "'" + v
+		}
+	}
+	w.Write(row)
+}
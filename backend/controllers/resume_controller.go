@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type IResumeController interface {
+	GetResumePDF(ctx *gin.Context)
+	GetJSONResume(ctx *gin.Context)
+}
+
+type ResumeController struct {
+	resumeService services.IResumeService
+}
+
+func NewResumeController(resumeService services.IResumeService) IResumeController {
+	return &ResumeController{resumeService: resumeService}
+}
+
+// GetResumePDF は本人の履歴書を PDF でダウンロードさせます
+func (c *ResumeController) GetResumePDF(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var query dto.ResumeQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pdf, err := c.resumeService.RenderPDF(currentUser.ID, query)
+	if err != nil {
+		respondResumeError(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="resume.pdf"`)
+	ctx.Data(http.StatusOK, "application/pdf", pdf)
+}
+
+// GetJSONResume は本人の履歴書を JSON Resume 形式で返します
+func (c *ResumeController) GetJSONResume(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var query dto.ResumeQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resume, err := c.resumeService.ExportJSONResume(currentUser.ID, query)
+	if err != nil {
+		respondResumeError(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="resume.json"`)
+	ctx.JSON(http.StatusOK, resume)
+}
+
+func respondResumeError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
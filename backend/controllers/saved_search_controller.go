@@ -0,0 +1,188 @@
+// controllers/saved_search_controller.go
+
+package controllers
+
+import (
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"html"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type ISavedSearchController interface {
+	CreateSavedSearch(ctx *gin.Context)
+	GetSavedSearches(ctx *gin.Context)
+	UpdateSavedSearch(ctx *gin.Context)
+	DeleteSavedSearch(ctx *gin.Context)
+	ConfirmUnsubscribe(ctx *gin.Context)
+	Unsubscribe(ctx *gin.Context)
+}
+
+type SavedSearchController struct {
+	savedSearchService services.ISavedSearchService
+}
+
+func NewSavedSearchController(savedSearchService services.ISavedSearchService) ISavedSearchController {
+	return &SavedSearchController{savedSearchService: savedSearchService}
+}
+
+func (c *SavedSearchController) CreateSavedSearch(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.CreateSavedSearchInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	search, err := c.savedSearchService.CreateSavedSearch(currentUser, input)
+	if err != nil {
+		respondSavedSearchError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"savedSearch": search})
+}
+
+func (c *SavedSearchController) GetSavedSearches(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	searches, err := c.savedSearchService.GetSavedSearches(currentUser.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get saved searches"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"savedSearches": searches})
+}
+
+func (c *SavedSearchController) UpdateSavedSearch(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.UpdateSavedSearchInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	search, err := c.savedSearchService.UpdateSavedSearch(currentUser.ID, id, input)
+	if err != nil {
+		respondSavedSearchError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"savedSearch": search})
+}
+
+func (c *SavedSearchController) DeleteSavedSearch(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	if err := c.savedSearchService.DeleteSavedSearch(currentUser.ID, id); err != nil {
+		respondSavedSearchError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Saved search deleted successfully"})
+}
+
+// ConfirmUnsubscribe はメールの配信停止リンクから開かれる確認ページを返します
+// リンクを先読みするメールスキャナーで配信が止まらないよう、GET では何も変更しない
+func (c *SavedSearchController) ConfirmUnsubscribe(ctx *gin.Context) {
+	token := ctx.Query("token")
+	if token == "" {
+		ctx.Data(http.StatusBadRequest, "text/html; charset=utf-8", []byte(unsubscribePage("配信停止リンクが正しくありません。")))
+		return
+	}
+
+	form := `<p>新着通知メールの配信を停止しますか？</p>
+    <form method="post" action="unsubscribe">
+        <input type="hidden" name="token" value="` + html.EscapeString(token) + `">
+        <button type="submit">配信を停止する</button>
+    </form>`
+	ctx.Data(http.StatusOK, "text/html; charset=utf-8", []byte(unsubscribeLayout(form)))
+}
+
+// Unsubscribe は確認ページのフォームと、メールクライアントのワンクリック配信停止（RFC 8058）から呼ばれます
+// ワンクリックの場合は本文が List-Unsubscribe=One-Click で、トークンは URL のクエリに入っている
+func (c *SavedSearchController) Unsubscribe(ctx *gin.Context) {
+	token := ctx.Query("token")
+	if token == "" {
+		token = ctx.PostForm("token")
+	}
+	if token == "" {
+		ctx.Data(http.StatusBadRequest, "text/html; charset=utf-8", []byte(unsubscribePage("配信停止リンクが正しくありません。")))
+		return
+	}
+
+	if err := c.savedSearchService.Unsubscribe(token); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.Data(http.StatusNotFound, "text/html; charset=utf-8", []byte(unsubscribePage("配信停止リンクが見つかりません。検索条件が削除された可能性があります。")))
+			return
+		}
+		ctx.Data(http.StatusInternalServerError, "text/html; charset=utf-8", []byte(unsubscribePage("配信停止に失敗しました。時間をおいて再度お試しください。")))
+		return
+	}
+
+	ctx.Data(http.StatusOK, "text/html; charset=utf-8", []byte(unsubscribePage("新着通知メールの配信を停止しました。")))
+}
+
+func unsubscribePage(message string) string {
+	return unsubscribeLayout("<p>" + message + "</p>")
+}
+
+func unsubscribeLayout(content string) string {
+	return `<!DOCTYPE html>
+<html lang="ja">
+<head><meta charset="utf-8"><title>配信停止 | エンジニアのポートフォリオ</title></head>
+<body style="font-family: Arial, sans-serif; color: #333; text-align: center; padding-top: 80px;">
+    <h2 style="color: #F15A24;">エンジニアのポートフォリオ</h2>
+    ` + content + `
+</body>
+</html>`
+}
+
+func respondSavedSearchError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	case errors.Is(err, services.ErrForbidden):
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	domainSiteExport "backend/domain/siteexport"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type ISiteExportController interface {
+	RequestExport(ctx *gin.Context)
+	GetExports(ctx *gin.Context)
+	GetExport(ctx *gin.Context)
+	Download(ctx *gin.Context)
+}
+
+type SiteExportController struct {
+	siteExportService services.ISiteExportService
+}
+
+func NewSiteExportController(siteExportService services.ISiteExportService) ISiteExportController {
+	return &SiteExportController{siteExportService: siteExportService}
+}
+
+// siteExportView は書き出しの依頼に、ダウンロードできるときだけダウンロード先を付けたものです
+type siteExportView struct {
+	*domainSiteExport.Export
+	DownloadURL string `json:"downloadUrl,omitempty"`
+}
+
+func newSiteExportView(e *domainSiteExport.Export) siteExportView {
+	view := siteExportView{Export: e}
+	if e.IsDownloadable(time.Now()) {
+		view.DownloadURL = fmt.Sprintf("/user/export-site/%d/download", e.ID)
+	}
+	return view
+}
+
+// RequestExport は静的サイトの書き出しを受け付けます。ZIP はバックグラウンドで作り、できたら通知する
+func (c *SiteExportController) RequestExport(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	var input dto.SiteExportInput
+	// 本文なしで呼ばれた場合は既定のテーマで書き出す
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&input); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	export, err := c.siteExportService.RequestExport(currentUser.ID, input)
+	if err != nil {
+		respondSiteExportError(ctx, err)
+		return
+	}
+
+	ctx.Header("Location", fmt.Sprintf("/user/export-site/%d", export.ID))
+	ctx.JSON(http.StatusAccepted, gin.H{"export": newSiteExportView(export)})
+}
+
+func (c *SiteExportController) GetExports(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	exports, err := c.siteExportService.GetExports(currentUser.ID)
+	if err != nil {
+		respondSiteExportError(ctx, err)
+		return
+	}
+
+	views := make([]siteExportView, 0, len(exports))
+	for _, e := range exports {
+		views = append(views, newSiteExportView(e))
+	}
+	ctx.JSON(http.StatusOK, gin.H{"exports": views})
+}
+
+func (c *SiteExportController) GetExport(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	export, err := c.siteExportService.GetExport(currentUser.ID, id)
+	if err != nil {
+		respondSiteExportError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"export": newSiteExportView(export)})
+}
+
+// Download は書き出した ZIP をダウンロードさせます
+func (c *SiteExportController) Download(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	export, err := c.siteExportService.GetDownload(currentUser.ID, id)
+	if err != nil {
+		respondSiteExportError(ctx, err)
+		return
+	}
+
+	ctx.FileAttachment(export.FilePath, export.FileName())
+}
+
+func respondSiteExportError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	case errors.Is(err, services.ErrSiteExportInProgress), errors.Is(err, services.ErrSiteExportNotReady):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,268 @@
+// controllers/taxonomy_controller.go
+
+package controllers
+
+import (
+	domainTaxonomy "backend/domain/taxonomy"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// ITaxonomyController は運営向けの選択肢（職種・スキル・ジャンル）管理 API です
+// パスの :kind には "job-types", "skills", "genres" を指定します
+type ITaxonomyController interface {
+	ListOptions(ctx *gin.Context)
+	CreateOption(ctx *gin.Context)
+	UpdateOption(ctx *gin.Context)
+	DeleteOption(ctx *gin.Context)
+	MergeOption(ctx *gin.Context)
+	RetireOption(ctx *gin.Context)
+	RestoreOption(ctx *gin.Context)
+	ReorderOptions(ctx *gin.Context)
+}
+
+type TaxonomyController struct {
+	taxonomyService services.ITaxonomyService
+}
+
+func NewTaxonomyController(taxonomyService services.ITaxonomyService) ITaxonomyController {
+	return &TaxonomyController{taxonomyService: taxonomyService}
+}
+
+func (c *TaxonomyController) ListOptions(ctx *gin.Context) {
+	kind, ok := parseKindParam(ctx)
+	if !ok {
+		return
+	}
+
+	options, err := c.taxonomyService.ListAllOptions(kind)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get options"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"options": options})
+}
+
+func (c *TaxonomyController) CreateOption(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	kind, ok := parseKindParam(ctx)
+	if !ok {
+		return
+	}
+
+	var input dto.TaxonomyOptionInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	option, err := c.taxonomyService.CreateOption(auditActor(ctx, currentUser.ID), kind, input)
+	if err != nil {
+		respondTaxonomyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"option": option})
+}
+
+func (c *TaxonomyController) UpdateOption(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	kind, ok := parseKindParam(ctx)
+	if !ok {
+		return
+	}
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.TaxonomyOptionInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	option, err := c.taxonomyService.UpdateOption(auditActor(ctx, currentUser.ID), kind, id, input)
+	if err != nil {
+		respondTaxonomyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"option": option})
+}
+
+func (c *TaxonomyController) DeleteOption(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	kind, ok := parseKindParam(ctx)
+	if !ok {
+		return
+	}
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	if err := c.taxonomyService.DeleteOption(auditActor(ctx, currentUser.ID), kind, id); err != nil {
+		respondTaxonomyError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *TaxonomyController) MergeOption(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	kind, ok := parseKindParam(ctx)
+	if !ok {
+		return
+	}
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	var input dto.MergeTaxonomyOptionInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	target, rewritten, err := c.taxonomyService.MergeOption(auditActor(ctx, currentUser.ID), kind, id, input.TargetID)
+	if err != nil {
+		respondTaxonomyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"option": target, "rewrittenRows": rewritten})
+}
+
+func (c *TaxonomyController) RetireOption(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	kind, ok := parseKindParam(ctx)
+	if !ok {
+		return
+	}
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	option, err := c.taxonomyService.RetireOption(auditActor(ctx, currentUser.ID), kind, id)
+	if err != nil {
+		respondTaxonomyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"option": option})
+}
+
+func (c *TaxonomyController) RestoreOption(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	kind, ok := parseKindParam(ctx)
+	if !ok {
+		return
+	}
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	option, err := c.taxonomyService.RestoreOption(auditActor(ctx, currentUser.ID), kind, id)
+	if err != nil {
+		respondTaxonomyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"option": option})
+}
+
+func (c *TaxonomyController) ReorderOptions(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	kind, ok := parseKindParam(ctx)
+	if !ok {
+		return
+	}
+
+	var input dto.ReorderTaxonomyOptionsInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	options, err := c.taxonomyService.ReorderOptions(auditActor(ctx, currentUser.ID), kind, input.IDs)
+	if err != nil {
+		respondTaxonomyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"options": options})
+}
+
+func parseKindParam(ctx *gin.Context) (domainTaxonomy.Kind, bool) {
+	kind, err := domainTaxonomy.ParseKind(ctx.Param("kind"))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return "", false
+	}
+	return kind, true
+}
+
+func respondTaxonomyError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Option not found"})
+	case errors.Is(err, services.ErrDuplicateOption):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
@@ -3,9 +3,11 @@
 package controllers
 
 import (
+	domainCareer "backend/domain/career"
 	domainUser "backend/domain/user"
 	"backend/dto"
 	"backend/services"
+	"errors"
 	"mime/multipart"
 	"net/http"
 
@@ -15,14 +17,32 @@ import (
 type IUserController interface {
 	GetUserInfo(ctx *gin.Context)
 	UpdateMinimumUserInfo(ctx *gin.Context)
+	UpdatePrivacySettings(ctx *gin.Context)
+	GetProfile(ctx *gin.Context)
 }
 
 type UserController struct {
-	userService services.IUserService
+	userService         services.IUserService
+	userSkillService    services.IUserSkillService
+	careerService       services.ICareerService
+	externalLinkService services.IExternalLinkService
+	portfolioService    services.IPortfolioService
 }
 
-func NewUserController(userService services.IUserService) IUserController {
-	return &UserController{userService: userService}
+func NewUserController(
+	userService services.IUserService,
+	userSkillService services.IUserSkillService,
+	careerService services.ICareerService,
+	externalLinkService services.IExternalLinkService,
+	portfolioService services.IPortfolioService,
+) IUserController {
+	return &UserController{
+		userService:         userService,
+		userSkillService:    userSkillService,
+		careerService:       careerService,
+		externalLinkService: externalLinkService,
+		portfolioService:    portfolioService,
+	}
 }
 
 func (c *UserController) GetUserInfo(ctx *gin.Context) {
@@ -36,15 +56,36 @@ func (c *UserController) GetUserInfo(ctx *gin.Context) {
 	userID := user.(*domainUser.UserModel).ID
 
 	// ユーザー情報を取得
-	user, err := c.userService.GetUserByID(userID)
+	info, err := c.userService.GetUserByID(userID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user info"})
 		return
 	}
 
-	// ユーザー情報を返す
+	skills, err := c.userSkillService.GetSkills(userID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user skills"})
+		return
+	}
+	educations, experiences, err := c.getCareer(userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user career"})
+		return
+	}
+	links, err := c.externalLinkService.GetLinks(userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user links"})
+		return
+	}
+
+	// ユーザー情報を返す。プロフィールを更新するときは ETag を If-Match に送る
+	setETag(ctx, info.Version)
 	ctx.JSON(http.StatusOK, gin.H{
-		"user": user,
+		"user":        info,
+		"skills":      skills,
+		"educations":  educations,
+		"experiences": experiences,
+		"links":       links,
 	})
 }
 
@@ -56,6 +97,11 @@ func (c *UserController) UpdateMinimumUserInfo(ctx *gin.Context) {
 	}
 	currentUser := user.(*domainUser.UserModel)
 
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return
+	}
+
 	var input dto.MinimumUserInfoInput
 	var fileHeaders []*multipart.FileHeader
 
@@ -83,14 +129,130 @@ func (c *UserController) UpdateMinimumUserInfo(ctx *gin.Context) {
 	}
 
 	// サービス層へ
-	updatedUser, err := c.userService.UpdateMinimumUserInfo(currentUser.ID, input, fileHeaders)
+	updatedUser, err := c.userService.UpdateMinimumUserInfo(auditActor(ctx, currentUser.ID), version, input, fileHeaders)
+	if errors.Is(err, domainUser.ErrVersionConflict) {
+		respondUserConflict(ctx, c.userService, currentUser.ID, err)
+		return
+	}
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update user info"})
 		return
 	}
 
+	setETag(ctx, updatedUser.Version)
 	ctx.JSON(http.StatusOK, gin.H{
 		"message": "User info updated",
 		"user":    updatedUser,
 	})
 }
+
+func (c *UserController) UpdatePrivacySettings(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return
+	}
+
+	var input dto.PrivacySettingsInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updatedUser, err := c.userService.UpdatePrivacySettings(auditActor(ctx, currentUser.ID), version, input)
+	if errors.Is(err, domainUser.ErrVersionConflict) {
+		respondUserConflict(ctx, c.userService, currentUser.ID, err)
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	setETag(ctx, updatedUser.Version)
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Privacy settings updated",
+		"user":    updatedUser,
+	})
+}
+
+// respondUserConflict はほかの操作が先にプロフィールを保存していた場合に、412 で今のユーザーと ETag を返します
+func respondUserConflict(ctx *gin.Context, userService services.IUserService, userID uint, err error) {
+	current, getErr := userService.GetUserByID(userID)
+	if getErr != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user info"})
+		return
+	}
+	setETag(ctx, current.Version)
+	ctx.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error(), "user": current})
+}
+
+// GetProfile は他のユーザーのプロフィールを、推薦の多い順に並べたスキルと一緒に返します
+func (c *UserController) GetProfile(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	id, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+
+	profile, err := c.userService.GetProfile(currentUser.ID, id)
+	if err != nil {
+		respondUserSkillError(ctx, err)
+		return
+	}
+	skills, err := c.userSkillService.GetSkills(currentUser.ID, id)
+	if err != nil {
+		respondUserSkillError(ctx, err)
+		return
+	}
+	educations, experiences, err := c.getCareer(id)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user career"})
+		return
+	}
+	links, err := c.externalLinkService.GetLinks(id)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user links"})
+		return
+	}
+	// 共同制作者として参加した作品も含める
+	posts, err := c.portfolioService.GetPostsByUserID(id)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user posts"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"user":        profile,
+		"skills":      skills,
+		"educations":  educations,
+		"experiences": experiences,
+		"links":       links,
+		"posts":       posts,
+	})
+}
+
+// getCareer はプロフィールに表示する学歴・職歴をまとめて取得します
+func (c *UserController) getCareer(userID uint) ([]*domainCareer.Education, []*domainCareer.Experience, error) {
+	educations, err := c.careerService.GetEducations(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	experiences, err := c.careerService.GetExperiences(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return educations, experiences, nil
+}
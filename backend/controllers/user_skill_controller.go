@@ -0,0 +1,125 @@
+// controllers/user_skill_controller.go
+
+package controllers
+
+import (
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"backend/services"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type IUserSkillController interface {
+	UpdateSkills(ctx *gin.Context)
+	Endorse(ctx *gin.Context)
+	WithdrawEndorsement(ctx *gin.Context)
+}
+
+type UserSkillController struct {
+	userSkillService services.IUserSkillService
+	userService      services.IUserService
+}
+
+func NewUserSkillController(userSkillService services.IUserSkillService, userService services.IUserService) IUserSkillController {
+	return &UserSkillController{userSkillService: userSkillService, userService: userService}
+}
+
+func (c *UserSkillController) UpdateSkills(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return
+	}
+
+	var input dto.UpdateUserSkillsInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	skills, newVersion, err := c.userSkillService.UpdateSkills(auditActor(ctx, currentUser.ID), version, input)
+	if errors.Is(err, domainUser.ErrVersionConflict) {
+		respondUserConflict(ctx, c.userService, currentUser.ID, err)
+		return
+	}
+	if err != nil {
+		respondUserSkillError(ctx, err)
+		return
+	}
+
+	setETag(ctx, newVersion)
+	ctx.JSON(http.StatusOK, gin.H{"skills": skills})
+}
+
+func (c *UserSkillController) Endorse(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	userID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+	skillID, ok := parseIDParam(ctx, "skillId")
+	if !ok {
+		return
+	}
+
+	skill, err := c.userSkillService.Endorse(currentUser.ID, userID, skillID)
+	if err != nil {
+		respondUserSkillError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"skill": skill})
+}
+
+func (c *UserSkillController) WithdrawEndorsement(ctx *gin.Context) {
+	user, exists := ctx.Get("user")
+	if !exists {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	currentUser := user.(*domainUser.UserModel)
+
+	userID, ok := parseIDParam(ctx, "id")
+	if !ok {
+		return
+	}
+	skillID, ok := parseIDParam(ctx, "skillId")
+	if !ok {
+		return
+	}
+
+	skill, err := c.userSkillService.WithdrawEndorsement(currentUser.ID, userID, skillID)
+	if err != nil {
+		respondUserSkillError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"skill": skill})
+}
+
+func respondUserSkillError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	case errors.Is(err, services.ErrAlreadyEndorsed):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,182 @@
+// backend/domain/analytics/entity.go
+package analytics
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TargetType は閲覧された対象の種類です
+type TargetType string
+
+const (
+	TargetPost    TargetType = "post"
+	TargetProfile TargetType = "profile"
+)
+
+// ParseTargetType は文字列を閲覧対象の種類に変換します
+func ParseTargetType(s string) (TargetType, error) {
+	switch TargetType(s) {
+	case TargetPost, TargetProfile:
+		return TargetType(s), nil
+	}
+	return "", fmt.Errorf("閲覧対象の種類が不正です: %s", s)
+}
+
+// Location は閲覧数を日ごとに区切るタイムゾーンです（利用者に合わせて日本時間）
+var Location = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+const (
+	dayLayout          = "2006-01-02"
+	maxReferrerLength  = 255
+	visitorHashLength  = 32
+	MaxInsightDays     = 365
+	DefaultInsightDays = 30
+)
+
+// Day は t を Location の日付 ("2006-01-02") にします
+func Day(t time.Time) string {
+	return t.In(Location).Format(dayLayout)
+}
+
+// ParseDay は "2006-01-02" を Location の 0 時として読み込みます
+func ParseDay(day string) (time.Time, error) {
+	t, err := time.ParseInLocation(dayLayout, day, Location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("日付の形式が不正です: %s", day)
+	}
+	return t, nil
+}
+
+// Visit は閲覧の記録の依頼です。ViewerID が 0 の場合は未ログインの閲覧者
+type Visit struct {
+	TargetType TargetType
+	TargetID   uint
+	ViewerID   uint
+	IP         string
+	UserAgent  string
+	Referrer   string // 閲覧者がどこから来たか（ページの document.referrer）
+	At         time.Time
+}
+
+// View は閲覧 1 件の生データです。同じ閲覧者・同じ対象は 1 日 1 件だけ記録する
+type View struct {
+	ID             uint
+	TargetType     TargetType
+	TargetID       uint
+	OwnerID        uint // 閲覧された投稿の投稿者・プロフィールの本人
+	ViewerID       uint
+	VisitorKey     string // 重複を除くための閲覧者の識別子。未ログインの場合は IP と User-Agent のハッシュ
+	OrganizationID *uint  // 採用担当が企業名の表示を許可している場合の企業
+	ReferrerHost   string // 外部サイトから来た場合のホスト名。直接・サイト内の移動は空
+	Day            string
+	CreatedAt      time.Time
+}
+
+// NewView は閲覧の依頼から記録する閲覧を作ります
+// salt は未ログインの閲覧者の IP と User-Agent をハッシュにするときの秘密の値、ownHost は自サイトのホスト名です
+func NewView(v Visit, ownerID uint, organizationID *uint, salt, ownHost string) *View {
+	day := Day(v.At)
+	return &View{
+		TargetType:     v.TargetType,
+		TargetID:       v.TargetID,
+		OwnerID:        ownerID,
+		ViewerID:       v.ViewerID,
+		VisitorKey:     VisitorKey(v.ViewerID, v.IP, v.UserAgent, salt, day),
+		OrganizationID: organizationID,
+		ReferrerHost:   ReferrerHost(v.Referrer, ownHost),
+		Day:            day,
+		CreatedAt:      v.At,
+	}
+}
+
+// VisitorKey は 1 日の中で同じ閲覧者を見分ける識別子を返します
+// 未ログインの閲覧者は IP と User-Agent をそのまま保存せず、日付を含めたハッシュにするので日をまたいで追跡できない
+func VisitorKey(viewerID uint, ip, userAgent, salt, day string) string {
+	if viewerID != 0 {
+		return fmt.Sprintf("u:%d", viewerID)
+	}
+	sum := sha256.Sum256([]byte(salt + "\x00" + day + "\x00" + ip + "\x00" + userAgent))
+	return "a:" + hex.EncodeToString(sum[:])[:visitorHashLength]
+}
+
+// ReferrerHost は参照元の URL からホスト名だけを取り出します（"www." は除く）
+// 自サイト内の移動・http(s) 以外・不正な URL は空を返す
+func ReferrerHost(referrer, ownHost string) string {
+	u, err := url.Parse(strings.TrimSpace(referrer))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if host == "" || host == strings.TrimPrefix(strings.ToLower(ownHost), "www.") || len(host) > maxReferrerLength {
+		return ""
+	}
+	return host
+}
+
+// DailyViews は 1 日の閲覧数です
+type DailyViews struct {
+	Date         string `json:"date"`
+	PostViews    int    `json:"postViews"`
+	ProfileViews int    `json:"profileViews"`
+}
+
+// PostViews は投稿ごとの閲覧数です
+type PostViews struct {
+	PostID uint   `json:"postId"`
+	Title  string `json:"title"`
+	Views  int    `json:"views"`
+}
+
+// ReferrerViews は参照元ごとの閲覧数です。Host が空の場合は直接・サイト内からの閲覧
+type ReferrerViews struct {
+	Host  string `json:"host"`
+	Views int    `json:"views"`
+}
+
+// OrganizationViews は企業ごとの閲覧数です（企業名の表示を許可した採用担当の閲覧のみ）
+type OrganizationViews struct {
+	OrganizationID uint   `json:"organizationId"`
+	Name           string `json:"name"`
+	Views          int    `json:"views"`
+}
+
+// Insights は本人向けの閲覧の集計です。閲覧数は 1 日 1 閲覧者 1 回として数える
+type Insights struct {
+	From              string              `json:"from"`
+	To                string              `json:"to"`
+	TotalPostViews    int                 `json:"totalPostViews"`
+	TotalProfileViews int                 `json:"totalProfileViews"`
+	Series            []DailyViews        `json:"series"`
+	TopPosts          []PostViews         `json:"topPosts"`
+	Referrers         []ReferrerViews     `json:"referrers"`
+	Organizations     []OrganizationViews `json:"organizations"`
+}
+
+// FillSeries は from から to までの日ごとの閲覧数を、閲覧のなかった日を 0 で埋めて返します
+func FillSeries(from, to string, rows []DailyViews) ([]DailyViews, error) {
+	start, err := ParseDay(from)
+	if err != nil {
+		return nil, err
+	}
+	end, err := ParseDay(to)
+	if err != nil {
+		return nil, err
+	}
+	byDate := make(map[string]DailyViews, len(rows))
+	for _, r := range rows {
+		byDate[r.Date] = r
+	}
+	series := []DailyViews{}
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		date := d.Format(dayLayout)
+		row := byDate[date]
+		row.Date = date
+		series = append(series, row)
+	}
+	return series, nil
+}
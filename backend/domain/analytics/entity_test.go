@@ -0,0 +1,61 @@
+// backend/domain/analytics/entity_test.go
+package analytics
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDay(t *testing.T) {
+	// UTC の 15 時は日本時間の翌日 0 時
+	if got := Day(time.Date(2026, 3, 31, 15, 0, 0, 0, time.UTC)); got != "2026-04-01" {
+		t.Errorf("Day() = %q, want 2026-04-01", got)
+	}
+}
+
+func TestVisitorKey(t *testing.T) {
+	if got := VisitorKey(42, "1.2.3.4", "ua", "salt", "2026-04-01"); got != "u:42" {
+		t.Errorf("VisitorKey() = %q, want u:42", got)
+	}
+	a := VisitorKey(0, "1.2.3.4", "ua", "salt", "2026-04-01")
+	if a != VisitorKey(0, "1.2.3.4", "ua", "salt", "2026-04-01") {
+		t.Error("the same visitor should get the same key within a day")
+	}
+	if a == VisitorKey(0, "1.2.3.4", "ua", "salt", "2026-04-02") {
+		t.Error("anonymous keys should change every day")
+	}
+	if a == VisitorKey(0, "1.2.3.4", "other", "salt", "2026-04-01") {
+		t.Error("different user agents should get different keys")
+	}
+}
+
+func TestReferrerHost(t *testing.T) {
+	tests := map[string]string{
+		"https://www.Google.com/search?q=a": "google.com",
+		"https://zenn.dev/articles/1":       "zenn.dev",
+		"https://portfolio.example.com/x":   "", // 自サイト内の移動
+		"android-app://com.twitter":         "",
+		"":                                  "",
+	}
+	for referrer, want := range tests {
+		if got := ReferrerHost(referrer, "portfolio.example.com"); got != want {
+			t.Errorf("ReferrerHost(%q) = %q, want %q", referrer, got, want)
+		}
+	}
+}
+
+func TestFillSeries(t *testing.T) {
+	series, err := FillSeries("2026-04-29", "2026-05-01", []DailyViews{{Date: "2026-04-30", PostViews: 3}})
+	if err != nil {
+		t.Fatalf("FillSeries failed: %v", err)
+	}
+	want := []DailyViews{
+		{Date: "2026-04-29"},
+		{Date: "2026-04-30", PostViews: 3},
+		{Date: "2026-05-01"},
+	}
+	if !reflect.DeepEqual(series, want) {
+		t.Errorf("FillSeries() = %+v, want %+v", series, want)
+	}
+}
@@ -0,0 +1,33 @@
+// backend/domain/analytics/repository.go
+package analytics
+
+import "time"
+
+// TargetViews は閲覧対象ごとの閲覧数です
+type TargetViews struct {
+	TargetID uint
+	Views    int
+}
+
+// Repository は閲覧の生データと日ごとの集計の永続化インターフェースです
+// 本人向けの集計の読み出しは日ごとの集計だけを使い、生データは読まない
+type Repository interface {
+	// RecordView は閲覧を保存します。同じ閲覧者・対象・日の閲覧がすでにある場合は保存せず false を返す
+	RecordView(v *View) (bool, error)
+
+	// RolledUpThrough は集計済みの最後の日を返します。まだ集計していない場合は空
+	RolledUpThrough() (string, error)
+	// FirstViewDay は生データの最初の日を返します。生データがない場合は空
+	FirstViewDay() (string, error)
+	// Rollup は day の生データから日ごとの集計を作り直し、集計済みの日として記録します
+	Rollup(day string, now time.Time) error
+	// DeleteViewsBefore は day より前の生データを削除します（集計は残す）
+	DeleteViewsBefore(day string) error
+
+	// 以下は from から to まで（両端を含む）の ownerID の集計
+	GetDailyViews(ownerID uint, from, to string) ([]DailyViews, error)
+	GetTopTargets(ownerID uint, targetType TargetType, from, to string, limit int) ([]TargetViews, error)
+	GetReferrers(ownerID uint, from, to string, limit int) ([]ReferrerViews, error)
+	// GetOrganizationViews は企業ごとの閲覧数を返します（Name は設定しない）
+	GetOrganizationViews(ownerID uint, from, to string) ([]OrganizationViews, error)
+}
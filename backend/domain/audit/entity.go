@@ -0,0 +1,173 @@
+// backend/domain/audit/entity.go
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Action は監査ログに記録する操作の種類です
+type Action string
+
+const (
+	// アカウント・認証
+	ActionLoginSucceeded         Action = "auth.login_succeeded"
+	ActionLoginFailed            Action = "auth.login_failed"
+	ActionGoogleLogin            Action = "auth.google_login"
+	ActionLogout                 Action = "auth.logout"
+	ActionAccountVerified        Action = "auth.account_verified"
+	ActionPasswordResetRequested Action = "auth.password_reset_requested"
+	ActionPasswordResetCompleted Action = "auth.password_reset_completed"
+
+	// プロフィール・権限
+	ActionProfileUpdated Action = "user.profile_updated"
+	ActionPrivacyUpdated Action = "user.privacy_updated"
+	ActionRoleChanged    Action = "user.role_changed"
+
+	// 学歴・職歴
+	ActionEducationCreated    Action = "user.education_created"
+	ActionEducationUpdated    Action = "user.education_updated"
+	ActionEducationDeleted    Action = "user.education_deleted"
+	ActionEducationsReordered Action = "user.educations_reordered"
+	ActionExperienceCreated   Action = "user.experience_created"
+	ActionExperienceUpdated   Action = "user.experience_updated"
+	ActionExperienceDeleted   Action = "user.experience_deleted"
+
+	// 作品投稿
+	ActionPostCreated     Action = "post.created"
+	ActionPostUpdated     Action = "post.updated"
+	ActionPostDeleted     Action = "post.deleted"
+	ActionPostPublished   Action = "post.published"
+	ActionPostScheduled   Action = "post.scheduled"
+	ActionPostUnscheduled Action = "post.unscheduled"
+	ActionPostArchived    Action = "post.archived"
+	ActionPostUnarchived  Action = "post.unarchived"
+	ActionPostRestored    Action = "post.restored" // 過去の版に戻した
+
+	// 作品の共同制作者
+	ActionCollaboratorInvited  Action = "post.collaborator_invited"
+	ActionCollaboratorAccepted Action = "post.collaborator_accepted"
+	ActionCollaboratorRemoved  Action = "post.collaborator_removed" // 投稿者による削除・本人の辞退や脱退
+	ActionContributionUpdated  Action = "post.contribution_updated"
+
+	// 投稿のコレクション
+	ActionCollectionCreated    Action = "collection.created"
+	ActionCollectionUpdated    Action = "collection.updated" // タイトル・説明・表紙・投稿の並び
+	ActionCollectionDeleted    Action = "collection.deleted"
+	ActionCollectionsReordered Action = "collection.reordered"
+	ActionCollectionFeatured   Action = "collection.featured"
+	ActionCollectionUnfeatured Action = "collection.unfeatured"
+	ActionFeaturedReordered    Action = "collection.featured_reordered"
+
+	// 運営による操作
+	ActionPostHidden      Action = "moderation.post_hidden"
+	ActionPostUnhidden    Action = "moderation.post_unhidden"
+	ActionUserSuspended   Action = "moderation.user_suspended"
+	ActionUserUnsuspended Action = "moderation.user_unsuspended"
+	ActionReportResolved  Action = "moderation.report_resolved"
+	ActionReportDismissed Action = "moderation.report_dismissed"
+	ActionNoteAdded       Action = "moderation.note_added"
+
+	// 企業アカウントの審査
+	ActionOrganizationApproved Action = "organization.approved"
+
+	// 選択肢（職種・スキル・ジャンル）の管理
+	ActionOptionCreated    Action = "taxonomy.option_created"
+	ActionOptionUpdated    Action = "taxonomy.option_updated"
+	ActionOptionDeleted    Action = "taxonomy.option_deleted"
+	ActionOptionMerged     Action = "taxonomy.option_merged"
+	ActionOptionRetired    Action = "taxonomy.option_retired"
+	ActionOptionRestored   Action = "taxonomy.option_restored"
+	ActionOptionsReordered Action = "taxonomy.options_reordered"
+)
+
+const maxUserAgentLength = 512
+
+// Actor は操作を行った人と、その操作のリクエスト元です
+type Actor struct {
+	UserID    uint // 未ログインの操作では 0
+	IP        string
+	UserAgent string
+}
+
+// Entry は監査ログ 1 件を表すドメインエンティティです
+// 追記専用で、作成後に書き換えることはありません
+type Entry struct {
+	ID         uint
+	ActorID    uint
+	Action     Action
+	TargetType string // "post", "user" など
+	TargetID   uint
+	Detail     string // 操作の詳細（JSON）
+	IP         string
+	UserAgent  string
+	CreatedAt  time.Time
+}
+
+// NewEntry は Entry を生成するファクトリメソッドです
+// detail は JSON に変換して保持します
+func NewEntry(actor Actor, action Action, targetType string, targetID uint, detail interface{}, now time.Time) (*Entry, error) {
+	if action == "" {
+		return nil, fmt.Errorf("操作の種類は必須です")
+	}
+	e := &Entry{
+		ActorID:    actor.UserID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		IP:         actor.IP,
+		UserAgent:  truncate(actor.UserAgent, maxUserAgentLength),
+		CreatedAt:  now,
+	}
+	if detail != nil {
+		b, err := json.Marshal(detail)
+		if err != nil {
+			return nil, err
+		}
+		e.Detail = string(b)
+	}
+	return e, nil
+}
+
+// SecurityActions は本人が「セキュリティ履歴」として確認できる操作です
+// 運営による操作や運営メモは含めません
+var SecurityActions = []Action{
+	ActionLoginSucceeded,
+	ActionLoginFailed,
+	ActionGoogleLogin,
+	ActionLogout,
+	ActionAccountVerified,
+	ActionPasswordResetRequested,
+	ActionPasswordResetCompleted,
+	ActionProfileUpdated,
+	ActionPrivacyUpdated,
+	ActionRoleChanged,
+}
+
+// Change は 1 項目分の変更前後の値です
+type Change struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// Diff は before と after を比べ、値が変わった項目だけを返します
+func Diff(before, after map[string]interface{}) map[string]Change {
+	changes := make(map[string]Change)
+	for key, to := range after {
+		from := before[key]
+		if !reflect.DeepEqual(from, to) {
+			changes[key] = Change{From: from, To: to}
+		}
+	}
+	return changes
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
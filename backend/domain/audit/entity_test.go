@@ -0,0 +1,50 @@
+// backend/domain/audit/entity_test.go
+package audit
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	before := map[string]interface{}{
+		"firstName":  "太郎",
+		"skills":     []string{"Go"},
+		"laboratory": "",
+	}
+	after := map[string]interface{}{
+		"firstName":  "太郎",
+		"skills":     []string{"Go", "SQL"},
+		"laboratory": "情報工学研究室",
+	}
+
+	changes := Diff(before, after)
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %v", changes)
+	}
+	if _, ok := changes["firstName"]; ok {
+		t.Error("unchanged field should not be reported")
+	}
+	if c := changes["laboratory"]; c.From != "" || c.To != "情報工学研究室" {
+		t.Errorf("unexpected laboratory change: %+v", c)
+	}
+}
+
+func TestNewEntry(t *testing.T) {
+	actor := Actor{UserID: 1, IP: "192.0.2.1", UserAgent: strings.Repeat("a", 600)}
+	e, err := NewEntry(actor, ActionLoginSucceeded, "user", 1, map[string]interface{}{"method": "password"}, time.Now())
+	if err != nil {
+		t.Fatalf("NewEntry failed: %v", err)
+	}
+	if e.Detail != `{"method":"password"}` {
+		t.Errorf("Detail = %s", e.Detail)
+	}
+	if len(e.UserAgent) != maxUserAgentLength {
+		t.Errorf("UserAgent should be truncated to %d, got %d", maxUserAgentLength, len(e.UserAgent))
+	}
+
+	if _, err := NewEntry(actor, "", "user", 1, nil, time.Now()); err == nil {
+		t.Error("expected error when action is empty")
+	}
+}
@@ -0,0 +1,26 @@
+// backend/domain/audit/repository.go
+package audit
+
+import "time"
+
+// Filter は監査ログ検索の条件です。空の項目は条件に含めません
+type Filter struct {
+	ActorID    uint
+	TargetType string
+	TargetID   uint
+	Actions    []Action
+	From       time.Time
+	To         time.Time
+	Limit      int
+	Offset     int
+}
+
+// Repository は監査ログの永続化インターフェースです
+// 改ざんを防ぐため、個別の更新・削除のメソッドは用意しません
+type Repository interface {
+	Append(e *Entry) error
+	// 新しい順に返す
+	Find(filter Filter) ([]*Entry, int64, error)
+	// 保存期間を過ぎたログの一括削除（保持期間ジョブ専用）
+	DeleteBefore(cutoff time.Time) (int64, error)
+}
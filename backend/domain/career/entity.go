@@ -0,0 +1,308 @@
+// backend/domain/career/entity.go
+package career
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Degree は学歴の種類です。空文字は未設定を表します（旧プロフィールから移行した学歴など）
+type Degree string
+
+const (
+	DegreeHighSchool       Degree = "high_school"       // 高校
+	DegreeVocational       Degree = "vocational"        // 専門学校
+	DegreeTechnicalCollege Degree = "technical_college" // 高等専門学校
+	DegreeAssociate        Degree = "associate"         // 短期大学
+	DegreeBachelor         Degree = "bachelor"          // 学士（大学）
+	DegreeMaster           Degree = "master"            // 修士
+	DegreeDoctor           Degree = "doctor"            // 博士
+	DegreeOther            Degree = "other"
+)
+
+// Degrees は学歴の種類の一覧です
+var Degrees = []Degree{
+	DegreeHighSchool, DegreeVocational, DegreeTechnicalCollege, DegreeAssociate,
+	DegreeBachelor, DegreeMaster, DegreeDoctor, DegreeOther,
+}
+
+// ParseDegree は文字列を学歴の種類に変換します。空文字は未設定として受け付けます
+func ParseDegree(s string) (Degree, error) {
+	if s == "" {
+		return "", nil
+	}
+	for _, d := range Degrees {
+		if string(d) == s {
+			return d, nil
+		}
+	}
+	return "", fmt.Errorf("学歴の種類が不正です: %s", s)
+}
+
+// ExperienceType は職歴の種類です
+type ExperienceType string
+
+const (
+	ExperienceInternship ExperienceType = "internship" // インターンシップ
+	ExperiencePartTime   ExperienceType = "part_time"  // アルバイト
+	ExperienceFullTime   ExperienceType = "full_time"  // 正社員・契約社員
+	ExperienceFreelance  ExperienceType = "freelance"  // 業務委託・個人での受託
+	ExperienceOther      ExperienceType = "other"
+)
+
+// ExperienceTypes は職歴の種類の一覧です
+var ExperienceTypes = []ExperienceType{
+	ExperienceInternship, ExperiencePartTime, ExperienceFullTime, ExperienceFreelance, ExperienceOther,
+}
+
+// ParseExperienceType は文字列を職歴の種類に変換します。空文字は other として扱います
+func ParseExperienceType(s string) (ExperienceType, error) {
+	if s == "" {
+		return ExperienceOther, nil
+	}
+	for _, t := range ExperienceTypes {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("職歴の種類が不正です: %s", s)
+}
+
+const (
+	minYear              = 1950
+	maxFutureYears       = 10 // 卒業予定などで受け付ける未来の年数
+	maxNameLength        = 255
+	maxDescriptionLength = 2000
+	maxExperienceSkills  = 20
+
+	// 卒業年だけが分かっている場合は、その年の 3 月卒業とみなす
+	graduationMonth = time.March
+)
+
+// yearMonthLayout は開始・終了年月の入力形式です（例: "2024-04"）
+const yearMonthLayout = "2006-01"
+
+// ParseYearMonth は "YYYY-MM" 形式の年月を月初の日時に変換します。空文字は nil を返します
+func ParseYearMonth(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(yearMonthLayout, s)
+	if err != nil {
+		return nil, fmt.Errorf("年月は YYYY-MM 形式で入力してください: %s", s)
+	}
+	if err := validateYear(t.Year()); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// ParseGraduationYear は 4 桁の卒業年（予定を含む）を検証して返します
+func ParseGraduationYear(s string) (int, error) {
+	if len(s) != 4 {
+		return 0, fmt.Errorf("卒業年は西暦4桁で入力してください: %s", s)
+	}
+	year, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("卒業年は西暦4桁で入力してください: %s", s)
+	}
+	if err := validateYear(year); err != nil {
+		return 0, err
+	}
+	return year, nil
+}
+
+// GraduationDate は卒業年から卒業年月（3 月）を返します
+func GraduationDate(year int) *time.Time {
+	t := time.Date(year, graduationMonth, 1, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func validateYear(year int) error {
+	max := time.Now().Year() + maxFutureYears
+	if year < minYear || year > max {
+		return fmt.Errorf("年は%d〜%d年の範囲で入力してください", minYear, max)
+	}
+	return nil
+}
+
+func validatePeriod(start, end *time.Time) error {
+	if start != nil && end != nil && end.Before(*start) {
+		return fmt.Errorf("終了年月は開始年月以降にしてください")
+	}
+	return nil
+}
+
+// Education は学歴 1 件を表すドメインエンティティです
+// DisplayOrder の小さい順に表示し、先頭の学歴をプロフィールの学校名・卒業年として扱います
+type Education struct {
+	ID           uint
+	UserID       uint
+	Degree       Degree
+	SchoolName   string
+	Department   string // 学部・学科
+	Laboratory   string // 研究室
+	StartDate    *time.Time
+	EndDate      *time.Time // 卒業（予定）年月。未定なら nil
+	DisplayOrder int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewEducation は Education を生成するファクトリメソッドです
+func NewEducation(userID uint, degree Degree, schoolName, department, laboratory string, start, end *time.Time) (*Education, error) {
+	if userID == 0 {
+		return nil, fmt.Errorf("ユーザーは必須です")
+	}
+	now := time.Now()
+	e := &Education{UserID: userID, CreatedAt: now}
+	if err := e.Update(degree, schoolName, department, laboratory, start, end); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Update は学歴の内容を書き換える振る舞い
+func (e *Education) Update(degree Degree, schoolName, department, laboratory string, start, end *time.Time) error {
+	if _, err := ParseDegree(string(degree)); err != nil {
+		return err
+	}
+	if schoolName == "" {
+		return fmt.Errorf("学校名は必須です")
+	}
+	for _, v := range []string{schoolName, department, laboratory} {
+		if len([]rune(v)) > maxNameLength {
+			return fmt.Errorf("学校名・学部・研究室は%d文字以内で入力してください", maxNameLength)
+		}
+	}
+	if err := validatePeriod(start, end); err != nil {
+		return err
+	}
+	e.Degree = degree
+	e.SchoolName = schoolName
+	e.Department = department
+	e.Laboratory = laboratory
+	e.StartDate = start
+	e.EndDate = end
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// GraduationYear は卒業（予定）年を 4 桁の文字列で返します。未定なら空文字
+func (e *Education) GraduationYear() string {
+	if e.EndDate == nil {
+		return ""
+	}
+	return strconv.Itoa(e.EndDate.Year())
+}
+
+// Primary は表示順が先頭の学歴を返します。学歴がなければ nil
+func Primary(educations []*Education) *Education {
+	var primary *Education
+	for _, e := range educations {
+		if primary == nil || e.DisplayOrder < primary.DisplayOrder ||
+			(e.DisplayOrder == primary.DisplayOrder && e.ID < primary.ID) {
+			primary = e
+		}
+	}
+	return primary
+}
+
+// Experience は職歴（インターン・アルバイトなど）1 件を表すドメインエンティティです
+type Experience struct {
+	ID          uint
+	UserID      uint
+	Type        ExperienceType
+	CompanyName string
+	Role        string
+	StartDate   time.Time
+	EndDate     *time.Time // 継続中なら nil
+	Description string
+	Skills      []string // この職歴で使ったスキル
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewExperience は Experience を生成するファクトリメソッドです
+func NewExperience(
+	userID uint,
+	experienceType ExperienceType,
+	companyName, role string,
+	start *time.Time, end *time.Time,
+	description string,
+	skills []string,
+) (*Experience, error) {
+	if userID == 0 {
+		return nil, fmt.Errorf("ユーザーは必須です")
+	}
+	now := time.Now()
+	e := &Experience{UserID: userID, CreatedAt: now}
+	if err := e.Update(experienceType, companyName, role, start, end, description, skills); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Update は職歴の内容を書き換える振る舞い
+func (e *Experience) Update(
+	experienceType ExperienceType,
+	companyName, role string,
+	start *time.Time, end *time.Time,
+	description string,
+	skills []string,
+) error {
+	if _, err := ParseExperienceType(string(experienceType)); err != nil {
+		return err
+	}
+	if companyName == "" {
+		return fmt.Errorf("会社名は必須です")
+	}
+	if role == "" {
+		return fmt.Errorf("役割・職種は必須です")
+	}
+	if len([]rune(companyName)) > maxNameLength || len([]rune(role)) > maxNameLength {
+		return fmt.Errorf("会社名・役割は%d文字以内で入力してください", maxNameLength)
+	}
+	if start == nil {
+		return fmt.Errorf("開始年月は必須です")
+	}
+	if err := validatePeriod(start, end); err != nil {
+		return err
+	}
+	if len([]rune(description)) > maxDescriptionLength {
+		return fmt.Errorf("説明は%d文字以内で入力してください", maxDescriptionLength)
+	}
+	if len(skills) > maxExperienceSkills {
+		return fmt.Errorf("スキルは%d件までです", maxExperienceSkills)
+	}
+	if skills == nil {
+		skills = []string{}
+	}
+	e.Type = experienceType
+	e.CompanyName = companyName
+	e.Role = role
+	e.StartDate = *start
+	e.EndDate = end
+	e.Description = description
+	e.Skills = skills
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsCurrent は継続中の職歴かどうかを返します
+func (e *Experience) IsCurrent() bool {
+	return e.EndDate == nil
+}
+
+// SortExperiences は継続中のものを先頭に、開始年月の新しい順に並べ替えます
+func SortExperiences(experiences []*Experience) {
+	sort.SliceStable(experiences, func(i, j int) bool {
+		a, b := experiences[i], experiences[j]
+		if a.IsCurrent() != b.IsCurrent() {
+			return a.IsCurrent()
+		}
+		return a.StartDate.After(b.StartDate)
+	})
+}
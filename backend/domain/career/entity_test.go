@@ -0,0 +1,82 @@
+// backend/domain/career/entity_test.go
+package career
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseGraduationYear(t *testing.T) {
+	if year, err := ParseGraduationYear("2026"); err != nil || year != 2026 {
+		t.Errorf("ParseGraduationYear(2026) = %d, %v", year, err)
+	}
+	for _, s := range []string{"", "26", "R8", "1900", "3000"} {
+		if _, err := ParseGraduationYear(s); err == nil {
+			t.Errorf("ParseGraduationYear(%q) should fail", s)
+		}
+	}
+}
+
+func TestNewEducation_Validation(t *testing.T) {
+	start, _ := ParseYearMonth("2022-04")
+	end, _ := ParseYearMonth("2026-03")
+	if _, err := NewEducation(1, DegreeBachelor, "", "", "", start, end); err == nil {
+		t.Error("expected error when school name is empty")
+	}
+	if _, err := NewEducation(1, Degree("phd"), "東京大学", "", "", start, end); err == nil {
+		t.Error("expected error for unknown degree")
+	}
+	if _, err := NewEducation(1, DegreeBachelor, "東京大学", "", "", end, start); err == nil {
+		t.Error("expected error when end is before start")
+	}
+
+	e, err := NewEducation(1, DegreeBachelor, "東京大学", "工学部", "", start, end)
+	if err != nil {
+		t.Fatalf("NewEducation failed: %v", err)
+	}
+	if got := e.GraduationYear(); got != "2026" {
+		t.Errorf("GraduationYear() = %q, want 2026", got)
+	}
+}
+
+func TestPrimary(t *testing.T) {
+	if Primary(nil) != nil {
+		t.Error("Primary(nil) should be nil")
+	}
+	master := &Education{ID: 2, SchoolName: "京都大学大学院", DisplayOrder: 0}
+	bachelor := &Education{ID: 1, SchoolName: "大阪大学", DisplayOrder: 1}
+	if got := Primary([]*Education{bachelor, master}); got != master {
+		t.Errorf("Primary() = %s, want 京都大学大学院", got.SchoolName)
+	}
+}
+
+func TestNewExperience_Validation(t *testing.T) {
+	start := time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := NewExperience(1, ExperienceInternship, "株式会社サンプル", "バックエンド", nil, nil, "", nil); err == nil {
+		t.Error("expected error when start is missing")
+	}
+	if _, err := NewExperience(1, ExperienceInternship, "", "バックエンド", &start, nil, "", nil); err == nil {
+		t.Error("expected error when company is empty")
+	}
+	e, err := NewExperience(1, ExperienceInternship, "株式会社サンプル", "バックエンド", &start, nil, "", nil)
+	if err != nil {
+		t.Fatalf("NewExperience failed: %v", err)
+	}
+	if !e.IsCurrent() || e.Skills == nil {
+		t.Errorf("unexpected experience: %+v", e)
+	}
+}
+
+func TestSortExperiences(t *testing.T) {
+	d := func(y int) time.Time { return time.Date(y, time.April, 1, 0, 0, 0, 0, time.UTC) }
+	end := d(2024)
+	old := &Experience{CompanyName: "A", StartDate: d(2022), EndDate: &end}
+	recent := &Experience{CompanyName: "B", StartDate: d(2023), EndDate: &end}
+	current := &Experience{CompanyName: "C", StartDate: d(2021)}
+
+	experiences := []*Experience{old, recent, current}
+	SortExperiences(experiences)
+	if experiences[0] != current || experiences[1] != recent || experiences[2] != old {
+		t.Errorf("unexpected order: %s %s %s", experiences[0].CompanyName, experiences[1].CompanyName, experiences[2].CompanyName)
+	}
+}
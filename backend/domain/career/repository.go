@@ -0,0 +1,20 @@
+// backend/domain/career/repository.go
+package career
+
+// Repository は学歴・職歴の永続化インターフェースです
+type Repository interface {
+	// DisplayOrder 順に返す
+	GetEducationsByUserID(userID uint) ([]*Education, error)
+	GetEducationByID(id uint) (*Education, error)
+	CreateEducation(e *Education) error
+	UpdateEducation(e *Education) error
+	DeleteEducation(id uint) error
+	// ids の順に表示順を振り直す
+	ReorderEducations(userID uint, ids []uint) error
+
+	GetExperiencesByUserID(userID uint) ([]*Experience, error)
+	GetExperienceByID(id uint) (*Experience, error)
+	CreateExperience(e *Experience) error
+	UpdateExperience(e *Experience) error
+	DeleteExperience(id uint) error
+}
@@ -0,0 +1,176 @@
+// backend/domain/collection/entity.go
+package collection
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MaxCollections = 50  // 1ユーザーが作成できるコレクションの上限
+	MaxPosts       = 100 // 1コレクションに入れられる投稿の上限
+
+	maxTitleLength       = 100
+	maxDescriptionLength = 2000
+)
+
+// PostSummary はコレクションの中に表示する投稿の概要です
+type PostSummary struct {
+	ID           uint
+	Title        string
+	ThumbnailURL string
+}
+
+// Collection は「大学の課題」「ゲームジャム 2026」のように投稿をまとめたものです
+// 投稿は複数のコレクションに入れられます。PostIDs の並びがコレクション内の表示順です
+// 運営が作ったコレクションは、FeaturedAt を設定するとトップページに特集として表示します
+type Collection struct {
+	ID            uint
+	UserID        uint
+	Title         string
+	Description   string
+	CoverPostID   *uint // 表紙にする投稿。未設定なら先頭の投稿の画像を使う
+	DisplayOrder  int   // プロフィールでの表示順
+	PostIDs       []uint
+	FeaturedAt    *time.Time
+	FeaturedOrder int // 特集の表示順
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	// 以下は読み出し時に設定する表示用の値で、永続化しない
+	Posts         []PostSummary // PostIDs のうち閲覧者に見せられる投稿
+	CoverImageURL string
+}
+
+// NewCollection はタイトルと説明を検証して空のコレクションを生成するファクトリメソッドです
+func NewCollection(userID uint, title, description string) (*Collection, error) {
+	if userID == 0 {
+		return nil, fmt.Errorf("ユーザーは必須です")
+	}
+	now := time.Now()
+	c := &Collection{
+		UserID:    userID,
+		PostIDs:   []uint{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := c.setDetails(title, description); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Update はタイトル・説明・表紙を更新します。表紙はコレクションに入っている投稿から選ぶ
+func (c *Collection) Update(title, description string, coverPostID *uint, now time.Time) error {
+	if err := c.setDetails(title, description); err != nil {
+		return err
+	}
+	if coverPostID != nil && !c.Contains(*coverPostID) {
+		return fmt.Errorf("表紙にはコレクション内の投稿を選んでください")
+	}
+	c.CoverPostID = coverPostID
+	c.UpdatedAt = now
+	return nil
+}
+
+func (c *Collection) setDetails(title, description string) error {
+	title = strings.TrimSpace(title)
+	description = strings.TrimSpace(description)
+	if title == "" {
+		return fmt.Errorf("タイトルは必須です")
+	}
+	if utf8.RuneCountInString(title) > maxTitleLength {
+		return fmt.Errorf("タイトルは%d文字以内で入力してください", maxTitleLength)
+	}
+	if utf8.RuneCountInString(description) > maxDescriptionLength {
+		return fmt.Errorf("説明は%d文字以内で入力してください", maxDescriptionLength)
+	}
+	c.Title = title
+	c.Description = description
+	return nil
+}
+
+// SetPosts はコレクションの投稿を ids の並びで置き換えます
+// 表紙の投稿が外れた場合は表紙を未設定に戻す
+func (c *Collection) SetPosts(ids []uint, now time.Time) error {
+	if len(ids) > MaxPosts {
+		return fmt.Errorf("コレクションに入れられる投稿は%d件までです", MaxPosts)
+	}
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		if id == 0 {
+			return fmt.Errorf("投稿の ID が不正です")
+		}
+		if seen[id] {
+			return fmt.Errorf("同じ投稿が重複しています: %d", id)
+		}
+		seen[id] = true
+	}
+	c.PostIDs = append([]uint{}, ids...)
+	if c.CoverPostID != nil && !seen[*c.CoverPostID] {
+		c.CoverPostID = nil
+	}
+	c.UpdatedAt = now
+	return nil
+}
+
+// AddPost は投稿をコレクションの末尾に追加します
+func (c *Collection) AddPost(postID uint, now time.Time) error {
+	if c.Contains(postID) {
+		return fmt.Errorf("この投稿はすでにコレクションに入っています")
+	}
+	return c.SetPosts(append(append([]uint{}, c.PostIDs...), postID), now)
+}
+
+// RemovePost は投稿をコレクションから外します。入っていなかった場合は false を返す
+func (c *Collection) RemovePost(postID uint, now time.Time) bool {
+	ids := make([]uint, 0, len(c.PostIDs))
+	for _, id := range c.PostIDs {
+		if id != postID {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == len(c.PostIDs) {
+		return false
+	}
+	// 件数が減るだけなので検証エラーにはならない
+	_ = c.SetPosts(ids, now)
+	return true
+}
+
+// Contains は投稿がコレクションに入っているかを返します
+func (c *Collection) Contains(postID uint) bool {
+	for _, id := range c.PostIDs {
+		if id == postID {
+			return true
+		}
+	}
+	return false
+}
+
+// Feature は運営がコレクションを特集に追加します。order は特集の中での表示順
+func (c *Collection) Feature(order int, now time.Time) error {
+	if c.IsFeatured() {
+		return fmt.Errorf("このコレクションはすでに特集に追加されています")
+	}
+	c.FeaturedAt = &now
+	c.FeaturedOrder = order
+	return nil
+}
+
+// Unfeature はコレクションを特集から外します
+func (c *Collection) Unfeature() error {
+	if !c.IsFeatured() {
+		return fmt.Errorf("このコレクションは特集に追加されていません")
+	}
+	c.FeaturedAt = nil
+	c.FeaturedOrder = 0
+	return nil
+}
+
+// IsFeatured は特集に追加されているかを返します
+func (c *Collection) IsFeatured() bool {
+	return c.FeaturedAt != nil
+}
@@ -0,0 +1,56 @@
+// backend/domain/collection/entity_test.go
+package collection
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewCollection(t *testing.T) {
+	if _, err := NewCollection(1, " ", ""); err == nil {
+		t.Error("expected error when title is empty")
+	}
+	c, err := NewCollection(1, " ゲームジャム 2026 ", "")
+	if err != nil {
+		t.Fatalf("NewCollection failed: %v", err)
+	}
+	if c.Title != "ゲームジャム 2026" {
+		t.Errorf("Title = %q, want trimmed title", c.Title)
+	}
+}
+
+func TestCollection_Posts(t *testing.T) {
+	now := time.Now()
+	c, _ := NewCollection(1, "大学の課題", "")
+	if err := c.SetPosts([]uint{3, 1, 3}, now); err == nil {
+		t.Error("expected error for duplicate posts")
+	}
+	if err := c.SetPosts([]uint{3, 1}, now); err != nil {
+		t.Fatalf("SetPosts failed: %v", err)
+	}
+	if err := c.AddPost(1, now); err == nil {
+		t.Error("adding the same post twice should fail")
+	}
+	if err := c.AddPost(2, now); err != nil {
+		t.Fatalf("AddPost failed: %v", err)
+	}
+	if !reflect.DeepEqual(c.PostIDs, []uint{3, 1, 2}) {
+		t.Errorf("PostIDs = %v, want [3 1 2]", c.PostIDs)
+	}
+
+	cover := uint(5)
+	if err := c.Update("大学の課題", "", &cover, now); err == nil {
+		t.Error("cover outside the collection should be rejected")
+	}
+	cover = 1
+	if err := c.Update("大学の課題", "", &cover, now); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if !c.RemovePost(1, now) || c.CoverPostID != nil {
+		t.Errorf("removing the cover post should clear the cover: %+v", c)
+	}
+	if c.RemovePost(1, now) {
+		t.Error("RemovePost should report a missing post")
+	}
+}
@@ -0,0 +1,23 @@
+// backend/domain/collection/repository.go
+package collection
+
+// Repository はコレクションの永続化インターフェースです
+// 見つからない場合は gorm.ErrRecordNotFound を返します
+type Repository interface {
+	// Create はコレクションを投稿の並びとともに保存します
+	Create(c *Collection) error
+	GetByID(id uint) (*Collection, error)
+	// GetByUserID はユーザーのコレクションを DisplayOrder 順に返します
+	GetByUserID(userID uint) ([]*Collection, error)
+	// Update はタイトルなどと、投稿の並び（PostIDs）を保存します
+	Update(c *Collection) error
+	Delete(id uint) error
+	// Reorder はユーザーのコレクションの表示順を ids の並びにします
+	Reorder(userID uint, ids []uint) error
+
+	// 以下は運営が選ぶ特集
+	// GetFeatured は特集中のコレクションを FeaturedOrder 順に返します
+	GetFeatured() ([]*Collection, error)
+	// ReorderFeatured は特集の表示順を ids の並びにします
+	ReorderFeatured(ids []uint) error
+}
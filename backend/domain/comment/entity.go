@@ -0,0 +1,44 @@
+// backend/domain/comment/entity.go
+package comment
+
+import (
+	domainUser "backend/domain/user"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// コメント本文の最大文字数
+const MaxBodyLength = 1000
+
+// Comment は作品投稿へのコメントを表すドメインエンティティです
+type Comment struct {
+	ID        uint
+	PostID    uint
+	UserID    uint
+	User      domainUser.UserModel
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewComment は Comment を生成するファクトリメソッドです
+// 本文必須、最大文字数のチェックを行います
+func NewComment(postID, userID uint, body string) (*Comment, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, fmt.Errorf("コメントを入力してください")
+	}
+	if utf8.RuneCountInString(body) > MaxBodyLength {
+		return nil, fmt.Errorf("コメントは%d文字以内で入力してください", MaxBodyLength)
+	}
+	now := time.Now()
+	return &Comment{
+		PostID:    postID,
+		UserID:    userID,
+		Body:      body,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
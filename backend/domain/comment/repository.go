@@ -0,0 +1,9 @@
+// backend/domain/comment/repository.go
+package comment
+
+// Repository はコメントの永続化を抽象化したインターフェースです
+type Repository interface {
+	CreateComment(c *Comment) error
+	GetCommentByID(id uint) (*Comment, error)
+	GetCommentsByPostID(postID uint) ([]*Comment, error)
+}
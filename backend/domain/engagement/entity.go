@@ -0,0 +1,43 @@
+// backend/domain/engagement/entity.go
+package engagement
+
+import (
+	"fmt"
+	"time"
+)
+
+// Kind は投稿への反応の種類です
+type Kind string
+
+const (
+	KindLike     Kind = "like"     // いいね。件数を投稿に表示する
+	KindBookmark Kind = "bookmark" // あとで見るための保存。本人だけが一覧できる
+)
+
+// Reaction はユーザーが投稿に付けたいいね・ブックマーク 1 件です。同じ種類は 1 投稿に 1 件まで
+type Reaction struct {
+	PostID    uint
+	UserID    uint
+	Kind      Kind
+	CreatedAt time.Time
+}
+
+// NewReaction は Reaction を生成するファクトリメソッドです
+func NewReaction(postID, userID uint, kind Kind) (*Reaction, error) {
+	if kind != KindLike && kind != KindBookmark {
+		return nil, fmt.Errorf("反応の種類が不正です: %s", kind)
+	}
+	if postID == 0 || userID == 0 {
+		return nil, fmt.Errorf("投稿とユーザーは必須です")
+	}
+	return &Reaction{PostID: postID, UserID: userID, Kind: kind, CreatedAt: time.Now()}, nil
+}
+
+// Summary は投稿のいいね・ブックマークの件数と、閲覧者自身が付けているかです
+type Summary struct {
+	PostID     uint
+	Likes      int
+	Bookmarks  int
+	Liked      bool
+	Bookmarked bool
+}
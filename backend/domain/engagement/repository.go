@@ -0,0 +1,14 @@
+// backend/domain/engagement/repository.go
+package engagement
+
+// Repository はいいね・ブックマークの永続化インターフェースです
+type Repository interface {
+	// Add は反応を保存します。すでに付けている場合は保存せず false を返す
+	Add(r *Reaction) (bool, error)
+	// Remove は反応を削除します。付けていなかった場合は false を返す
+	Remove(postID, userID uint, kind Kind) (bool, error)
+	// GetPostIDs はユーザーが kind の反応を付けた投稿を新しい順に返します
+	GetPostIDs(userID uint, kind Kind) ([]uint, error)
+	// GetSummary は投稿の件数と、viewerID のユーザーが付けているかを返します
+	GetSummary(postID, viewerID uint) (*Summary, error)
+}
@@ -0,0 +1,179 @@
+// backend/domain/externallink/entity.go
+package externallink
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Type は外部リンクの種類です
+type Type string
+
+const (
+	TypeGitHub   Type = "github"
+	TypeX        Type = "x"
+	TypeZenn     Type = "zenn"
+	TypeQiita    Type = "qiita"
+	TypeBlog     Type = "blog" // 個人ブログ・ポートフォリオサイトなど。ドメインは問わない
+	TypeLinkedIn Type = "linkedin"
+)
+
+// Types は外部リンクの種類の一覧です（プロフィールでの表示順）
+var Types = []Type{TypeGitHub, TypeX, TypeZenn, TypeQiita, TypeBlog, TypeLinkedIn}
+
+const (
+	MaxLinks     = 10 // 1ユーザーが登録できるリンクの上限
+	maxBlogLinks = 3  // ブログだけは複数登録できる（他の種類は 1 件まで）
+	maxURLLength = 2048
+)
+
+// allowedHosts は種類ごとに許可するホストです。ブログはどのホストでもよい
+var allowedHosts = map[Type][]string{
+	TypeGitHub:   {"github.com", "www.github.com"},
+	TypeX:        {"x.com", "www.x.com", "twitter.com", "www.twitter.com", "mobile.twitter.com"},
+	TypeZenn:     {"zenn.dev", "www.zenn.dev"},
+	TypeQiita:    {"qiita.com", "www.qiita.com"},
+	TypeLinkedIn: {"linkedin.com", "www.linkedin.com", "jp.linkedin.com"},
+}
+
+// ParseType は文字列をリンクの種類に変換します
+func ParseType(s string) (Type, error) {
+	for _, t := range Types {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("リンクの種類が不正です: %s", s)
+}
+
+// Link はプロフィールに載せる外部サービスへのリンクです
+type Link struct {
+	ID           uint
+	UserID       uint
+	Type         Type
+	URL          string
+	DisplayOrder int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewLink は URL を検証して Link を生成するファクトリメソッドです
+func NewLink(userID uint, linkType Type, rawURL string) (*Link, error) {
+	if userID == 0 {
+		return nil, fmt.Errorf("ユーザーは必須です")
+	}
+	normalized, err := ValidateURL(linkType, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &Link{
+		UserID:    userID,
+		Type:      linkType,
+		URL:       normalized,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// ValidateURL はリンクの種類に合った URL かを確認し、前後の空白と末尾の "/" を除いた URL を返します
+// ・https のみ（ブログは http も可）
+// ・GitHub などはサービスのドメインで、ユーザー名などのパスを含むこと
+func ValidateURL(linkType Type, rawURL string) (string, error) {
+	if _, err := ParseType(string(linkType)); err != nil {
+		return "", err
+	}
+	raw := strings.TrimSpace(rawURL)
+	if raw == "" {
+		return "", fmt.Errorf("URLは必須です")
+	}
+	if len(raw) > maxURLLength {
+		return "", fmt.Errorf("URLは%d文字以内で入力してください", maxURLLength)
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return "", fmt.Errorf("URLの形式が不正です: %s", raw)
+	}
+	switch {
+	case u.Scheme == "https":
+	case u.Scheme == "http" && linkType == TypeBlog:
+	default:
+		return "", fmt.Errorf("URLは https:// から始めてください")
+	}
+	if u.User != nil {
+		return "", fmt.Errorf("URLにユーザー情報を含めることはできません")
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if hosts, ok := allowedHosts[linkType]; ok {
+		if !contains(hosts, host) {
+			return "", fmt.Errorf("%s のURLではありません: %s", linkType, raw)
+		}
+		if strings.Trim(u.Path, "/") == "" {
+			return "", fmt.Errorf("ユーザーページのURLを入力してください: %s", raw)
+		}
+	} else if !strings.Contains(host, ".") {
+		return "", fmt.Errorf("URLのホスト名が不正です: %s", raw)
+	}
+
+	u.Host = strings.ToLower(u.Host)
+	return strings.TrimSuffix(u.String(), "/"), nil
+}
+
+// Username は GitHub などのリンクからユーザー名（パスの先頭）を取り出します
+func (l *Link) Username() string {
+	u, err := url.Parse(l.URL)
+	if err != nil {
+		return ""
+	}
+	path := strings.Trim(u.Path, "/")
+	if i := strings.Index(path, "/"); i >= 0 {
+		path = path[:i]
+	}
+	return strings.TrimPrefix(path, "@")
+}
+
+// ValidateLinks はリンク一覧全体の件数と種類ごとの上限を確認します
+func ValidateLinks(links []*Link) error {
+	if len(links) > MaxLinks {
+		return fmt.Errorf("リンクは%d件まで登録できます", MaxLinks)
+	}
+	counts := make(map[Type]int, len(Types))
+	seen := make(map[string]bool, len(links))
+	for _, l := range links {
+		counts[l.Type]++
+		max := 1
+		if l.Type == TypeBlog {
+			max = maxBlogLinks
+		}
+		if counts[l.Type] > max {
+			return fmt.Errorf("%s のリンクは%d件まで登録できます", l.Type, max)
+		}
+		if seen[l.URL] {
+			return fmt.Errorf("同じURLが重複しています: %s", l.URL)
+		}
+		seen[l.URL] = true
+	}
+	return nil
+}
+
+// Find は links から指定した種類のリンクを返します
+func Find(links []*Link, linkType Type) (*Link, bool) {
+	for _, l := range links {
+		if l.Type == linkType {
+			return l, true
+		}
+	}
+	return nil, false
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
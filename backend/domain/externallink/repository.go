@@ -0,0 +1,10 @@
+// backend/domain/externallink/repository.go
+package externallink
+
+// Repository は外部リンクの永続化インターフェースです
+type Repository interface {
+	// DisplayOrder 順に返す
+	GetByUserID(userID uint) ([]*Link, error)
+	// ユーザーのリンクを links の内容と並びで置き換える
+	ReplaceForUser(userID uint, links []*Link) error
+}
@@ -0,0 +1,96 @@
+// backend/domain/github/entity.go
+package github
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+var (
+	ErrUserNotFound = errors.New("github user not found")
+	ErrRateLimited  = errors.New("github api rate limit exceeded")
+)
+
+// Repo は GitHub の公開リポジトリ 1 件です
+type Repo struct {
+	Name        string
+	FullName    string
+	Description string
+	HTMLURL     string
+	Homepage    string
+	Fork        bool
+	Stars       int
+	Languages   map[string]int // 言語ごとのバイト数
+	Topics      []string
+	PushedAt    time.Time
+
+	Skills []string // Languages を登録済みのスキル名に読み替えたもの（取り込み時に設定）
+}
+
+// Client は GitHub API から公開情報を取得するインターフェースです
+// テストではローカルのスタブサーバーに向けた実装を使います
+type Client interface {
+	// フォークを含む公開リポジトリを、言語ごとのバイト数つきで返す
+	ListRepositories(username string) ([]*Repo, error)
+}
+
+// LanguageStat は言語ごとの集計です
+type LanguageStat struct {
+	Language string
+	Bytes    int
+	Repos    int
+}
+
+// AggregateLanguages はフォークを除いたリポジトリの言語をバイト数の多い順に集計します
+func AggregateLanguages(repos []*Repo) []LanguageStat {
+	byLanguage := make(map[string]*LanguageStat)
+	for _, r := range repos {
+		if r.Fork {
+			continue
+		}
+		for lang, bytes := range r.Languages {
+			stat, ok := byLanguage[lang]
+			if !ok {
+				stat = &LanguageStat{Language: lang}
+				byLanguage[lang] = stat
+			}
+			stat.Bytes += bytes
+			stat.Repos++
+		}
+	}
+	stats := make([]LanguageStat, 0, len(byLanguage))
+	for _, s := range byLanguage {
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Bytes != stats[j].Bytes {
+			return stats[i].Bytes > stats[j].Bytes
+		}
+		return stats[i].Language < stats[j].Language
+	})
+	return stats
+}
+
+// LanguageNames はリポジトリの言語をバイト数の多い順に返します
+func (r *Repo) LanguageNames() []string {
+	names := make([]string, 0, len(r.Languages))
+	for lang := range r.Languages {
+		names = append(names, lang)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if r.Languages[names[i]] != r.Languages[names[j]] {
+			return r.Languages[names[i]] > r.Languages[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// ImportPreview は GitHub から取り込める内容の一覧です
+type ImportPreview struct {
+	Username        string
+	Repositories    []*Repo
+	Languages       []LanguageStat
+	SuggestedSkills []string // プロフィールにまだないスキル（使用量の多い順）
+}
@@ -0,0 +1,33 @@
+// backend/domain/github/entity_test.go
+package github
+
+import "testing"
+
+func TestAggregateLanguages(t *testing.T) {
+	repos := []*Repo{
+		{Name: "api", Languages: map[string]int{"Go": 5000, "Dockerfile": 100}},
+		{Name: "web", Languages: map[string]int{"TypeScript": 3000, "Go": 200}},
+		{Name: "forked", Fork: true, Languages: map[string]int{"Rust": 90000}},
+	}
+	stats := AggregateLanguages(repos)
+	if len(stats) != 3 {
+		t.Fatalf("got %d languages, want 3 (forks excluded): %+v", len(stats), stats)
+	}
+	if stats[0].Language != "Go" || stats[0].Bytes != 5200 || stats[0].Repos != 2 {
+		t.Errorf("unexpected top language: %+v", stats[0])
+	}
+	if stats[1].Language != "TypeScript" {
+		t.Errorf("second language = %s, want TypeScript", stats[1].Language)
+	}
+}
+
+func TestRepo_LanguageNames(t *testing.T) {
+	r := &Repo{Languages: map[string]int{"CSS": 10, "TypeScript": 300, "HTML": 10}}
+	got := r.LanguageNames()
+	want := []string{"TypeScript", "CSS", "HTML"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("LanguageNames() = %v, want %v", got, want)
+		}
+	}
+}
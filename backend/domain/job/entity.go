@@ -0,0 +1,214 @@
+// backend/domain/job/entity.go
+package job
+
+import (
+	domainOrganization "backend/domain/organization"
+	domainPortfolio "backend/domain/portfolio"
+	domainUser "backend/domain/user"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EmploymentType は募集区分です
+type EmploymentType string
+
+const (
+	EmploymentInternship EmploymentType = "internship" // インターンシップ
+	EmploymentNewGrad    EmploymentType = "new_grad"   // 新卒採用
+)
+
+// PostingStatus は求人の公開状態です
+type PostingStatus string
+
+const (
+	PostingDraft     PostingStatus = "draft"     // 下書き（企業メンバーのみ閲覧可）
+	PostingPublished PostingStatus = "published" // 公開中
+	PostingClosed    PostingStatus = "closed"    // 募集終了
+)
+
+// JobPosting は企業が掲載する求人のドメインエンティティです
+// 職種・スキルは models の JobType / Skill の名前で保持します
+type JobPosting struct {
+	ID              uint
+	OrganizationID  uint
+	Organization    domainOrganization.Organization
+	CreatedByUserID uint
+
+	Title          string
+	Description    string
+	EmploymentType EmploymentType
+	JobTypes       []string
+	Skills         []string
+	Location       string
+
+	Status      PostingStatus
+	Deadline    *time.Time // nil の場合は締め切りなし
+	PublishedAt *time.Time
+	ClosedAt    *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewJobPosting は下書き状態の求人を生成するファクトリメソッドです
+func NewJobPosting(
+	organizationID, createdByUserID uint,
+	title, description string,
+	employmentType EmploymentType,
+	jobTypes, skills []string,
+	location string,
+	deadline *time.Time,
+) (*JobPosting, error) {
+	j := &JobPosting{
+		OrganizationID:  organizationID,
+		CreatedByUserID: createdByUserID,
+		Status:          PostingDraft,
+	}
+	if err := j.Update(title, description, employmentType, jobTypes, skills, location, deadline); err != nil {
+		return nil, err
+	}
+	j.CreatedAt = j.UpdatedAt
+	return j, nil
+}
+
+// Update は求人内容を書き換える振る舞い
+// 募集終了した求人は編集できません
+func (j *JobPosting) Update(
+	title, description string,
+	employmentType EmploymentType,
+	jobTypes, skills []string,
+	location string,
+	deadline *time.Time,
+) error {
+	if j.Status == PostingClosed {
+		return fmt.Errorf("募集終了した求人は編集できません")
+	}
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return fmt.Errorf("求人タイトルは必須です")
+	}
+	switch employmentType {
+	case EmploymentInternship, EmploymentNewGrad:
+	default:
+		return fmt.Errorf("募集区分が不正です")
+	}
+	if len(jobTypes) == 0 {
+		return fmt.Errorf("職種は1つ以上選択してください")
+	}
+	if deadline != nil && j.Status == PostingPublished && deadline.Before(time.Now()) {
+		return fmt.Errorf("締め切りは未来の日時を指定してください")
+	}
+	j.Title = title
+	j.Description = description
+	j.EmploymentType = employmentType
+	j.JobTypes = jobTypes
+	j.Skills = skills
+	j.Location = strings.TrimSpace(location)
+	j.Deadline = deadline
+	j.UpdatedAt = time.Now()
+	return nil
+}
+
+// Publish は下書きの求人を公開する振る舞い
+func (j *JobPosting) Publish(now time.Time) error {
+	if j.Status != PostingDraft {
+		return fmt.Errorf("下書きの求人のみ公開できます")
+	}
+	if j.Deadline != nil && !j.Deadline.After(now) {
+		return fmt.Errorf("締め切りを過ぎた求人は公開できません")
+	}
+	j.Status = PostingPublished
+	j.PublishedAt = &now
+	j.UpdatedAt = now
+	return nil
+}
+
+// Close は公開中の求人の募集を終了する振る舞い
+func (j *JobPosting) Close(now time.Time) error {
+	if j.Status != PostingPublished {
+		return fmt.Errorf("公開中の求人のみ募集終了にできます")
+	}
+	j.Status = PostingClosed
+	j.ClosedAt = &now
+	j.UpdatedAt = now
+	return nil
+}
+
+// IsOpen は応募を受け付けているかどうかを返します
+func (j *JobPosting) IsOpen(now time.Time) bool {
+	if j.Status != PostingPublished {
+		return false
+	}
+	return j.Deadline == nil || now.Before(*j.Deadline)
+}
+
+// ApplicationStatus は応募の選考ステータスです
+type ApplicationStatus string
+
+const (
+	ApplicationApplied   ApplicationStatus = "applied"   // 応募済み
+	ApplicationScreening ApplicationStatus = "screening" // 書類選考中
+	ApplicationInterview ApplicationStatus = "interview" // 面接
+	ApplicationOffer     ApplicationStatus = "offer"     // 内定・合格
+	ApplicationRejected  ApplicationStatus = "rejected"  // 見送り
+)
+
+// applicationTransitions は選考ステータスの遷移可能な先です
+var applicationTransitions = map[ApplicationStatus][]ApplicationStatus{
+	ApplicationApplied:   {ApplicationScreening, ApplicationInterview, ApplicationRejected},
+	ApplicationScreening: {ApplicationInterview, ApplicationOffer, ApplicationRejected},
+	ApplicationInterview: {ApplicationOffer, ApplicationRejected},
+}
+
+// Application は学生から求人への応募を表すドメインエンティティです
+// 選考の参考にしてもらうため、自分の作品投稿を添付できます
+type Application struct {
+	ID           uint
+	JobPostingID uint
+	JobPosting   JobPosting
+	UserID       uint
+	Applicant    domainUser.UserModel
+	Message      string
+	PostIDs      []uint
+	Posts        []*domainPortfolio.Post // 表示用に添付作品を詰める
+	Status       ApplicationStatus
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewApplication は求人への応募を生成するファクトリメソッドです
+// 受付中の求人であること、添付作品が重複していないことをチェックします
+func NewApplication(posting *JobPosting, userID uint, message string, postIDs []uint, now time.Time) (*Application, error) {
+	if !posting.IsOpen(now) {
+		return nil, fmt.Errorf("この求人は応募を受け付けていません")
+	}
+	seen := make(map[uint]bool, len(postIDs))
+	for _, id := range postIDs {
+		if seen[id] {
+			return nil, fmt.Errorf("同じ作品が重複して添付されています")
+		}
+		seen[id] = true
+	}
+	return &Application{
+		JobPostingID: posting.ID,
+		UserID:       userID,
+		Message:      strings.TrimSpace(message),
+		PostIDs:      postIDs,
+		Status:       ApplicationApplied,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// ChangeStatus は選考ステータスを進める振る舞い
+func (a *Application) ChangeStatus(status ApplicationStatus) error {
+	for _, next := range applicationTransitions[a.Status] {
+		if next == status {
+			a.Status = status
+			a.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+	return fmt.Errorf("ステータスを %s から %s に変更できません", a.Status, status)
+}
@@ -0,0 +1,106 @@
+// backend/domain/job/entity_test.go
+package job
+
+import (
+	"testing"
+	"time"
+)
+
+func newPublishedPosting(t *testing.T, deadline *time.Time) *JobPosting {
+	t.Helper()
+	j, err := NewJobPosting(1, 1, "Go インターン", "", EmploymentInternship, []string{"バックエンド"}, []string{"Go"}, "東京", deadline)
+	if err != nil {
+		t.Fatalf("NewJobPosting failed: %v", err)
+	}
+	if err := j.Publish(time.Now()); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+	return j
+}
+
+func TestJobPosting_Lifecycle(t *testing.T) {
+	j, err := NewJobPosting(1, 1, "Go インターン", "", EmploymentInternship, []string{"バックエンド"}, nil, "", nil)
+	if err != nil {
+		t.Fatalf("NewJobPosting failed: %v", err)
+	}
+	if j.Status != PostingDraft || j.IsOpen(time.Now()) {
+		t.Fatalf("new posting should be a closed draft, got %s", j.Status)
+	}
+	if err := j.Close(time.Now()); err == nil {
+		t.Error("expected error when closing a draft")
+	}
+	if err := j.Publish(time.Now()); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+	if !j.IsOpen(time.Now()) {
+		t.Error("published posting without deadline should be open")
+	}
+	if err := j.Close(time.Now()); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if j.IsOpen(time.Now()) {
+		t.Error("closed posting should not be open")
+	}
+	if err := j.Update("新しいタイトル", "", EmploymentInternship, []string{"バックエンド"}, nil, "", nil); err == nil {
+		t.Error("expected error when editing a closed posting")
+	}
+}
+
+func TestJobPosting_Deadline(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	j, _ := NewJobPosting(1, 1, "Go インターン", "", EmploymentNewGrad, []string{"バックエンド"}, nil, "", &past)
+	if err := j.Publish(time.Now()); err == nil {
+		t.Error("expected error when publishing a posting past its deadline")
+	}
+
+	future := time.Now().Add(time.Hour)
+	open := newPublishedPosting(t, &future)
+	if !open.IsOpen(time.Now()) {
+		t.Error("posting should be open before the deadline")
+	}
+	if open.IsOpen(future.Add(time.Second)) {
+		t.Error("posting should not be open after the deadline")
+	}
+}
+
+func TestApplication_ChangeStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		steps   []ApplicationStatus
+		wantErr bool
+	}{
+		{name: "full flow", steps: []ApplicationStatus{ApplicationScreening, ApplicationInterview, ApplicationOffer}},
+		{name: "reject from applied", steps: []ApplicationStatus{ApplicationRejected}},
+		{name: "offer without screening", steps: []ApplicationStatus{ApplicationOffer}, wantErr: true},
+		{name: "no change after rejection", steps: []ApplicationStatus{ApplicationRejected, ApplicationScreening}, wantErr: true},
+		{name: "unknown status", steps: []ApplicationStatus{"hired"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewApplication(newPublishedPosting(t, nil), 2, "よろしくお願いします", []uint{10}, time.Now())
+			if err != nil {
+				t.Fatalf("NewApplication failed: %v", err)
+			}
+			var lastErr error
+			for _, s := range tt.steps {
+				if lastErr = a.ChangeStatus(s); lastErr != nil {
+					break
+				}
+			}
+			if tt.wantErr != (lastErr != nil) {
+				t.Errorf("wantErr = %v, got %v", tt.wantErr, lastErr)
+			}
+		})
+	}
+}
+
+func TestNewApplication_Validation(t *testing.T) {
+	draft, _ := NewJobPosting(1, 1, "Go インターン", "", EmploymentInternship, []string{"バックエンド"}, nil, "", nil)
+	if _, err := NewApplication(draft, 2, "", nil, time.Now()); err == nil {
+		t.Error("expected error when applying to a draft")
+	}
+	if _, err := NewApplication(newPublishedPosting(t, nil), 2, "", []uint{1, 1}, time.Now()); err == nil {
+		t.Error("expected error for duplicated post attachments")
+	}
+}
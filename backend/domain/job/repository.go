@@ -0,0 +1,32 @@
+// backend/domain/job/repository.go
+package job
+
+import "time"
+
+// SearchCriteria は求人検索の条件です。空の項目は条件に含めません
+type SearchCriteria struct {
+	Keyword        string
+	JobType        string
+	Skill          string
+	Location       string
+	EmploymentType EmploymentType
+	OpenAt         time.Time // この時点で応募受付中の求人に絞る
+}
+
+// Repository は求人と応募の永続化を抽象化したインターフェースです
+type Repository interface {
+	CreateJobPosting(j *JobPosting) error
+	UpdateJobPosting(j *JobPosting) error
+	DeleteJobPosting(id uint) error
+	GetJobPostingByID(id uint) (*JobPosting, error)
+	GetJobPostingsByOrganizationID(organizationID uint) ([]*JobPosting, error)
+	SearchJobPostings(criteria SearchCriteria) ([]*JobPosting, error)
+
+	CreateApplication(a *Application) error
+	UpdateApplication(a *Application) error
+	GetApplicationByID(id uint) (*Application, error)
+	// 同じ求人への応募がなければ gorm.ErrRecordNotFound
+	FindApplication(jobPostingID, userID uint) (*Application, error)
+	GetApplicationsByJobPostingID(jobPostingID uint) ([]*Application, error)
+	GetApplicationsByUserID(userID uint) ([]*Application, error)
+}
@@ -0,0 +1,55 @@
+// backend/domain/markdown/entity.go
+package markdown
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"unicode/utf8"
+)
+
+// MaxSourceLength は Markdown の原文の上限（文字数）です
+const MaxSourceLength = 20000
+
+// Renderer は Markdown を表示用の安全な HTML に変換するインターフェースです
+// 生の HTML は出力せず、許可したタグ・属性だけを残します
+type Renderer interface {
+	Render(source string) (string, error)
+}
+
+// Cached は変換済みの HTML と、変換元の原文のハッシュです
+type Cached struct {
+	SourceHash string
+	HTML       string
+}
+
+// Cache は変換結果のキャッシュです。原文が編集されたら呼び出し側で Delete します
+type Cache interface {
+	Get(key string) (Cached, bool)
+	Set(key string, value Cached)
+	Delete(key string)
+}
+
+// Hash は原文のハッシュを返します。キャッシュが古くないかの確認に使います
+func Hash(source string) string {
+	sum := sha256.Sum256([]byte(source))
+	return hex.EncodeToString(sum[:])
+}
+
+// PostDescriptionKey は投稿の説明文のキャッシュキーです
+func PostDescriptionKey(postID uint) string {
+	return fmt.Sprintf("post:%d:description", postID)
+}
+
+// SelfIntroductionKey はユーザーの自己紹介のキャッシュキーです
+func SelfIntroductionKey(userID uint) string {
+	return fmt.Sprintf("user:%d:self_introduction", userID)
+}
+
+// Validate は原文の長さを確認します
+func Validate(source string) error {
+	if utf8.RuneCountInString(source) > MaxSourceLength {
+		return fmt.Errorf("本文は%d文字以内で入力してください", MaxSourceLength)
+	}
+	return nil
+}
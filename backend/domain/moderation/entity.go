@@ -0,0 +1,143 @@
+// backend/domain/moderation/entity.go
+package moderation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// TargetType は通報・メモの対象の種類です
+type TargetType string
+
+const (
+	TargetPost    TargetType = "post"
+	TargetComment TargetType = "comment"
+	TargetUser    TargetType = "user" // プロフィール
+)
+
+// ReasonCategory は通報理由の分類です
+type ReasonCategory string
+
+const (
+	ReasonSpam          ReasonCategory = "spam"          // スパム・宣伝
+	ReasonHarassment    ReasonCategory = "harassment"    // 誹謗中傷・嫌がらせ
+	ReasonInappropriate ReasonCategory = "inappropriate" // 不適切な内容
+	ReasonCopyright     ReasonCategory = "copyright"     // 著作権侵害・盗用
+	ReasonImpersonation ReasonCategory = "impersonation" // なりすまし
+	ReasonOther         ReasonCategory = "other"
+)
+
+// ReportStatus は通報の対応状況です
+type ReportStatus string
+
+const (
+	ReportOpen      ReportStatus = "open"      // 未対応
+	ReportResolved  ReportStatus = "resolved"  // 対応済み
+	ReportDismissed ReportStatus = "dismissed" // 問題なしとして却下
+)
+
+const (
+	maxDetailLength = 1000
+	maxNoteLength   = 2000
+)
+
+func validTargetType(t TargetType) bool {
+	return t == TargetPost || t == TargetComment || t == TargetUser
+}
+
+// Report はユーザーからの通報を表すドメインエンティティです
+type Report struct {
+	ID         uint
+	ReporterID uint
+	TargetType TargetType
+	TargetID   uint
+	Category   ReasonCategory
+	Detail     string
+
+	Status       ReportStatus
+	ResolvedByID *uint // 対応した運営ユーザー
+	ResolvedAt   *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewReport は未対応の通報を生成するファクトリメソッドです
+func NewReport(reporterID uint, targetType TargetType, targetID uint, category ReasonCategory, detail string, now time.Time) (*Report, error) {
+	if reporterID == 0 {
+		return nil, fmt.Errorf("通報者は必須です")
+	}
+	if !validTargetType(targetType) || targetID == 0 {
+		return nil, fmt.Errorf("通報の対象が不正です")
+	}
+	switch category {
+	case ReasonSpam, ReasonHarassment, ReasonInappropriate, ReasonCopyright, ReasonImpersonation, ReasonOther:
+	default:
+		return nil, fmt.Errorf("通報理由が不正です: %s", category)
+	}
+	detail = strings.TrimSpace(detail)
+	if category == ReasonOther && detail == "" {
+		return nil, fmt.Errorf("「その他」を選んだ場合は詳細を入力してください")
+	}
+	if utf8.RuneCountInString(detail) > maxDetailLength {
+		return nil, fmt.Errorf("詳細は%d文字以内で入力してください", maxDetailLength)
+	}
+	return &Report{
+		ReporterID: reporterID,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Category:   category,
+		Detail:     detail,
+		Status:     ReportOpen,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// Close は運営が通報への対応を終える振る舞い（resolved または dismissed）
+func (r *Report) Close(status ReportStatus, adminID uint, now time.Time) error {
+	if status != ReportResolved && status != ReportDismissed {
+		return fmt.Errorf("通報の対応状況が不正です: %s", status)
+	}
+	if r.Status != ReportOpen {
+		return fmt.Errorf("この通報は対応済みです")
+	}
+	r.Status = status
+	r.ResolvedByID = &adminID
+	r.ResolvedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
+
+// Note は運営だけが閲覧できる対応メモです
+type Note struct {
+	ID         uint
+	TargetType TargetType
+	TargetID   uint
+	AuthorID   uint
+	Body       string
+	CreatedAt  time.Time
+}
+
+// NewNote は Note を生成するファクトリメソッドです
+func NewNote(targetType TargetType, targetID uint, authorID uint, body string, now time.Time) (*Note, error) {
+	if !validTargetType(targetType) || targetID == 0 {
+		return nil, fmt.Errorf("メモの対象が不正です")
+	}
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, fmt.Errorf("メモの本文は必須です")
+	}
+	if utf8.RuneCountInString(body) > maxNoteLength {
+		return nil, fmt.Errorf("メモは%d文字以内で入力してください", maxNoteLength)
+	}
+	return &Note{
+		TargetType: targetType,
+		TargetID:   targetID,
+		AuthorID:   authorID,
+		Body:       body,
+		CreatedAt:  now,
+	}, nil
+}
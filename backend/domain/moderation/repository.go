@@ -0,0 +1,24 @@
+// backend/domain/moderation/repository.go
+package moderation
+
+// ReportFilter は通報一覧の絞り込み条件です。空の項目は条件に含めません
+type ReportFilter struct {
+	Status     ReportStatus
+	TargetType TargetType
+	Limit      int
+	Offset     int
+}
+
+// Repository は通報と運営メモの永続化インターフェースです
+type Repository interface {
+	CreateReport(r *Report) error
+	UpdateReport(r *Report) error
+	GetReportByID(id uint) (*Report, error)
+	// 古い順（対応待ちの長いもの）から返す
+	FindReports(filter ReportFilter) ([]*Report, int64, error)
+	// 同じユーザーが同じ対象を未対応のまま重ねて通報していないかの確認用
+	HasOpenReport(reporterID uint, targetType TargetType, targetID uint) (bool, error)
+
+	CreateNote(n *Note) error
+	GetNotes(targetType TargetType, targetID uint) ([]*Note, error)
+}
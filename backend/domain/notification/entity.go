@@ -0,0 +1,57 @@
+// backend/domain/notification/entity.go
+package notification
+
+import (
+	"fmt"
+	"time"
+)
+
+// Type は通知の種類です
+type Type string
+
+const (
+	TypeApplicationReceived      Type = "application_received"       // 求人への応募があった
+	TypeApplicationStatusChanged Type = "application_status_changed" // 応募ステータスが変わった
+	TypeOrganizationInvited      Type = "organization_invited"       // 企業アカウントに追加された
+	TypeOrganizationApproved     Type = "organization_approved"      // 作成した企業アカウントが運営に承認された
+	TypeSkillEndorsed            Type = "skill_endorsed"             // プロフィールのスキルが推薦された
+	TypeCollaboratorInvited      Type = "collaborator_invited"       // 作品の共同制作者に招待された
+	TypeCollaboratorAccepted     Type = "collaborator_accepted"      // 招待した共同制作者が承諾した
+	TypeSiteExportCompleted      Type = "site_export_completed"      // 静的サイトの ZIP をダウンロードできるようになった
+	TypeSiteExportFailed         Type = "site_export_failed"         // 静的サイトの ZIP を作れなかった
+)
+
+// Notification はユーザーへのお知らせを表すドメインエンティティです
+type Notification struct {
+	ID        uint
+	UserID    uint   // 通知先ユーザー
+	Type      Type   // 通知の種類
+	Title     string // 一覧に表示する見出し
+	Body      string
+	Link      string // フロントエンド上の遷移先パス
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+// NewNotification は Notification を生成するファクトリメソッドです
+func NewNotification(userID uint, notificationType Type, title, body, link string) (*Notification, error) {
+	if userID == 0 {
+		return nil, fmt.Errorf("通知先ユーザーは必須です")
+	}
+	if title == "" {
+		return nil, fmt.Errorf("通知の見出しは必須です")
+	}
+	return &Notification{
+		UserID:    userID,
+		Type:      notificationType,
+		Title:     title,
+		Body:      body,
+		Link:      link,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// IsRead は既読かどうかを返します
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
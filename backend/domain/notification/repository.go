@@ -0,0 +1,15 @@
+// backend/domain/notification/repository.go
+package notification
+
+// Repository は通知の永続化を抽象化したインターフェースです
+type Repository interface {
+	CreateNotification(n *Notification) error
+
+	// 新しい順に最大 limit 件
+	GetNotificationsByUserID(userID uint, limit int) ([]*Notification, error)
+	CountUnread(userID uint) (int64, error)
+
+	// 本人の通知だけを既読にする（他人の通知 ID は gorm.ErrRecordNotFound）
+	MarkAsRead(userID uint, id uint) error
+	MarkAllAsRead(userID uint) error
+}
@@ -0,0 +1,92 @@
+// backend/domain/organization/entity.go
+package organization
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// MemberRole は企業アカウント内での権限です
+type MemberRole string
+
+const (
+	MemberRoleOwner  MemberRole = "owner"  // 作成者。メンバーの追加ができる
+	MemberRoleMember MemberRole = "member" // 求人の作成・選考ができる
+)
+
+// Status は企業アカウントの審査状況です
+type Status string
+
+const (
+	StatusPending  Status = "pending"  // 運営の審査待ち。求人の掲載や採用担当への昇格はできない
+	StatusApproved Status = "approved" // 運営が実在を確認済み
+)
+
+var ErrAlreadyApproved = errors.New("organization is already approved")
+
+// Organization は求人を掲載する企業アカウントのドメインエンティティです
+type Organization struct {
+	ID          uint
+	Name        string
+	Description string
+	WebsiteURL  string
+	Status      Status
+	ApprovedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Member は企業アカウントに所属するユーザーです
+type Member struct {
+	OrganizationID uint
+	UserID         uint
+	Role           MemberRole
+	// DiscloseViews は採用担当がプロフィール・作品を閲覧したとき、閲覧された学生に企業名を知らせるかです
+	// 本人が選んだ 1 社だけ true にできる（既定は知らせない）
+	DiscloseViews bool
+	CreatedAt     time.Time
+}
+
+// NewOrganization は Organization を生成するファクトリメソッドです
+// 企業名必須、WebサイトURLは http(s) のみ許可します。作成直後は審査待ちです
+func NewOrganization(name, description, websiteURL string) (*Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("企業名は必須です")
+	}
+	if websiteURL != "" {
+		u, err := url.Parse(websiteURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("WebサイトのURLが不正です")
+		}
+	}
+	now := time.Now()
+	return &Organization{
+		Name:        name,
+		Description: description,
+		WebsiteURL:  websiteURL,
+		Status:      StatusPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// IsApproved は運営の審査を通過しているかを返します
+func (o *Organization) IsApproved() bool {
+	return o.Status == StatusApproved
+}
+
+// Approve は審査待ちの企業アカウントを承認済みにします
+func (o *Organization) Approve() error {
+	if o.IsApproved() {
+		return ErrAlreadyApproved
+	}
+	now := time.Now()
+	o.Status = StatusApproved
+	o.ApprovedAt = &now
+	o.UpdatedAt = now
+	return nil
+}
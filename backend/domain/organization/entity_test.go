@@ -0,0 +1,31 @@
+// backend/domain/organization/entity_test.go
+package organization
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewOrganization_StartsPending(t *testing.T) {
+	org, err := NewOrganization("Example Inc.", "", "https://example.com")
+	if err != nil {
+		t.Fatalf("NewOrganization failed: %v", err)
+	}
+	if org.IsApproved() || org.ApprovedAt != nil {
+		t.Errorf("new organization should be pending, got %s", org.Status)
+	}
+}
+
+func TestOrganization_Approve(t *testing.T) {
+	org, _ := NewOrganization("Example Inc.", "", "")
+
+	if err := org.Approve(); err != nil {
+		t.Fatalf("Approve failed: %v", err)
+	}
+	if !org.IsApproved() || org.ApprovedAt == nil {
+		t.Errorf("organization should be approved, got %s", org.Status)
+	}
+	if err := org.Approve(); !errors.Is(err, ErrAlreadyApproved) {
+		t.Errorf("expected ErrAlreadyApproved, got %v", err)
+	}
+}
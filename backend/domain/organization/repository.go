@@ -0,0 +1,22 @@
+// backend/domain/organization/repository.go
+package organization
+
+// Repository は企業アカウントとメンバーの永続化を抽象化したインターフェースです
+type Repository interface {
+	// 作成者をオーナーとして同時に登録する
+	CreateOrganization(o *Organization, ownerUserID uint) error
+	GetOrganizationByID(id uint) (*Organization, error)
+	GetOrganizationsByUserID(userID uint) ([]*Organization, error)
+	GetOrganizationsByStatus(status Status) ([]*Organization, error)
+	// UpdateStatus は審査状況と承認日時を保存します
+	UpdateStatus(o *Organization) error
+
+	AddMember(m *Member) error
+	// 所属していない場合は gorm.ErrRecordNotFound
+	FindMember(organizationID, userID uint) (*Member, error)
+	GetMemberUserIDs(organizationID uint) ([]uint, error)
+	// SetDiscloseViews は閲覧時に企業名を知らせるかを設定します。有効にすると他の企業の設定は無効にする
+	SetDiscloseViews(organizationID, userID uint, enabled bool) error
+	// GetDisclosedOrganizationID は閲覧時に企業名を知らせる承認済みの企業を返します。ない場合は 0
+	GetDisclosedOrganizationID(userID uint) (uint, error)
+}
@@ -0,0 +1,130 @@
+// backend/domain/portfolio/collaborator.go
+package portfolio
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// CollaboratorStatus は共同制作者の招待の状態です
+type CollaboratorStatus string
+
+const (
+	CollaboratorPending  CollaboratorStatus = "pending"  // 招待中。投稿には表示しない
+	CollaboratorAccepted CollaboratorStatus = "accepted" // 承諾済み。投稿と本人のプロフィールに表示する
+)
+
+const (
+	MaxCollaborators = 20 // 1 投稿に招待できる共同制作者の上限（投稿者本人を除く）
+
+	maxContributionLength = 2000
+)
+
+// Collaborator はチームで制作した作品の共同制作者です
+// 投稿者（Post.UserID）が招待し、招待されたユーザーが承諾すると共同制作者になります
+// 担当と貢献内容は共同制作者本人だけが編集します
+type Collaborator struct {
+	ID           uint
+	PostID       uint
+	UserID       uint
+	Status       CollaboratorStatus
+	Role         string // 担当（"フロントエンド担当" など）
+	Contribution string // 貢献内容
+	InvitedBy    uint
+	AcceptedAt   *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+
+	// 以下は読み出し時に設定する表示用の値で、永続化しない
+	FirstName       string
+	LastName        string
+	ProfileImageURL string
+	PostTitle       string // 招待一覧で表示する投稿のタイトル
+}
+
+// NewInvitation は投稿者が userID のユーザーを共同制作者に招待します
+func NewInvitation(post *Post, userID uint) (*Collaborator, error) {
+	if userID == 0 {
+		return nil, fmt.Errorf("招待するユーザーは必須です")
+	}
+	if userID == post.UserID {
+		return nil, fmt.Errorf("投稿者本人は共同制作者に招待できません")
+	}
+	now := time.Now()
+	return &Collaborator{
+		PostID:    post.ID,
+		UserID:    userID,
+		Status:    CollaboratorPending,
+		InvitedBy: post.UserID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// IsAccepted は招待を承諾済みかを返します
+func (c *Collaborator) IsAccepted() bool {
+	return c.Status == CollaboratorAccepted
+}
+
+// Accept は招待を承諾し、本人の担当と貢献内容を登録します
+func (c *Collaborator) Accept(role, contribution string, now time.Time) error {
+	if c.Status != CollaboratorPending {
+		return fmt.Errorf("%w: 招待中の共同制作者だけが承諾できます", ErrInvalidTransition)
+	}
+	if err := c.setContribution(role, contribution); err != nil {
+		return err
+	}
+	c.Status = CollaboratorAccepted
+	c.AcceptedAt = &now
+	c.UpdatedAt = now
+	return nil
+}
+
+// UpdateContribution は承諾済みの共同制作者が自分の担当と貢献内容を更新します
+func (c *Collaborator) UpdateContribution(role, contribution string, now time.Time) error {
+	if c.Status != CollaboratorAccepted {
+		return fmt.Errorf("%w: 招待を承諾してから貢献内容を編集してください", ErrInvalidTransition)
+	}
+	if err := c.setContribution(role, contribution); err != nil {
+		return err
+	}
+	c.UpdatedAt = now
+	return nil
+}
+
+func (c *Collaborator) setContribution(role, contribution string) error {
+	role = strings.TrimSpace(role)
+	contribution = strings.TrimSpace(contribution)
+	if utf8.RuneCountInString(role) > maxRoleLength {
+		return fmt.Errorf("担当は%d文字以内で入力してください", maxRoleLength)
+	}
+	if utf8.RuneCountInString(contribution) > maxContributionLength {
+		return fmt.Errorf("貢献内容は%d文字以内で入力してください", maxContributionLength)
+	}
+	c.Role = role
+	c.Contribution = contribution
+	return nil
+}
+
+// AuthorIDs は投稿者と承諾済みの共同制作者の ID を返します
+func (p *Post) AuthorIDs() []uint {
+	ids := []uint{p.UserID}
+	for _, c := range p.Collaborators {
+		if c.IsAccepted() {
+			ids = append(ids, c.UserID)
+		}
+	}
+	return ids
+}
+
+// IsAuthor は userID が投稿者か承諾済みの共同制作者かを返します
+func (p *Post) IsAuthor(userID uint) bool {
+	for _, id := range p.AuthorIDs() {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
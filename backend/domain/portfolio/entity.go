@@ -3,56 +3,499 @@ package portfolio
 
 import (
 	domainUser "backend/domain/user"
+	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
+	"unicode/utf8"
+)
+
+// Status は投稿の公開状態です
+type Status string
+
+const (
+	StatusDraft     Status = "draft"     // 下書き。本人にしか見えない
+	StatusScheduled Status = "scheduled" // 予約投稿。PublishAt になったら公開する
+	StatusPublished Status = "published" // 公開中
+	StatusArchived  Status = "archived"  // 公開終了。本人にしか見えない
+)
+
+// ErrInvalidTransition は今の公開状態からは行えない操作です
+var ErrInvalidTransition = errors.New("invalid post status transition")
+
+// ErrVersionConflict は読み込んだあとにほかの操作で更新された投稿への保存です
+var ErrVersionConflict = errors.New("the post was updated by another request")
+
+const (
+	MaxImages    = 10  // 1 投稿に載せられる画像の上限
+	MaxTechStack = 30  // 技術スタックの上限
+	MaxTeamSize  = 100 // チーム人数の上限
+
+	maxURLLength     = 2048
+	maxRoleLength    = 100
+	maxAltTextLength = 200
+	maxCaptionLength = 200
+	maxTechLength    = 64
+	maxVersionLength = 32
 )
 
 // Image は投稿に紐づく画像情報（ドメインモデル）
+// Images の並び順がそのまま表示順になります
 type Image struct {
-	URL string
+	URL          string
+	AltText      string // 画像の代替テキスト（必須）
+	Caption      string
+	DisplayOrder int
+	IsCover      bool // 一覧で表示するカバー画像。1 投稿に 1 枚
+}
+
+// TechStackItem は作品で使った技術とそのバージョンです
+type TechStackItem struct {
+	Name    string
+	Version string // 任意。"1.22" など
+}
+
+// ProjectDetails は作品の制作情報です。すべて任意項目
+type ProjectDetails struct {
+	RepositoryURL string
+	DemoURL       string
+	Role          string // 担当した役割（"バックエンド担当" など）
+	TeamSize      int    // 0 は未設定、1 は個人開発
+	StartDate     *time.Time
+	EndDate       *time.Time // 開発中なら nil
+	TechStack     []TechStackItem
 }
 
 // Post は作品投稿を表すドメインエンティティ
 // ビジネスルール（必須チェックなど）を内包します
 type Post struct {
 	ID          uint
+	Title       string
+	Description string // Markdown の原文
+	Genres      []string
+	Skills      []string
+	Images      []Image
+	Status      Status
+	ProjectDetails
+	UserID    uint
+	User      domainUser.UserModel
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// Version は楽観的排他制御の版番号です。保存するたびに 1 増え、ETag に使う
+	Version int
+
+	PublishAt   *time.Time // 予約投稿の公開日時
+	PublishedAt *time.Time // 最初に公開した日時。フィードはこの順に並べる
+	ArchivedAt  *time.Time
+
+	// Collaborators は承諾済みの共同制作者です（招待中の共同制作者は含めない）
+	Collaborators []Collaborator
+
+	// 運営による非表示。非表示の投稿は一覧・詳細のどちらにも出さない
+	HiddenAt     *time.Time
+	HiddenReason string
+
+	// DescriptionHTML は Description を変換・サニタイズした HTML です（保存せず、読み込み時に設定する）
+	DescriptionHTML string
+}
+
+// CheckVersion は読み込んだときの版番号 version が今の版番号と同じかを確認します。version が 0 なら版を問わない
+func (p *Post) CheckVersion(version int) error {
+	if version != 0 && version != p.Version {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// Content は投稿の編集できる内容です。版（Revision）にもこの形で残します
+type Content struct {
 	Title       string
 	Description string
 	Genres      []string
 	Skills      []string
 	Images      []Image
-	UserID      uint
-	User        domainUser.UserModel
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ProjectDetails
 }
 
-// NewPost は Post を生成するファクトリメソッドです
-// タイトル必須、ジャンル1つ以上、画像1枚以上のチェックを行います
+// NewPost は公開済みの Post を生成するファクトリメソッドです
+// タイトル必須、ジャンル1つ以上、画像1枚以上（代替テキスト必須）のチェックと、制作情報の検証を行います
+// カバー画像の指定がなければ先頭の画像をカバーにします
 func NewPost(
 	title, description string,
 	genres, skills []string,
 	images []Image,
+	details ProjectDetails,
 	userID uint,
 ) (*Post, error) {
-	if title == "" {
-		return nil, fmt.Errorf("タイトルは必須です")
+	post, err := NewDraftPost(title, description, genres, skills, details, userID)
+	if err != nil {
+		return nil, err
+	}
+	if post.Images, err = normalizeImages(images); err != nil {
+		return nil, err
 	}
-	if len(genres) == 0 {
-		return nil, fmt.Errorf("ジャンルは1つ以上選択してください")
+	if err := post.Publish(post.CreatedAt); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// NewDraftPost は下書きの Post を生成するファクトリメソッドです
+// 下書きは公開前に仕上げる前提なので、タイトル以外（ジャンル・画像）は公開するときに揃っていればよい
+func NewDraftPost(
+	title, description string,
+	genres, skills []string,
+	details ProjectDetails,
+	userID uint,
+) (*Post, error) {
+	content := Content{
+		Title:          title,
+		Description:    description,
+		Genres:         genres,
+		Skills:         skills,
+		ProjectDetails: details,
 	}
-	if len(images) == 0 {
-		return nil, fmt.Errorf("画像は少なくとも1枚必要です")
+	if err := content.normalize(); err != nil {
+		return nil, err
 	}
 	now := time.Now()
 	return &Post{
-		Title:       title,
-		Description: description,
-		Genres:      genres,
-		Skills:      skills,
-		Images:      images,
-		UserID:      userID,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		Title:          content.Title,
+		Description:    content.Description,
+		Genres:         content.Genres,
+		Skills:         content.Skills,
+		Status:         StatusDraft,
+		ProjectDetails: content.ProjectDetails,
+		UserID:         userID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
 	}, nil
 }
+
+// NewImportedDraft は他のサービスから取り込む投稿を下書きとして生成するファクトリメソッドです
+// 取り込んだあと手を入れずに公開できるよう、内容は NewPost と同じ検証（ジャンル・画像・代替テキスト）を通す
+func NewImportedDraft(
+	title, description string,
+	genres, skills []string,
+	images []Image,
+	details ProjectDetails,
+	userID uint,
+) (*Post, error) {
+	validated, err := NewPost(title, description, genres, skills, images, details, userID)
+	if err != nil {
+		return nil, err
+	}
+	post, err := NewDraftPost(title, description, genres, skills, details, userID)
+	if err != nil {
+		return nil, err
+	}
+	post.Images = validated.Images
+	return post, nil
+}
+
+// Content は投稿の今の内容を返します
+func (p *Post) Content() Content {
+	return Content{
+		Title:          p.Title,
+		Description:    p.Description,
+		Genres:         p.Genres,
+		Skills:         p.Skills,
+		Images:         p.Images,
+		ProjectDetails: p.ProjectDetails,
+	}
+}
+
+// Edit は投稿の内容を置き換えます
+// 下書き以外（予約・公開中・公開終了）は、公開できる内容（ジャンル・画像・代替テキスト）のままでなければならない
+func (p *Post) Edit(c Content, now time.Time) error {
+	if err := c.normalize(); err != nil {
+		return err
+	}
+	if p.Status != StatusDraft {
+		if err := c.validateForPublish(); err != nil {
+			return err
+		}
+	}
+	p.Title = c.Title
+	p.Description = c.Description
+	p.Genres = c.Genres
+	p.Skills = c.Skills
+	p.Images = c.Images
+	p.ProjectDetails = c.ProjectDetails
+	p.UpdatedAt = now
+	return nil
+}
+
+// Publish は下書き・予約投稿を公開します。公開日時は最初に公開したときのものを残す
+func (p *Post) Publish(now time.Time) error {
+	if p.Status != StatusDraft && p.Status != StatusScheduled {
+		return fmt.Errorf("%w: 下書きか予約投稿だけを公開できます", ErrInvalidTransition)
+	}
+	if err := p.Content().validateForPublish(); err != nil {
+		return err
+	}
+	p.Status = StatusPublished
+	p.PublishAt = nil
+	if p.PublishedAt == nil {
+		p.PublishedAt = &now
+	}
+	p.UpdatedAt = now
+	return nil
+}
+
+// Schedule は下書きを at に公開する予約投稿にします。予約済みなら日時を変更します
+func (p *Post) Schedule(at, now time.Time) error {
+	if p.Status != StatusDraft && p.Status != StatusScheduled {
+		return fmt.Errorf("%w: 下書きか予約投稿だけを予約できます", ErrInvalidTransition)
+	}
+	if !at.After(now) {
+		return fmt.Errorf("公開日時は現在より後にしてください")
+	}
+	if err := p.Content().validateForPublish(); err != nil {
+		return err
+	}
+	p.Status = StatusScheduled
+	p.PublishAt = &at
+	p.UpdatedAt = now
+	return nil
+}
+
+// Unschedule は予約を取り消して下書きに戻します
+func (p *Post) Unschedule(now time.Time) error {
+	if p.Status != StatusScheduled {
+		return fmt.Errorf("%w: 予約投稿ではありません", ErrInvalidTransition)
+	}
+	p.Status = StatusDraft
+	p.PublishAt = nil
+	p.UpdatedAt = now
+	return nil
+}
+
+// Archive は公開中の投稿を公開終了にします
+func (p *Post) Archive(now time.Time) error {
+	if p.Status != StatusPublished {
+		return fmt.Errorf("%w: 公開中の投稿だけを公開終了にできます", ErrInvalidTransition)
+	}
+	p.Status = StatusArchived
+	p.ArchivedAt = &now
+	p.UpdatedAt = now
+	return nil
+}
+
+// Unarchive は公開終了にした投稿を再び公開します
+func (p *Post) Unarchive(now time.Time) error {
+	if p.Status != StatusArchived {
+		return fmt.Errorf("%w: 公開終了にした投稿ではありません", ErrInvalidTransition)
+	}
+	p.Status = StatusPublished
+	p.ArchivedAt = nil
+	p.UpdatedAt = now
+	return nil
+}
+
+// IsDue は予約投稿の公開日時を過ぎているかを返します
+func (p *Post) IsDue(now time.Time) bool {
+	return p.Status == StatusScheduled && p.PublishAt != nil && !p.PublishAt.After(now)
+}
+
+// IsDraft は下書きかどうかを返します
+func (p *Post) IsDraft() bool {
+	return p.Status == StatusDraft
+}
+
+// IsPublished は誰でも閲覧できる状態かを返します
+func (p *Post) IsPublished() bool {
+	return p.Status == StatusPublished
+}
+
+// Hide は運営が投稿を非表示にする振る舞い
+func (p *Post) Hide(reason string, now time.Time) error {
+	if reason == "" {
+		return fmt.Errorf("非表示の理由は必須です")
+	}
+	p.HiddenAt = &now
+	p.HiddenReason = reason
+	return nil
+}
+
+// Unhide は非表示を解除する振る舞い
+func (p *Post) Unhide() {
+	p.HiddenAt = nil
+	p.HiddenReason = ""
+}
+
+// IsHidden は運営により非表示にされているかを返します
+func (p *Post) IsHidden() bool {
+	return p.HiddenAt != nil
+}
+
+// SetCoverImage は Images の index 番目をカバー画像にします
+func (p *Post) SetCoverImage(index int) error {
+	if index < 0 || index >= len(p.Images) {
+		return fmt.Errorf("カバー画像の指定が不正です: %d", index)
+	}
+	for i := range p.Images {
+		p.Images[i].IsCover = i == index
+	}
+	return nil
+}
+
+// CoverImage はカバー画像を返します。画像がなければ false を返します
+func (p *Post) CoverImage() (Image, bool) {
+	for _, img := range p.Images {
+		if img.IsCover {
+			return img, true
+		}
+	}
+	if len(p.Images) > 0 {
+		return p.Images[0], true
+	}
+	return Image{}, false
+}
+
+// normalizeImages は画像の代替テキスト・キャプションの長さを検証し、表示順とカバー画像を揃えます
+// 代替テキストが空でもよい（公開するときに validateForPublish で確認する）
+func normalizeImages(images []Image) ([]Image, error) {
+	if len(images) > MaxImages {
+		return nil, fmt.Errorf("画像は%d枚まで登録できます", MaxImages)
+	}
+	normalized := make([]Image, len(images))
+	covers := 0
+	for i, img := range images {
+		img.AltText = strings.TrimSpace(img.AltText)
+		img.Caption = strings.TrimSpace(img.Caption)
+		if utf8.RuneCountInString(img.AltText) > maxAltTextLength {
+			return nil, fmt.Errorf("代替テキストは%d文字以内で入力してください", maxAltTextLength)
+		}
+		if utf8.RuneCountInString(img.Caption) > maxCaptionLength {
+			return nil, fmt.Errorf("キャプションは%d文字以内で入力してください", maxCaptionLength)
+		}
+		if img.IsCover {
+			covers++
+		}
+		img.DisplayOrder = i
+		normalized[i] = img
+	}
+	if covers > 1 {
+		return nil, fmt.Errorf("カバー画像は1枚だけ選択してください")
+	}
+	if covers == 0 && len(normalized) > 0 {
+		normalized[0].IsCover = true
+	}
+	return normalized, nil
+}
+
+// normalize は下書きとして保存できる内容かを確認し、前後の空白などを揃えます
+func (c *Content) normalize() error {
+	c.Title = strings.TrimSpace(c.Title)
+	if c.Title == "" {
+		return fmt.Errorf("タイトルは必須です")
+	}
+	images, err := normalizeImages(c.Images)
+	if err != nil {
+		return err
+	}
+	c.Images = images
+	return c.ProjectDetails.normalize()
+}
+
+// validateForPublish は公開に必要な項目（ジャンル1つ以上、画像1枚以上、すべての画像の代替テキスト）を確認します
+func (c Content) validateForPublish() error {
+	if len(c.Genres) == 0 {
+		return fmt.Errorf("ジャンルは1つ以上選択してください")
+	}
+	if len(c.Images) == 0 {
+		return fmt.Errorf("画像は少なくとも1枚必要です")
+	}
+	for i, img := range c.Images {
+		if img.AltText == "" {
+			return fmt.Errorf("%d枚目の画像の代替テキストは必須です", i+1)
+		}
+	}
+	return nil
+}
+
+// normalize は制作情報の前後の空白を除いて検証します
+func (d *ProjectDetails) normalize() error {
+	var err error
+	if d.RepositoryURL, err = normalizeProjectURL("リポジトリ", d.RepositoryURL); err != nil {
+		return err
+	}
+	if d.DemoURL, err = normalizeProjectURL("デモ", d.DemoURL); err != nil {
+		return err
+	}
+	d.Role = strings.TrimSpace(d.Role)
+	if utf8.RuneCountInString(d.Role) > maxRoleLength {
+		return fmt.Errorf("担当は%d文字以内で入力してください", maxRoleLength)
+	}
+	if d.TeamSize < 0 || d.TeamSize > MaxTeamSize {
+		return fmt.Errorf("チーム人数は1〜%d人で入力してください", MaxTeamSize)
+	}
+	if d.EndDate != nil {
+		if d.StartDate == nil {
+			return fmt.Errorf("終了年月を入力する場合は開始年月も入力してください")
+		}
+		if d.EndDate.Before(*d.StartDate) {
+			return fmt.Errorf("終了年月は開始年月以降にしてください")
+		}
+	}
+	if d.StartDate != nil && d.StartDate.After(time.Now()) {
+		return fmt.Errorf("開始年月に未来の日付は指定できません")
+	}
+	return d.normalizeTechStack()
+}
+
+func (d *ProjectDetails) normalizeTechStack() error {
+	if len(d.TechStack) > MaxTechStack {
+		return fmt.Errorf("技術スタックは%d件まで登録できます", MaxTechStack)
+	}
+	seen := make(map[string]bool, len(d.TechStack))
+	stack := make([]TechStackItem, 0, len(d.TechStack))
+	for _, item := range d.TechStack {
+		item.Name = strings.TrimSpace(item.Name)
+		item.Version = strings.TrimSpace(item.Version)
+		if item.Name == "" {
+			return fmt.Errorf("技術スタックの名前は必須です")
+		}
+		if utf8.RuneCountInString(item.Name) > maxTechLength {
+			return fmt.Errorf("技術スタックの名前は%d文字以内で入力してください", maxTechLength)
+		}
+		if utf8.RuneCountInString(item.Version) > maxVersionLength {
+			return fmt.Errorf("バージョンは%d文字以内で入力してください", maxVersionLength)
+		}
+		key := strings.ToLower(item.Name)
+		if seen[key] {
+			return fmt.Errorf("技術スタックが重複しています: %s", item.Name)
+		}
+		seen[key] = true
+		stack = append(stack, item)
+	}
+	d.TechStack = stack
+	return nil
+}
+
+// IsProjectURL は raw がリポジトリ・デモの URL として登録できる形式かを返します
+func IsProjectURL(raw string) bool {
+	normalized, err := normalizeProjectURL("", raw)
+	return err == nil && normalized != ""
+}
+
+// normalizeProjectURL は http(s) の URL かを確認し、前後の空白を除いて返します。空文字はそのまま返します
+func normalizeProjectURL(label, raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", nil
+	}
+	if len(raw) > maxURLLength {
+		return "", fmt.Errorf("%sのURLは%d文字以内で入力してください", label, maxURLLength)
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || !strings.Contains(u.Hostname(), ".") {
+		return "", fmt.Errorf("%sのURLは http:// または https:// から始まる形式で入力してください: %s", label, raw)
+	}
+	if u.User != nil {
+		return "", fmt.Errorf("%sのURLにユーザー情報を含めることはできません", label)
+	}
+	return raw, nil
+}
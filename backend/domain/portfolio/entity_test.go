@@ -0,0 +1,210 @@
+// backend/domain/portfolio/entity_test.go
+package portfolio
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func month(year int, m time.Month) *time.Time {
+	t := time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func TestNewPost_Images(t *testing.T) {
+	genres := []string{"Web"}
+	if _, err := NewPost("作品", "", genres, nil, []Image{{URL: "a.png"}}, ProjectDetails{}, 1); err == nil {
+		t.Error("expected error when alt text is empty")
+	}
+	if _, err := NewPost("作品", "", genres, nil, []Image{
+		{URL: "a.png", AltText: "a", IsCover: true},
+		{URL: "b.png", AltText: "b", IsCover: true},
+	}, ProjectDetails{}, 1); err == nil {
+		t.Error("expected error when two cover images are chosen")
+	}
+
+	post, err := NewPost("作品", "", genres, nil, []Image{
+		{URL: "a.png", AltText: " トップ画面 "},
+		{URL: "b.png", AltText: "設定画面", Caption: "ダークモード"},
+	}, ProjectDetails{}, 1)
+	if err != nil {
+		t.Fatalf("NewPost failed: %v", err)
+	}
+	if cover, _ := post.CoverImage(); cover.URL != "a.png" || cover.AltText != "トップ画面" {
+		t.Errorf("first image should be the cover by default: %+v", cover)
+	}
+	if post.Images[1].DisplayOrder != 1 {
+		t.Errorf("DisplayOrder = %d, want 1", post.Images[1].DisplayOrder)
+	}
+	if err := post.SetCoverImage(1); err != nil {
+		t.Fatalf("SetCoverImage failed: %v", err)
+	}
+	if cover, _ := post.CoverImage(); cover.URL != "b.png" || post.Images[0].IsCover {
+		t.Errorf("cover was not moved: %+v", post.Images)
+	}
+	if err := post.SetCoverImage(2); err == nil {
+		t.Error("expected error for out of range cover index")
+	}
+}
+
+func TestNewPost_ProjectDetails(t *testing.T) {
+	images := []Image{{URL: "a.png", AltText: "a"}}
+	invalid := map[string]ProjectDetails{
+		"ftp repository":   {RepositoryURL: "ftp://github.com/a/b"},
+		"no host":          {DemoURL: "https://localhost"},
+		"negative team":    {TeamSize: -1},
+		"end before start": {StartDate: month(2024, 6), EndDate: month(2024, 4)},
+		"end only":         {EndDate: month(2024, 4)},
+		"future start":     {StartDate: month(time.Now().Year()+1, 1)},
+		"empty tech":       {TechStack: []TechStackItem{{Name: " "}}},
+		"duplicate tech":   {TechStack: []TechStackItem{{Name: "Go"}, {Name: "go", Version: "1.22"}}},
+	}
+	for name, details := range invalid {
+		if _, err := NewPost("作品", "", []string{"Web"}, nil, images, details, 1); err == nil {
+			t.Errorf("%s: expected validation error", name)
+		}
+	}
+
+	post, err := NewPost("作品", "", []string{"Web"}, nil, images, ProjectDetails{
+		RepositoryURL: " https://github.com/a/b ",
+		Role:          "バックエンド",
+		TeamSize:      3,
+		StartDate:     month(2024, 4),
+		TechStack:     []TechStackItem{{Name: "Go", Version: " 1.22 "}},
+	}, 1)
+	if err != nil {
+		t.Fatalf("NewPost failed: %v", err)
+	}
+	if post.RepositoryURL != "https://github.com/a/b" || post.TechStack[0].Version != "1.22" {
+		t.Errorf("details were not normalized: %+v", post.ProjectDetails)
+	}
+}
+
+func TestPost_Lifecycle(t *testing.T) {
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	post, err := NewDraftPost("作品", "", nil, nil, ProjectDetails{}, 1)
+	if err != nil {
+		t.Fatalf("NewDraftPost failed: %v", err)
+	}
+	if err := post.Publish(now); err == nil {
+		t.Error("draft without genres and images should not be publishable")
+	}
+	if err := post.Archive(now); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("Archive on draft = %v, want ErrInvalidTransition", err)
+	}
+
+	// 下書きはジャンル・画像がなくても編集できる
+	if err := post.Edit(Content{Title: "作品", Images: []Image{{URL: "a.png"}}}, now); err != nil {
+		t.Fatalf("Edit draft failed: %v", err)
+	}
+	if err := post.Schedule(now.Add(time.Hour), now); err == nil {
+		t.Error("schedule should require alt text")
+	}
+	if err := post.Edit(Content{Title: "作品", Genres: []string{"Web"}, Images: []Image{{URL: "a.png", AltText: "a"}}}, now); err != nil {
+		t.Fatalf("Edit draft failed: %v", err)
+	}
+	if err := post.Schedule(now.Add(-time.Minute), now); err == nil {
+		t.Error("schedule in the past should fail")
+	}
+	if err := post.Schedule(now.Add(time.Hour), now); err != nil {
+		t.Fatalf("Schedule failed: %v", err)
+	}
+	if post.IsDue(now) || !post.IsDue(now.Add(time.Hour)) {
+		t.Error("IsDue should turn true at PublishAt")
+	}
+	if err := post.Publish(now.Add(time.Hour)); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+	if post.PublishAt != nil || post.PublishedAt == nil || !post.PublishedAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("unexpected publish times: %v %v", post.PublishAt, post.PublishedAt)
+	}
+
+	// 公開中の投稿は公開できない内容に編集できない
+	if err := post.Edit(Content{Title: "作品", Images: []Image{{URL: "a.png", AltText: "a"}}}, now); err == nil {
+		t.Error("published post should keep at least one genre")
+	}
+	if err := post.Archive(now); err != nil {
+		t.Fatalf("Archive failed: %v", err)
+	}
+	if err := post.Unarchive(now); err != nil || !post.IsPublished() {
+		t.Fatalf("Unarchive failed: %v", err)
+	}
+}
+
+func TestNewImportedDraft(t *testing.T) {
+	images := []Image{{AltText: "画面"}, {AltText: "構成図", IsCover: true}}
+	post, err := NewImportedDraft("作品", "", []string{"Web"}, nil, images, ProjectDetails{}, 1)
+	if err != nil {
+		t.Fatalf("NewImportedDraft failed: %v", err)
+	}
+	if post.Status != StatusDraft || post.PublishedAt != nil {
+		t.Errorf("imported post should be an unpublished draft, got %s", post.Status)
+	}
+	if len(post.Images) != 2 || !post.Images[1].IsCover || post.Images[1].DisplayOrder != 1 {
+		t.Errorf("images should be normalized as in NewPost: %+v", post.Images)
+	}
+	// 下書きでも公開できない内容は取り込まない
+	if _, err := NewImportedDraft("作品", "", nil, nil, images, ProjectDetails{}, 1); err == nil {
+		t.Error("entry without genres should be rejected")
+	}
+	if _, err := NewImportedDraft("作品", "", []string{"Web"}, nil, []Image{{}}, ProjectDetails{}, 1); err == nil {
+		t.Error("entry with an image without alt text should be rejected")
+	}
+}
+
+func TestPost_CheckVersion(t *testing.T) {
+	p := &Post{Version: 2}
+	if err := p.CheckVersion(2); err != nil {
+		t.Errorf("same version should pass, got %v", err)
+	}
+	if err := p.CheckVersion(0); err != nil {
+		t.Errorf("version 0 should skip the check, got %v", err)
+	}
+	if err := p.CheckVersion(1); !errors.Is(err, ErrVersionConflict) {
+		t.Errorf("stale version should conflict, got %v", err)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	from := Content{Title: "作品", Description: "概要\n機能A\n機能B", Genres: []string{"Web"}}
+	to := Content{Title: "作品", Description: "概要\n機能B\n機能C", Genres: []string{"Web"}, Skills: []string{}}
+	changes := Diff(from, to)
+	if len(changes) != 1 || changes[0].Field != "description" {
+		t.Fatalf("Diff = %+v, want only description", changes)
+	}
+	want := []LineChange{
+		{LineEqual, "概要"},
+		{LineRemoved, "機能A"},
+		{LineEqual, "機能B"},
+		{LineAdded, "機能C"},
+	}
+	if !reflect.DeepEqual(changes[0].Lines, want) {
+		t.Errorf("Lines = %+v, want %+v", changes[0].Lines, want)
+	}
+}
+
+func TestCollaborator(t *testing.T) {
+	post := &Post{ID: 1, UserID: 10}
+	if _, err := NewInvitation(post, 10); err == nil {
+		t.Error("the owner should not be invitable")
+	}
+	c, err := NewInvitation(post, 20)
+	if err != nil {
+		t.Fatalf("NewInvitation failed: %v", err)
+	}
+	now := time.Now()
+	if err := c.UpdateContribution("API", "", now); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("UpdateContribution before accepting = %v, want ErrInvalidTransition", err)
+	}
+	if err := c.Accept(" フロントエンド ", "画面を実装", now); err != nil {
+		t.Fatalf("Accept failed: %v", err)
+	}
+	if c.Role != "フロントエンド" || c.AcceptedAt == nil {
+		t.Errorf("unexpected collaborator: %+v", c)
+	}
+	if err := c.Accept("", "", now); err == nil {
+		t.Error("accepting twice should fail")
+	}
+}
@@ -1,11 +1,70 @@
-// 2. backend/domain/portfolio/repository.go
 package portfolio
 
+import "time"
+
+// SortOrder は投稿フィードの並び順です
+type SortOrder string
+
+const (
+	SortNewest   SortOrder = "newest"   // 公開の新しい順（既定）
+	SortTrending SortOrder = "trending" // 定期的に計算する人気度の高い順。人気度のない投稿は新しい順で後ろに並ぶ
+)
+
+// SearchCriteria は投稿フィードの検索条件です。空の項目は条件に含めません
+type SearchCriteria struct {
+	Keyword        string   // タイトル・説明文の部分一致
+	Genres         []string // すべてのジャンルを含む投稿
+	Skills         []string // すべてのスキルを含む投稿
+	GraduationYear string   // 投稿者の卒業年
+	PublishedAfter time.Time
+	Sort           SortOrder
+}
+
 // Repository は投稿エンティティの永続化を抽象化したインターフェースです
 // サービス層はこのインターフェースだけを依存先として扱います
 type Repository interface {
 	CreatePost(post *Post) error
 	GetPostByID(id uint) (*Post, error)
+	// GetPostsByUserID は本人の投稿と、共同制作者として承諾した投稿を返します
 	GetPostsByUserID(userID uint) ([]*Post, error)
 	GetAllPosts() ([]*Post, error)
+	SearchPosts(criteria SearchCriteria) ([]*Post, error)
+
+	// 以下は投稿者本人向け。上のメソッドは公開中の投稿だけを返す
+	// GetPostByIDAnyStatus は公開状態によらず投稿を返します（非表示の投稿は返さない）
+	GetPostByIDAnyStatus(id uint) (*Post, error)
+	// GetPostsByStatus は本人の投稿のうち指定した公開状態のものを更新の新しい順に返します
+	GetPostsByStatus(userID uint, statuses ...Status) ([]*Post, error)
+	// GetPostsByIDs は公開状態によらず ids の投稿を返します。見つからない ID は結果に含めない（順不同）
+	GetPostsByIDs(ids []uint) ([]*Post, error)
+	// UpdatePost は内容（画像・技術スタックを含む）を保存します。公開状態は保存しない
+	// 版番号が p.Version のままのときだけ保存して版番号を進め、ほかの操作が先に保存していたら ErrVersionConflict を返す
+	UpdatePost(p *Post) error
+	// UpdatePostStatus は公開状態が from のままのときだけ公開状態を保存します
+	// ほかの操作や別のレプリカが先に変更していた場合は false を返す。保存できたら p.Version を保存後の版番号にする
+	UpdatePostStatus(p *Post, from Status) (bool, error)
+	// GetDuePosts は公開日時を過ぎた予約投稿を返します
+	GetDuePosts(now time.Time) ([]*Post, error)
+	// DeletePost は投稿を削除します（論理削除）
+	DeletePost(id uint) error
+
+	// 版の履歴。版は追加だけで、更新・削除はしない
+	// CreateRevision は投稿ごとに次の番号を振って版を保存します
+	CreateRevision(rev *Revision) error
+	GetRevisions(postID uint) ([]*Revision, error)
+	GetRevision(postID uint, number int) (*Revision, error)
+
+	// 共同制作者。見つからない場合は gorm.ErrRecordNotFound
+	CreateCollaborator(c *Collaborator) error
+	GetCollaborator(postID, userID uint) (*Collaborator, error)
+	// GetCollaborators は招待中を含む投稿の共同制作者を招待の古い順に返します
+	GetCollaborators(postID uint) ([]*Collaborator, error)
+	// GetInvitations は userID のユーザーへの招待中の共同制作の依頼を返します
+	GetInvitations(userID uint) ([]*Collaborator, error)
+	UpdateCollaborator(c *Collaborator) error
+	DeleteCollaborator(postID, userID uint) error
+
+	// 以下は運営向け。上のメソッドは非表示の投稿を返さない
+	GetPostByIDIncludingHidden(id uint) (*Post, error)
+	UpdatePostVisibility(p *Post) error
 }
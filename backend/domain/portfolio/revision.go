@@ -0,0 +1,184 @@
+// backend/domain/portfolio/revision.go
+package portfolio
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// RevisionReason は版を残したきっかけです
+type RevisionReason string
+
+const (
+	RevisionCreated  RevisionReason = "created"  // 編集前の内容（最初の編集のときに残す）
+	RevisionEdited   RevisionReason = "edited"   // 本人による編集
+	RevisionRestored RevisionReason = "restored" // 過去の版に戻した
+)
+
+// Revision は投稿の内容の版です。一度保存したら変更しない
+type Revision struct {
+	ID           uint
+	PostID       uint
+	Number       int // 投稿ごとに 1 から振る（リポジトリで採番する）
+	EditorID     uint
+	Reason       RevisionReason
+	RestoredFrom int // Reason が restored のとき、戻した版の番号
+	Content      Content
+	CreatedAt    time.Time
+}
+
+// NewRevision は投稿の今の内容から版を作ります
+func NewRevision(post *Post, editorID uint, reason RevisionReason, now time.Time) *Revision {
+	content := post.Content()
+	// 後から投稿を編集しても版の内容が変わらないようにスライスを複製する
+	content.Genres = append([]string(nil), content.Genres...)
+	content.Skills = append([]string(nil), content.Skills...)
+	content.Images = append([]Image(nil), content.Images...)
+	content.TechStack = append([]TechStackItem(nil), content.TechStack...)
+	return &Revision{
+		PostID:    post.ID,
+		EditorID:  editorID,
+		Reason:    reason,
+		Content:   content,
+		CreatedAt: now,
+	}
+}
+
+// LineOp は説明文の行単位の差分の種類です
+type LineOp string
+
+const (
+	LineEqual   LineOp = "equal"
+	LineAdded   LineOp = "added"
+	LineRemoved LineOp = "removed"
+)
+
+// LineChange は説明文の差分の 1 行です
+type LineChange struct {
+	Op   LineOp `json:"op"`
+	Text string `json:"text"`
+}
+
+// FieldChange は版の間で変わった項目です。説明文は行単位の差分も返します
+type FieldChange struct {
+	Field string       `json:"field"`
+	From  interface{}  `json:"from"`
+	To    interface{}  `json:"to"`
+	Lines []LineChange `json:"lines,omitempty"`
+}
+
+// maxDiffCells は行単位の差分を計算する上限（行数の積）です。超えた場合は全行の削除・追加として返す
+const maxDiffCells = 4_000_000
+
+// Diff は from から to への変更を項目ごとに返します
+func Diff(from, to Content) []FieldChange {
+	fields := []struct {
+		name     string
+		from, to interface{}
+	}{
+		{"title", from.Title, to.Title},
+		{"description", from.Description, to.Description},
+		{"genres", from.Genres, to.Genres},
+		{"skills", from.Skills, to.Skills},
+		{"images", from.Images, to.Images},
+		{"repositoryUrl", from.RepositoryURL, to.RepositoryURL},
+		{"demoUrl", from.DemoURL, to.DemoURL},
+		{"role", from.Role, to.Role},
+		{"teamSize", from.TeamSize, to.TeamSize},
+		{"startDate", from.StartDate, to.StartDate},
+		{"endDate", from.EndDate, to.EndDate},
+		{"techStack", from.TechStack, to.TechStack},
+	}
+	changes := []FieldChange{}
+	for _, f := range fields {
+		if equalValues(f.from, f.to) {
+			continue
+		}
+		change := FieldChange{Field: f.name, From: f.from, To: f.to}
+		if f.name == "description" {
+			change.Lines = DiffLines(from.Description, to.Description)
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// DiffLines は a から b への行単位の差分を返します（最長共通部分列による）
+func DiffLines(a, b string) []LineChange {
+	as, bs := splitLines(a), splitLines(b)
+	if len(as)*len(bs) > maxDiffCells {
+		changes := make([]LineChange, 0, len(as)+len(bs))
+		for _, line := range as {
+			changes = append(changes, LineChange{Op: LineRemoved, Text: line})
+		}
+		for _, line := range bs {
+			changes = append(changes, LineChange{Op: LineAdded, Text: line})
+		}
+		return changes
+	}
+
+	// lcs[i][j] は as[i:] と bs[j:] の最長共通部分列の長さ
+	lcs := make([][]int32, len(as)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(bs)+1)
+	}
+	for i := len(as) - 1; i >= 0; i-- {
+		for j := len(bs) - 1; j >= 0; j-- {
+			if as[i] == bs[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	changes := make([]LineChange, 0, len(as)+len(bs))
+	i, j := 0, 0
+	for i < len(as) && j < len(bs) {
+		switch {
+		case as[i] == bs[j]:
+			changes = append(changes, LineChange{Op: LineEqual, Text: as[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			changes = append(changes, LineChange{Op: LineRemoved, Text: as[i]})
+			i++
+		default:
+			changes = append(changes, LineChange{Op: LineAdded, Text: bs[j]})
+			j++
+		}
+	}
+	for ; i < len(as); i++ {
+		changes = append(changes, LineChange{Op: LineRemoved, Text: as[i]})
+	}
+	for ; j < len(bs); j++ {
+		changes = append(changes, LineChange{Op: LineAdded, Text: bs[j]})
+	}
+	return changes
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
+
+// equalValues は nil と空のスライス、同じ日時を指すポインタを等しいとみなして比較します
+func equalValues(a, b interface{}) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Kind() == reflect.Slice && vb.Kind() == reflect.Slice && va.Len() == 0 && vb.Len() == 0 {
+		return true
+	}
+	if ta, ok := a.(*time.Time); ok {
+		tb := b.(*time.Time)
+		if ta == nil || tb == nil {
+			return ta == nil && tb == nil
+		}
+		return ta.Equal(*tb)
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,88 @@
+// backend/domain/postimport/entity.go
+package postimport
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+)
+
+const (
+	ManifestName    = "manifest.json" // ZIP に入れるマニフェストのファイル名
+	MaxEntries      = 100             // 1 回で取り込める投稿の上限
+	MaxManifestSize = 1 << 20         // マニフェストの大きさの上限（バイト）
+)
+
+// Manifest は一括取り込みする投稿の一覧です
+//
+//	{"posts": [{"title": "...", "genres": ["Web"], "images": [{"file": "images/top.png", "alt": "トップ画面"}]}]}
+type Manifest struct {
+	Posts []Entry `json:"posts"`
+}
+
+// Entry は取り込む投稿 1 件です。内容の検証は portfolio.NewImportedDraft で行う
+type Entry struct {
+	Title       string       `json:"title"`
+	Description string       `json:"description"` // Markdown
+	Genres      []string     `json:"genres"`
+	Skills      []string     `json:"skills"`
+	Links       Links        `json:"links"`
+	Images      []EntryImage `json:"images"`
+}
+
+// Links は作品のリポジトリとデモの URL です
+type Links struct {
+	Repository string `json:"repository"`
+	Demo       string `json:"demo"`
+}
+
+// EntryImage は ZIP に同梱した画像 1 枚です。File はマニフェストからの相対パス
+type EntryImage struct {
+	File    string `json:"file"`
+	Alt     string `json:"alt"`
+	Caption string `json:"caption"`
+	Cover   bool   `json:"cover"`
+}
+
+// ItemError は取り込めなかった投稿と、その理由です。Index はマニフェストの posts の 0 始まりの位置
+type ItemError struct {
+	Index int    `json:"index"`
+	Title string `json:"title"`
+	Error string `json:"error"`
+}
+
+// ParseManifest はマニフェストの JSON を読み込みます
+func ParseManifest(data []byte) (*Manifest, error) {
+	if len(data) > MaxManifestSize {
+		return nil, fmt.Errorf("マニフェストは%dMBまでです", MaxManifestSize>>20)
+	}
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("マニフェストの形式が不正です: %v", err)
+	}
+	if len(m.Posts) == 0 {
+		return nil, fmt.Errorf("取り込む投稿がありません")
+	}
+	if len(m.Posts) > MaxEntries {
+		return nil, fmt.Errorf("一度に取り込める投稿は%d件までです", MaxEntries)
+	}
+	return &m, nil
+}
+
+// ResolvePath は画像のパスを ZIP 内のパスにします。base はマニフェストのあるディレクトリ（ルートなら空文字）
+// base の外を指すパスや絶対パスはエラー
+func ResolvePath(base, file string) (string, error) {
+	file = strings.ReplaceAll(strings.TrimSpace(file), "\\", "/")
+	if file == "" {
+		return "", fmt.Errorf("画像のファイル名は必須です")
+	}
+	if path.IsAbs(file) {
+		return "", fmt.Errorf("画像のパスが不正です: %s", file)
+	}
+	resolved := path.Clean(path.Join(base, file))
+	if resolved == ".." || strings.HasPrefix(resolved, "../") || (base != "" && !strings.HasPrefix(resolved, base+"/")) {
+		return "", fmt.Errorf("画像のパスが不正です: %s", file)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,50 @@
+// backend/domain/postimport/entity_test.go
+package postimport
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseManifest(t *testing.T) {
+	m, err := ParseManifest([]byte(`{"posts": [{"title": "作品", "genres": ["Web"], "links": {"repository": "https://github.com/a/b"},
+		"images": [{"file": "images/top.png", "alt": "トップ", "cover": true}]}]}`))
+	if err != nil {
+		t.Fatalf("ParseManifest failed: %v", err)
+	}
+	if len(m.Posts) != 1 || m.Posts[0].Links.Repository != "https://github.com/a/b" || !m.Posts[0].Images[0].Cover {
+		t.Errorf("ParseManifest = %+v", m)
+	}
+
+	if _, err := ParseManifest([]byte(`{"posts": []}`)); err == nil {
+		t.Error("empty manifest should be rejected")
+	}
+	if _, err := ParseManifest([]byte(`[{"title": "作品"}]`)); err == nil {
+		t.Error("manifest must be an object with posts")
+	}
+	many := `{"posts": [` + strings.TrimSuffix(strings.Repeat(`{"title": "a"},`, MaxEntries+1), ",") + `]}`
+	if _, err := ParseManifest([]byte(many)); err == nil {
+		t.Errorf("more than %d entries should be rejected", MaxEntries)
+	}
+}
+
+func TestResolvePath(t *testing.T) {
+	cases := []struct {
+		base, file, want string
+		ok               bool
+	}{
+		{"", "images/top.png", "images/top.png", true},
+		{"export", "./images/../top.png", "export/top.png", true},
+		{"export", `images\top.png`, "export/images/top.png", true},
+		{"", "../secret.png", "", false},
+		{"export", "../other/top.png", "", false},
+		{"", "/etc/passwd", "", false},
+		{"", " ", "", false},
+	}
+	for _, c := range cases {
+		got, err := ResolvePath(c.base, c.file)
+		if (err == nil) != c.ok || got != c.want {
+			t.Errorf("ResolvePath(%q, %q) = %q, %v; want %q (ok=%v)", c.base, c.file, got, err, c.want, c.ok)
+		}
+	}
+}
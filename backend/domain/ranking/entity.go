@@ -0,0 +1,124 @@
+// backend/domain/ranking/entity.go
+package ranking
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Signal は人気度の計算に使う反応の種類です
+type Signal string
+
+const (
+	SignalView     Signal = "view"
+	SignalComment  Signal = "comment"
+	SignalLike     Signal = "like"
+	SignalBookmark Signal = "bookmark"
+)
+
+// Event は投稿への反応 1 件です。UserID が 0 の場合は未ログインの閲覧者で、VisitorKey で見分ける
+type Event struct {
+	PostID     uint
+	UserID     uint
+	VisitorKey string
+	Signal     Signal
+	At         time.Time
+}
+
+// actor は同じ人の反応をまとめるための識別子です
+func (e Event) actor() string {
+	if e.UserID != 0 {
+		return fmt.Sprintf("u:%d", e.UserID)
+	}
+	return e.VisitorKey
+}
+
+// Config は人気度の計算の設定です
+type Config struct {
+	HalfLife    time.Duration      // 反応の重みが半分になるまでの時間
+	Window      time.Duration      // これより古い反応は数えない
+	Weights     map[Signal]float64 // 反応の種類ごとの重み
+	PerActorCap float64            // 1 人が 1 投稿に与えられる重みの上限（減衰前）
+}
+
+// DefaultConfig はホームフィードの「トレンド」に使う設定です
+var DefaultConfig = Config{
+	HalfLife: 36 * time.Hour,
+	Window:   14 * 24 * time.Hour,
+	Weights: map[Signal]float64{
+		SignalView:     1,
+		SignalComment:  3,
+		SignalLike:     4,
+		SignalBookmark: 5,
+	},
+	PerActorCap: 10,
+}
+
+// Score は投稿の人気度です
+type Score struct {
+	PostID uint
+	Score  float64
+}
+
+// Compute は反応から投稿ごとの人気度を計算し、高い順に返します
+// authors は人気度を付ける投稿と、その投稿者・共同制作者の ID です。authors にない投稿への反応と、
+// 投稿者・共同制作者自身の反応（自作自演）は数えない。同じ人の反応は PerActorCap までしか数えない
+func Compute(events []Event, authors map[uint][]uint, now time.Time, cfg Config) []Score {
+	// 新しい反応から上限を割り当てるので、時刻の新しい順に並べる
+	sorted := append([]Event(nil), events...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].At.After(sorted[j].At) })
+
+	type key struct {
+		postID uint
+		actor  string
+	}
+	used := make(map[key]float64)
+	totals := make(map[uint]float64)
+	for _, e := range sorted {
+		postAuthors, ok := authors[e.PostID]
+		if !ok || isAuthor(postAuthors, e.UserID) {
+			continue
+		}
+		age := now.Sub(e.At)
+		if age < 0 || age > cfg.Window {
+			continue
+		}
+		actor := e.actor()
+		if actor == "" {
+			continue
+		}
+		k := key{e.PostID, actor}
+		weight := math.Min(cfg.Weights[e.Signal], cfg.PerActorCap-used[k])
+		if weight <= 0 {
+			continue
+		}
+		used[k] += weight
+		totals[e.PostID] += weight * math.Pow(0.5, age.Hours()/cfg.HalfLife.Hours())
+	}
+
+	scores := make([]Score, 0, len(totals))
+	for postID, total := range totals {
+		scores = append(scores, Score{PostID: postID, Score: total})
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].PostID > scores[j].PostID
+	})
+	return scores
+}
+
+func isAuthor(authors []uint, userID uint) bool {
+	if userID == 0 {
+		return false
+	}
+	for _, id := range authors {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+// backend/domain/ranking/entity_test.go
+package ranking
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCompute_Decay(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	authors := map[uint][]uint{1: {100}, 2: {200}}
+	events := []Event{
+		{PostID: 1, UserID: 10, Signal: SignalLike, At: now},
+		{PostID: 2, UserID: 10, Signal: SignalLike, At: now.Add(-DefaultConfig.HalfLife)},
+		{PostID: 2, UserID: 11, Signal: SignalLike, At: now.Add(-DefaultConfig.Window - time.Hour)}, // 期間外
+		{PostID: 3, UserID: 10, Signal: SignalLike, At: now},                                        // 対象外の投稿
+	}
+	scores := Compute(events, authors, now, DefaultConfig)
+	if len(scores) != 2 || scores[0].PostID != 1 {
+		t.Fatalf("Compute() = %+v, want post 1 first", scores)
+	}
+	if math.Abs(scores[0].Score-4) > 1e-9 || math.Abs(scores[1].Score-2) > 1e-9 {
+		t.Errorf("a like should weigh 4 now and 2 after one half-life: %+v", scores)
+	}
+}
+
+func TestCompute_AntiGaming(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	authors := map[uint][]uint{1: {100, 101}}
+	var events []Event
+	// 投稿者・共同制作者の反応は数えない
+	events = append(events,
+		Event{PostID: 1, UserID: 100, Signal: SignalBookmark, At: now},
+		Event{PostID: 1, UserID: 101, Signal: SignalLike, At: now},
+	)
+	// 1 人のコメントの連投は PerActorCap で頭打ちになる
+	for i := 0; i < 20; i++ {
+		events = append(events, Event{PostID: 1, UserID: 10, Signal: SignalComment, At: now})
+	}
+	scores := Compute(events, authors, now, DefaultConfig)
+	if len(scores) != 1 || math.Abs(scores[0].Score-DefaultConfig.PerActorCap) > 1e-9 {
+		t.Errorf("Compute() = %+v, want a single capped score of %v", scores, DefaultConfig.PerActorCap)
+	}
+
+	// 未ログインの閲覧者は VisitorKey ごとに数える
+	scores = Compute([]Event{
+		{PostID: 1, VisitorKey: "a:1", Signal: SignalView, At: now},
+		{PostID: 1, VisitorKey: "a:2", Signal: SignalView, At: now},
+	}, authors, now, DefaultConfig)
+	if len(scores) != 1 || math.Abs(scores[0].Score-2) > 1e-9 {
+		t.Errorf("Compute() = %+v, want 2 views", scores)
+	}
+}
@@ -0,0 +1,12 @@
+// backend/domain/ranking/repository.go
+package ranking
+
+import "time"
+
+// Repository は人気度の計算に使う反応の読み出しと、計算した人気度の保存を抽象化したインターフェースです
+type Repository interface {
+	// GetEvents は since 以降の閲覧・コメント・いいね・ブックマークを返します
+	GetEvents(since time.Time) ([]Event, error)
+	// ReplaceScores は保存済みの人気度をすべて scores で置き換えます
+	ReplaceScores(scores []Score, computedAt time.Time) error
+}
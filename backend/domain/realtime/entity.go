@@ -0,0 +1,51 @@
+// backend/domain/realtime/entity.go
+package realtime
+
+import (
+	"fmt"
+	"time"
+)
+
+// EventType はリアルタイム配信するイベントの種類です
+type EventType string
+
+const (
+	EventNotification EventType = "notification" // 新着通知
+	EventMessage      EventType = "message"      // 新着メッセージ
+	EventComment      EventType = "comment"      // 投稿へのコメント
+
+	// EventReset は取りこぼしが多すぎて再送できないことを伝える制御イベントです
+	// 永続化はせず、受け取ったクライアントは通知などを取得し直します
+	EventReset EventType = "reset"
+)
+
+// Event はユーザーに配信されるイベントを表すドメインエンティティです
+// ID は永続化時に採番され、SSE の id (Last-Event-ID) としてそのまま使います
+type Event struct {
+	ID        uint
+	UserID    uint      // 配信先ユーザー
+	Type      EventType // イベント種別
+	Data      string    // JSON 文字列のペイロード
+	CreatedAt time.Time
+}
+
+// NewEvent は配信イベントを生成するファクトリメソッドです
+func NewEvent(userID uint, eventType EventType, data string) (*Event, error) {
+	if userID == 0 {
+		return nil, fmt.Errorf("配信先ユーザーは必須です")
+	}
+	switch eventType {
+	case EventNotification, EventMessage, EventComment:
+	default:
+		return nil, fmt.Errorf("不明なイベント種別です: %s", eventType)
+	}
+	if data == "" {
+		data = "{}"
+	}
+	return &Event{
+		UserID:    userID,
+		Type:      eventType,
+		Data:      data,
+		CreatedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,29 @@
+// backend/domain/realtime/repository.go
+package realtime
+
+import "time"
+
+// IEventRepository は配信イベントの永続化を表すインターフェースです
+// 再接続時 (Last-Event-ID) の取りこぼし再送に使います
+type IEventRepository interface {
+	CreateEvent(e *Event) error
+	FindEventByID(id uint) (*Event, error)
+
+	// afterID より新しいユーザー宛てイベントを ID 昇順で最大 limit 件返す
+	FindEventsAfter(userID uint, afterID uint, limit int) ([]*Event, error)
+
+	// 保持期間を過ぎたイベントの削除
+	DeleteEventsBefore(cutoff time.Time) error
+}
+
+// Broker はレプリカ間でイベントを中継する Pub/Sub のバックエンドです
+// Publish されたイベントは、発行元を含む全レプリカの Listen ハンドラに届きます
+type Broker interface {
+	// 永続化済みのイベントを全レプリカへ通知する
+	Publish(e *Event) error
+
+	// 受信したイベントを handler に渡し続ける（呼び出しはブロックしない）
+	Listen(handler func(e *Event)) error
+
+	Close() error
+}
@@ -0,0 +1,358 @@
+// backend/domain/recommendation/entity.go
+package recommendation
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Item は推薦の対象にする公開中の投稿 1 件の特徴です
+type Item struct {
+	PostID    uint
+	AuthorIDs []uint // 投稿者と共同制作者。本人の投稿は本人に推薦しない
+	Genres    []string
+	Skills    []string
+	Text      string // タイトルと説明文
+}
+
+// Interaction はユーザーが投稿に付けたいいね・ブックマークです
+type Interaction struct {
+	UserID uint
+	PostID uint
+}
+
+// Profile は「あなたへのおすすめ」に使うユーザーの情報です
+type Profile struct {
+	UserID       uint
+	Skills       []string
+	JobTypes     []string // 希望職種
+	Interactions []uint   // いいね・ブックマークした投稿
+}
+
+// Similarity は投稿 PostID に似ている投稿 SimilarPostID とその類似度です
+type Similarity struct {
+	PostID        uint
+	SimilarPostID uint
+	Score         float64
+}
+
+// Recommendation はユーザーに推薦する投稿とそのスコアです
+type Recommendation struct {
+	UserID uint
+	PostID uint
+	Score  float64
+}
+
+// Config は類似度・推薦スコアの重みと件数の設定です
+type Config struct {
+	// 似ている投稿: ジャンル・スキルの Jaccard 係数、本文の TF-IDF のコサイン類似度、
+	// いいね・ブックマークしたユーザーの重なり（共起）の重み付き和
+	GenreWeight        float64
+	SkillWeight        float64
+	TextWeight         float64
+	CoEngagementWeight float64
+
+	// あなたへのおすすめ: 本人のスキルと投稿のスキルの重なり、希望職種と投稿の本文・ジャンルの近さ、
+	// いいね・ブックマークした投稿との類似度の重み付き和
+	ProfileSkillWeight   float64
+	ProfileJobTypeWeight float64
+	HistoryWeight        float64
+
+	MinScore           float64 // これ未満のスコアは結果に含めない
+	MaxSimilar         int     // 1 投稿あたりに保存する似ている投稿の数
+	MaxRecommendations int     // 1 ユーザーあたりに保存するおすすめの数
+}
+
+// DefaultConfig は投稿詳細の「似ている投稿」と「あなたへのおすすめ」に使う設定です
+var DefaultConfig = Config{
+	GenreWeight:          0.3,
+	SkillWeight:          0.3,
+	TextWeight:           0.2,
+	CoEngagementWeight:   0.2,
+	ProfileSkillWeight:   0.4,
+	ProfileJobTypeWeight: 0.2,
+	HistoryWeight:        0.4,
+	MinScore:             0.05,
+	MaxSimilar:           20,
+	MaxRecommendations:   50,
+}
+
+// Tokenize は本文を TF-IDF 用の語に分けます
+// 英数字は空白・記号で区切った 2 文字以上の語を小文字にし、日本語は分かち書きをせずに
+// 漢字・カタカナの並びを 2 文字ずつ（bigram）区切ります。ひらがなは助詞などが多いので使わない
+func Tokenize(text string) []string {
+	var tokens []string
+	var word []rune
+	var cjk []rune
+	flushWord := func() {
+		if len(word) >= 2 {
+			tokens = append(tokens, string(word))
+		}
+		word = word[:0]
+	}
+	flushCJK := func() {
+		if len(cjk) == 1 {
+			tokens = append(tokens, string(cjk))
+		}
+		for i := 0; i+1 < len(cjk); i++ {
+			tokens = append(tokens, string(cjk[i:i+2]))
+		}
+		cjk = cjk[:0]
+	}
+	for _, r := range text {
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Katakana) || r == 'ー':
+			flushWord()
+			cjk = append(cjk, r)
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			flushCJK()
+			word = append(word, unicode.ToLower(r))
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return tokens
+}
+
+// Index は投稿の特徴を類似度の計算用に前処理したものです
+type Index struct {
+	items   []Item
+	byID    map[uint]int
+	genres  []map[string]bool
+	skills  []map[string]bool
+	vectors []map[string]float64 // 正規化した TF-IDF ベクトル
+	idf     map[string]float64
+}
+
+// NewIndex は items から索引を作ります。ジャンル・スキルは大文字小文字を区別しない
+func NewIndex(items []Item) *Index {
+	ix := &Index{
+		items:   items,
+		byID:    make(map[uint]int, len(items)),
+		genres:  make([]map[string]bool, len(items)),
+		skills:  make([]map[string]bool, len(items)),
+		vectors: make([]map[string]float64, len(items)),
+		idf:     make(map[string]float64),
+	}
+	termCounts := make([]map[string]float64, len(items))
+	df := make(map[string]int)
+	for i, item := range items {
+		ix.byID[item.PostID] = i
+		ix.genres[i] = toSet(item.Genres)
+		ix.skills[i] = toSet(item.Skills)
+		tf := make(map[string]float64)
+		for _, t := range Tokenize(item.Text) {
+			tf[t]++
+		}
+		for t := range tf {
+			df[t]++
+		}
+		termCounts[i] = tf
+	}
+	n := float64(len(items))
+	for t, d := range df {
+		ix.idf[t] = math.Log(1 + n/float64(d)) // 平滑化した IDF。全投稿に出る語も 0 にはしない
+	}
+	for i, tf := range termCounts {
+		ix.vectors[i] = ix.weigh(tf)
+	}
+	return ix
+}
+
+// weigh は語の出現回数を正規化した TF-IDF ベクトルにします。索引にない語は使わない
+func (ix *Index) weigh(tf map[string]float64) map[string]float64 {
+	vec := make(map[string]float64, len(tf))
+	var norm float64
+	for t, c := range tf {
+		idf, ok := ix.idf[t]
+		if !ok {
+			continue
+		}
+		w := (1 + math.Log(c)) * idf
+		vec[t] = w
+		norm += w * w
+	}
+	if norm == 0 {
+		return vec
+	}
+	norm = math.Sqrt(norm)
+	for t := range vec {
+		vec[t] /= norm
+	}
+	return vec
+}
+
+// Similar は投稿ごとに似ている投稿を類似度の高い順に MaxSimilar 件まで返します
+// interactions からは、同じユーザーがいいね・ブックマークした投稿同士を似ているとみなす（共起）
+func (ix *Index) Similar(interactions []Interaction, cfg Config) []Similarity {
+	engagers := make([]map[uint]bool, len(ix.items))
+	for _, in := range interactions {
+		i, ok := ix.byID[in.PostID]
+		if !ok || isAuthor(ix.items[i].AuthorIDs, in.UserID) {
+			continue
+		}
+		if engagers[i] == nil {
+			engagers[i] = make(map[uint]bool)
+		}
+		engagers[i][in.UserID] = true
+	}
+
+	var sims []Similarity
+	for i := range ix.items {
+		var row []Similarity
+		for j := range ix.items {
+			if i == j {
+				continue
+			}
+			score := cfg.GenreWeight*jaccard(ix.genres[i], ix.genres[j]) +
+				cfg.SkillWeight*jaccard(ix.skills[i], ix.skills[j]) +
+				cfg.TextWeight*cosine(ix.vectors[i], ix.vectors[j]) +
+				cfg.CoEngagementWeight*jaccardIDs(engagers[i], engagers[j])
+			if score < cfg.MinScore {
+				continue
+			}
+			row = append(row, Similarity{PostID: ix.items[i].PostID, SimilarPostID: ix.items[j].PostID, Score: score})
+		}
+		sort.Slice(row, func(a, b int) bool {
+			if row[a].Score != row[b].Score {
+				return row[a].Score > row[b].Score
+			}
+			return row[a].SimilarPostID > row[b].SimilarPostID
+		})
+		if len(row) > cfg.MaxSimilar {
+			row = row[:cfg.MaxSimilar]
+		}
+		sims = append(sims, row...)
+	}
+	return sims
+}
+
+// Recommend は profile のユーザーへのおすすめをスコアの高い順に MaxRecommendations 件まで返します
+// 本人の投稿と、いいね・ブックマーク済みの投稿は含めない。similar は Similar の結果
+func (ix *Index) Recommend(profile Profile, similar []Similarity, cfg Config) []Recommendation {
+	seen := make(map[uint]bool, len(profile.Interactions))
+	for _, id := range profile.Interactions {
+		seen[id] = true
+	}
+	// いいね・ブックマークした投稿に似ている投稿ほど高くする。件数で割って 0〜1 に収める
+	history := make(map[uint]float64)
+	if len(seen) > 0 {
+		for _, s := range similar {
+			if seen[s.PostID] {
+				history[s.SimilarPostID] += s.Score / float64(len(seen))
+			}
+		}
+	}
+	skills := toSet(profile.Skills)
+	jobTerms := make(map[string]float64)
+	for _, jt := range profile.JobTypes {
+		for _, t := range Tokenize(jt) {
+			jobTerms[t]++
+		}
+	}
+	jobVector := ix.weigh(jobTerms)
+	jobTypes := toSet(profile.JobTypes)
+
+	var recs []Recommendation
+	for i, item := range ix.items {
+		if seen[item.PostID] || isAuthor(item.AuthorIDs, profile.UserID) {
+			continue
+		}
+		// 希望職種は本文の近さと、職種名がそのままジャンルに入っているかの大きい方を使う
+		jobScore := math.Max(cosine(jobVector, ix.vectors[i]), overlap(jobTypes, ix.genres[i]))
+		score := cfg.ProfileSkillWeight*overlap(ix.skills[i], skills) +
+			cfg.ProfileJobTypeWeight*jobScore +
+			cfg.HistoryWeight*history[item.PostID]
+		if score < cfg.MinScore {
+			continue
+		}
+		recs = append(recs, Recommendation{UserID: profile.UserID, PostID: item.PostID, Score: score})
+	}
+	sort.Slice(recs, func(a, b int) bool {
+		if recs[a].Score != recs[b].Score {
+			return recs[a].Score > recs[b].Score
+		}
+		return recs[a].PostID > recs[b].PostID
+	})
+	if len(recs) > cfg.MaxRecommendations {
+		recs = recs[:cfg.MaxRecommendations]
+	}
+	return recs
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		v = strings.ToLower(strings.TrimSpace(v))
+		if v != "" {
+			set[v] = true
+		}
+	}
+	return set
+}
+
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	inter := 0
+	for v := range a {
+		if b[v] {
+			inter++
+		}
+	}
+	return float64(inter) / float64(len(a)+len(b)-inter)
+}
+
+func jaccardIDs(a, b map[uint]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	inter := 0
+	for v := range a {
+		if b[v] {
+			inter++
+		}
+	}
+	return float64(inter) / float64(len(a)+len(b)-inter)
+}
+
+// overlap は a のうち b にも含まれる割合です（投稿のスキルのうち本人が持っているスキルの割合など）
+func overlap(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	inter := 0
+	for v := range a {
+		if b[v] {
+			inter++
+		}
+	}
+	return float64(inter) / float64(len(a))
+}
+
+// cosine は正規化済みのベクトル同士のコサイン類似度です
+func cosine(a, b map[string]float64) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	var dot float64
+	for t, w := range a {
+		dot += w * b[t]
+	}
+	return dot
+}
+
+func isAuthor(authors []uint, userID uint) bool {
+	for _, id := range authors {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+// backend/domain/recommendation/entity_test.go
+package recommendation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	got := Tokenize("Go と React の Webアプリ、機械学習!")
+	want := []string{"go", "react", "web", "アプ", "プリ", "機械", "械学", "学習"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Tokenize() = %v, want %v", got, want)
+	}
+}
+
+func TestIndex_Similar(t *testing.T) {
+	items := []Item{
+		{PostID: 1, AuthorIDs: []uint{100}, Genres: []string{"Web"}, Skills: []string{"Go", "React"}, Text: "Go と React のタスク管理アプリ"},
+		{PostID: 2, AuthorIDs: []uint{200}, Genres: []string{"web"}, Skills: []string{"go", "Vue"}, Text: "Go で作ったタスク管理"},
+		{PostID: 3, AuthorIDs: []uint{300}, Genres: []string{"ゲーム"}, Skills: []string{"Unity"}, Text: "3D アクションゲーム"},
+		{PostID: 4, AuthorIDs: []uint{400}, Genres: []string{"ゲーム"}, Skills: []string{"C#"}, Text: "パズル"},
+	}
+	ix := NewIndex(items)
+	// 1 と 3 は内容は似ていないが、同じ 2 人がいいねしている。投稿者自身の反応は数えない
+	interactions := []Interaction{
+		{UserID: 10, PostID: 1}, {UserID: 10, PostID: 3},
+		{UserID: 11, PostID: 1}, {UserID: 11, PostID: 3},
+		{UserID: 400, PostID: 4}, {UserID: 400, PostID: 1},
+	}
+	similar := make(map[uint][]uint)
+	for _, s := range ix.Similar(interactions, DefaultConfig) {
+		similar[s.PostID] = append(similar[s.PostID], s.SimilarPostID)
+	}
+	if want := []uint{2, 3}; !reflect.DeepEqual(similar[1], want) {
+		t.Errorf("similar to 1 = %v, want %v", similar[1], want)
+	}
+	if want := []uint{4, 1}; !reflect.DeepEqual(similar[3], want) {
+		t.Errorf("similar to 3 = %v, want %v", similar[3], want)
+	}
+	if len(similar[4]) != 1 || similar[4][0] != 3 {
+		t.Errorf("similar to 4 = %v, want only 3 (author's own like ignored)", similar[4])
+	}
+}
+
+func TestIndex_Recommend(t *testing.T) {
+	items := []Item{
+		{PostID: 1, AuthorIDs: []uint{100}, Genres: []string{"Web"}, Skills: []string{"Go"}, Text: "API サーバー"},
+		{PostID: 2, AuthorIDs: []uint{200}, Genres: []string{"Web"}, Skills: []string{"Go", "React"}, Text: "API とフロントエンド"},
+		{PostID: 3, AuthorIDs: []uint{300}, Genres: []string{"ゲーム"}, Skills: []string{"Unity"}, Text: "ゲーム"},
+		{PostID: 4, AuthorIDs: []uint{10}, Genres: []string{"Web"}, Skills: []string{"Go"}, Text: "API"},
+	}
+	ix := NewIndex(items)
+	similar := ix.Similar(nil, DefaultConfig)
+
+	// スキルの合う投稿が上に来る。本人の投稿 (4) といいね済みの投稿 (1) は含めない
+	profile := Profile{UserID: 10, Skills: []string{"go"}, Interactions: []uint{1}}
+	var got []uint
+	for _, r := range ix.Recommend(profile, similar, DefaultConfig) {
+		got = append(got, r.PostID)
+	}
+	if want := []uint{2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Recommend() = %v, want %v", got, want)
+	}
+
+	// 希望職種がジャンル名と一致する投稿を推薦する
+	got = nil
+	for _, r := range ix.Recommend(Profile{UserID: 20, JobTypes: []string{"ゲーム"}}, similar, DefaultConfig) {
+		got = append(got, r.PostID)
+	}
+	if want := []uint{3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Recommend() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,19 @@
+// backend/domain/recommendation/repository.go
+package recommendation
+
+// Repository は推薦の計算に使うデータの読み出しと、計算した推薦の保存を抽象化したインターフェースです
+type Repository interface {
+	// GetInteractions はすべてのいいね・ブックマークを返します
+	GetInteractions() ([]Interaction, error)
+	// GetProfiles は退会していないユーザーのスキル・希望職種を返します（Interactions は設定しない）
+	GetProfiles() ([]Profile, error)
+
+	// ReplaceSimilarities / ReplaceRecommendations は保存済みの結果をすべて置き換えます
+	ReplaceSimilarities(similarities []Similarity) error
+	ReplaceRecommendations(recommendations []Recommendation) error
+
+	// GetSimilarPostIDs は postID に似ている投稿の ID を類似度の高い順に limit 件まで返します
+	GetSimilarPostIDs(postID uint, limit int) ([]uint, error)
+	// GetRecommendedPostIDs は userID へのおすすめの投稿の ID をスコアの高い順に limit 件まで返します
+	GetRecommendedPostIDs(userID uint, limit int) ([]uint, error)
+}
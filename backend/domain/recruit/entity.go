@@ -0,0 +1,99 @@
+// backend/domain/recruit/entity.go
+package recruit
+
+import (
+	domainUser "backend/domain/user"
+	"strings"
+	"time"
+)
+
+// SearchCriteria は企業による候補者検索の条件です
+// 複数指定した項目はすべてを満たす候補者に絞り込みます（AND 条件）
+type SearchCriteria struct {
+	DesiredJobTypes []string // 希望職種
+	Skills          []string // プロフィールまたは作品で使ったスキル
+	GraduationYear  string
+	SchoolName      string // 部分一致
+	PostGenres      []string
+	PostSkills      []string  // 作品で使ったスキル
+	Keyword         string    // 自己紹介・学部・研究室の部分一致
+	RegisteredAfter time.Time // 保存した検索の新着判定用。ゼロ値なら条件に含めない
+}
+
+// Candidate は候補者検索の結果 1 件を表します
+// User には企業に見せてよいプロフィール項目だけが入ります
+type Candidate struct {
+	User          domainUser.UserModel
+	PostCount     int
+	PostGenres    []string
+	PostSkills    []string
+	Score         float64  // 求人の必須スキルとの一致度 (0.0〜1.0)
+	MatchedSkills []string // 求人の必須スキルのうち一致したもの
+}
+
+// 作品で使われたスキルは、プロフィールに書いただけのスキルより実績として重く扱う
+const (
+	profileSkillWeight = 0.6
+	postSkillWeight    = 1.0
+)
+
+// CalculateScore は求人の必須スキルと候補者のスキルの重なりから関連度を計算します
+// 必須スキルごとに、作品で使っていれば 1.0、プロフィールにのみあれば 0.6 を加点し、
+// 必須スキル数で割って 0.0〜1.0 に正規化します。大文字小文字は区別しません
+func (c *Candidate) CalculateScore(requiredSkills []string) {
+	c.Score = 0
+	c.MatchedSkills = nil
+	if len(requiredSkills) == 0 {
+		return
+	}
+
+	profile := toSet(c.User.Skills)
+	posts := toSet(c.PostSkills)
+
+	var total float64
+	seen := make(map[string]bool, len(requiredSkills))
+	for _, skill := range requiredSkills {
+		key := normalize(skill)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		switch {
+		case posts[key]:
+			total += postSkillWeight
+		case profile[key]:
+			total += profileSkillWeight
+		default:
+			continue
+		}
+		c.MatchedSkills = append(c.MatchedSkills, skill)
+	}
+	if len(seen) > 0 {
+		c.Score = total / float64(len(seen))
+	}
+}
+
+// AllSkills はプロフィールのスキルと作品で使ったスキルを、重複を除いてこの順に並べて返します
+func (c *Candidate) AllSkills() []string {
+	seen := make(map[string]bool, len(c.User.Skills)+len(c.PostSkills))
+	var out []string
+	for _, v := range append(append([]string{}, c.User.Skills...), c.PostSkills...) {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func normalize(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[normalize(v)] = true
+	}
+	return set
+}
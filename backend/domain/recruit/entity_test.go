@@ -0,0 +1,55 @@
+// backend/domain/recruit/entity_test.go
+package recruit
+
+import (
+	"math"
+	"testing"
+
+	domainUser "backend/domain/user"
+)
+
+func TestCandidate_CalculateScore(t *testing.T) {
+	tests := []struct {
+		name        string
+		profile     []string
+		posts       []string
+		required    []string
+		wantScore   float64
+		wantMatched int
+	}{
+		{name: "no requirements", profile: []string{"Go"}, required: nil, wantScore: 0},
+		{name: "all used in posts", posts: []string{"Go", "SQL"}, required: []string{"Go", "SQL"}, wantScore: 1.0, wantMatched: 2},
+		{name: "profile only counts less", profile: []string{"Go"}, required: []string{"Go"}, wantScore: 0.6, wantMatched: 1},
+		{name: "case insensitive", posts: []string{"react"}, required: []string{"React", "Go"}, wantScore: 0.5, wantMatched: 1},
+		{name: "duplicated requirement", posts: []string{"Go"}, required: []string{"Go", "go"}, wantScore: 1.0, wantMatched: 1},
+		{name: "no overlap", profile: []string{"Rust"}, required: []string{"Go"}, wantScore: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Candidate{User: domainUser.UserModel{Skills: tt.profile}, PostSkills: tt.posts}
+			c.CalculateScore(tt.required)
+			if math.Abs(c.Score-tt.wantScore) > 1e-9 {
+				t.Errorf("Score = %v; want %v", c.Score, tt.wantScore)
+			}
+			if len(c.MatchedSkills) != tt.wantMatched {
+				t.Errorf("MatchedSkills = %v; want %d items", c.MatchedSkills, tt.wantMatched)
+			}
+		})
+	}
+}
+
+func TestCandidate_AllSkills(t *testing.T) {
+	c := &Candidate{User: domainUser.UserModel{Skills: []string{"Go", "SQL"}}, PostSkills: []string{"SQL", "React"}}
+	got := c.AllSkills()
+	want := []string{"Go", "SQL", "React"}
+	if len(got) != len(want) {
+		t.Fatalf("AllSkills() = %v; want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("AllSkills() = %v; want %v", got, want)
+			break
+		}
+	}
+}
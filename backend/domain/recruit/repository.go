@@ -0,0 +1,8 @@
+// backend/domain/recruit/repository.go
+package recruit
+
+// Repository は候補者検索の読み取り専用リポジトリです
+// 実装は公開設定（IsDiscoverableByRecruiters）を満たす学生だけを、件数を切り詰めずに返すこと
+type Repository interface {
+	SearchCandidates(criteria SearchCriteria) ([]*Candidate, error)
+}
@@ -0,0 +1,291 @@
+// backend/domain/resume/entity.go
+package resume
+
+import (
+	domainCareer "backend/domain/career"
+	domainExternalLink "backend/domain/externallink"
+	domainPortfolio "backend/domain/portfolio"
+	domainUser "backend/domain/user"
+	domainUserSkill "backend/domain/userskill"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Template は履歴書 PDF の体裁です
+type Template string
+
+const (
+	TemplateStandard Template = "standard" // 見出しと罫線で区切った履歴書に近い体裁（既定）
+	TemplateModern   Template = "modern"   // 色付きの見出しで、作品のサムネイルを大きく載せる体裁
+)
+
+// Templates は選べる体裁の一覧です
+var Templates = []Template{TemplateStandard, TemplateModern}
+
+// ParseTemplate は文字列を体裁に変換します。空文字は既定の体裁として扱います
+func ParseTemplate(s string) (Template, error) {
+	if s == "" {
+		return TemplateStandard, nil
+	}
+	for _, t := range Templates {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("履歴書のテンプレートが不正です: %s", s)
+}
+
+// MaxPosts は履歴書に載せられる作品の上限です
+const MaxPosts = 6
+
+// Resume は履歴書に載せるプロフィールと作品です
+// 自己紹介・作品の説明文は Markdown の原文のまま持ち、体裁に合わせて Renderer が変換します
+type Resume struct {
+	User        *domainUser.UserModel
+	Skills      []*domainUserSkill.UserSkill
+	Educations  []*domainCareer.Education
+	Experiences []*domainCareer.Experience
+	Links       []*domainExternalLink.Link
+	Posts       []*domainPortfolio.Post
+	GeneratedAt time.Time
+}
+
+// Renderer は履歴書を PDF に変換するインターフェースです
+type Renderer interface {
+	Render(w io.Writer, r *Resume, t Template) error
+}
+
+// SelectPosts は履歴書に載せる作品を選びます
+// ids が空なら posts の先頭から MaxPosts 件、指定があれば ids の順に返す。posts にない ID はエラー
+func SelectPosts(posts []*domainPortfolio.Post, ids []uint) ([]*domainPortfolio.Post, error) {
+	if len(ids) == 0 {
+		if len(posts) > MaxPosts {
+			return posts[:MaxPosts], nil
+		}
+		return posts, nil
+	}
+	if len(ids) > MaxPosts {
+		return nil, fmt.Errorf("履歴書に載せられる作品は%d件までです", MaxPosts)
+	}
+	byID := make(map[uint]*domainPortfolio.Post, len(posts))
+	for _, p := range posts {
+		byID[p.ID] = p
+	}
+	selected := make([]*domainPortfolio.Post, 0, len(ids))
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		p, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("履歴書に載せられない作品です: %d", id)
+		}
+		if !seen[id] {
+			seen[id] = true
+			selected = append(selected, p)
+		}
+	}
+	return selected, nil
+}
+
+// FullName は「姓 名」の順の氏名です
+func (r *Resume) FullName() string {
+	return strings.TrimSpace(r.User.LastName + " " + r.User.FirstName)
+}
+
+// FullNameKana は「姓 名」の順のふりがなです
+func (r *Resume) FullNameKana() string {
+	return strings.TrimSpace(r.User.LastNameKana + " " + r.User.FirstNameKana)
+}
+
+// FormatMonth は年月を「2024年4月」の形式にします。nil は空文字
+func FormatMonth(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d年%d月", t.Year(), int(t.Month()))
+}
+
+// LevelLabel は習熟度の表示名です
+func LevelLabel(l domainUserSkill.Level) string {
+	switch l {
+	case domainUserSkill.LevelBeginner:
+		return "初級"
+	case domainUserSkill.LevelIntermediate:
+		return "中級"
+	case domainUserSkill.LevelAdvanced:
+		return "上級"
+	case domainUserSkill.LevelExpert:
+		return "エキスパート"
+	}
+	return ""
+}
+
+// DegreeLabel は学歴の種類の表示名です
+func DegreeLabel(d domainCareer.Degree) string {
+	switch d {
+	case domainCareer.DegreeHighSchool:
+		return "高校"
+	case domainCareer.DegreeVocational:
+		return "専門学校"
+	case domainCareer.DegreeTechnicalCollege:
+		return "高等専門学校"
+	case domainCareer.DegreeAssociate:
+		return "短期大学"
+	case domainCareer.DegreeBachelor:
+		return "学士"
+	case domainCareer.DegreeMaster:
+		return "修士"
+	case domainCareer.DegreeDoctor:
+		return "博士"
+	}
+	return ""
+}
+
+// ExperienceTypeLabel は職歴の種類の表示名です
+func ExperienceTypeLabel(t domainCareer.ExperienceType) string {
+	switch t {
+	case domainCareer.ExperienceInternship:
+		return "インターンシップ"
+	case domainCareer.ExperiencePartTime:
+		return "アルバイト"
+	case domainCareer.ExperienceFullTime:
+		return "正社員・契約社員"
+	case domainCareer.ExperienceFreelance:
+		return "業務委託"
+	}
+	return ""
+}
+
+// JSONResume は JSON Resume (https://jsonresume.org/schema) 形式の履歴書です
+type JSONResume struct {
+	Schema    string            `json:"$schema"`
+	Basics    JSONBasics        `json:"basics"`
+	Work      []JSONWork        `json:"work"`
+	Education []JSONEducation   `json:"education"`
+	Skills    []JSONSkill       `json:"skills"`
+	Projects  []JSONProject     `json:"projects"`
+	Meta      map[string]string `json:"meta"`
+}
+
+type JSONBasics struct {
+	Name     string        `json:"name"`
+	Label    string        `json:"label,omitempty"`
+	Image    string        `json:"image,omitempty"`
+	Email    string        `json:"email,omitempty"`
+	Summary  string        `json:"summary,omitempty"`
+	Profiles []JSONProfile `json:"profiles"`
+}
+
+type JSONProfile struct {
+	Network string `json:"network"`
+	URL     string `json:"url"`
+}
+
+type JSONWork struct {
+	Name      string   `json:"name"`
+	Position  string   `json:"position,omitempty"`
+	StartDate string   `json:"startDate,omitempty"`
+	EndDate   string   `json:"endDate,omitempty"`
+	Summary   string   `json:"summary,omitempty"`
+	Keywords  []string `json:"keywords,omitempty"`
+}
+
+type JSONEducation struct {
+	Institution string `json:"institution"`
+	Area        string `json:"area,omitempty"`
+	StudyType   string `json:"studyType,omitempty"`
+	StartDate   string `json:"startDate,omitempty"`
+	EndDate     string `json:"endDate,omitempty"`
+}
+
+type JSONSkill struct {
+	Name     string   `json:"name"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+type JSONProject struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+}
+
+// jsonResumeSchema は出力する JSON Resume のスキーマです
+const jsonResumeSchema = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// ToJSONResume は JSON Resume 形式に変換します。作品の URL は siteURL の投稿詳細ページを指す
+func (r *Resume) ToJSONResume(siteURL string) JSONResume {
+	u := r.User
+	out := JSONResume{
+		Schema: jsonResumeSchema,
+		Basics: JSONBasics{
+			Name:     r.FullName(),
+			Label:    strings.Join(u.DesiredJobTypes, " / "),
+			Image:    u.ProfileImageURL,
+			Email:    u.Email,
+			Summary:  u.SelfIntroduction,
+			Profiles: make([]JSONProfile, 0, len(r.Links)),
+		},
+		Work:      make([]JSONWork, 0, len(r.Experiences)),
+		Education: make([]JSONEducation, 0, len(r.Educations)),
+		Skills:    make([]JSONSkill, 0, len(r.Skills)),
+		Projects:  make([]JSONProject, 0, len(r.Posts)),
+		Meta: map[string]string{
+			"version":      "v1.0.0",
+			"lastModified": r.GeneratedAt.UTC().Format(time.RFC3339),
+		},
+	}
+	for _, l := range r.Links {
+		out.Basics.Profiles = append(out.Basics.Profiles, JSONProfile{Network: string(l.Type), URL: l.URL})
+	}
+	for _, e := range r.Experiences {
+		out.Work = append(out.Work, JSONWork{
+			Name:      e.CompanyName,
+			Position:  e.Role,
+			StartDate: isoMonth(&e.StartDate),
+			EndDate:   isoMonth(e.EndDate),
+			Summary:   e.Description,
+			Keywords:  e.Skills,
+		})
+	}
+	for _, e := range r.Educations {
+		out.Education = append(out.Education, JSONEducation{
+			Institution: e.SchoolName,
+			Area:        strings.TrimSpace(e.Department + " " + e.Laboratory),
+			StudyType:   DegreeLabel(e.Degree),
+			StartDate:   isoMonth(e.StartDate),
+			EndDate:     isoMonth(e.EndDate),
+		})
+	}
+	for _, s := range r.Skills {
+		out.Skills = append(out.Skills, JSONSkill{Name: s.Name, Level: LevelLabel(s.Level)})
+	}
+	for _, p := range r.Posts {
+		project := JSONProject{
+			Name:        p.Title,
+			Description: p.Description,
+			URL:         fmt.Sprintf("%s/Portfolio/%d", siteURL, p.ID),
+			Keywords:    p.Skills,
+			StartDate:   isoMonth(p.StartDate),
+			EndDate:     isoMonth(p.EndDate),
+		}
+		if p.Role != "" {
+			project.Roles = []string{p.Role}
+		}
+		out.Projects = append(out.Projects, project)
+	}
+	return out
+}
+
+// isoMonth は JSON Resume の日付（YYYY-MM）にします。nil は空文字
+func isoMonth(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01")
+}
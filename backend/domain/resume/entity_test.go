@@ -0,0 +1,74 @@
+// backend/domain/resume/entity_test.go
+package resume
+
+import (
+	"testing"
+	"time"
+
+	domainCareer "backend/domain/career"
+	domainPortfolio "backend/domain/portfolio"
+	domainUser "backend/domain/user"
+	domainUserSkill "backend/domain/userskill"
+)
+
+func TestParseTemplate(t *testing.T) {
+	if got, err := ParseTemplate(""); err != nil || got != TemplateStandard {
+		t.Errorf("ParseTemplate(\"\") = %q, %v; want standard", got, err)
+	}
+	if got, err := ParseTemplate("modern"); err != nil || got != TemplateModern {
+		t.Errorf("ParseTemplate(modern) = %q, %v", got, err)
+	}
+	if _, err := ParseTemplate("fancy"); err == nil {
+		t.Error("unknown template should be rejected")
+	}
+}
+
+func TestSelectPosts(t *testing.T) {
+	var posts []*domainPortfolio.Post
+	for i := 1; i <= 8; i++ {
+		posts = append(posts, &domainPortfolio.Post{ID: uint(i)})
+	}
+	if got, _ := SelectPosts(posts, nil); len(got) != MaxPosts || got[0].ID != 1 {
+		t.Errorf("default selection = %d posts, want the first %d", len(got), MaxPosts)
+	}
+	got, err := SelectPosts(posts, []uint{5, 2, 5})
+	if err != nil || len(got) != 2 || got[0].ID != 5 || got[1].ID != 2 {
+		t.Errorf("SelectPosts(5, 2, 5) = %v, %v; want posts 5 and 2 in order", got, err)
+	}
+	if _, err := SelectPosts(posts, []uint{9}); err == nil {
+		t.Error("a post that is not the user's should be rejected")
+	}
+}
+
+func TestResume_ToJSONResume(t *testing.T) {
+	start := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+	r := &Resume{
+		User: &domainUser.UserModel{
+			FirstName: "太郎", LastName: "山田", Email: "taro@example.com",
+			SelfIntroduction: "**Go** が好きです", DesiredJobTypes: []string{"バックエンドエンジニア"},
+		},
+		Skills:     []*domainUserSkill.UserSkill{{Name: "Go", Level: domainUserSkill.LevelAdvanced}},
+		Educations: []*domainCareer.Education{{SchoolName: "○○大学", Department: "情報工学科", Degree: domainCareer.DegreeBachelor, StartDate: &start}},
+		Posts: []*domainPortfolio.Post{{
+			ID: 7, Title: "タスク管理", Skills: []string{"Go"},
+			ProjectDetails: domainPortfolio.ProjectDetails{Role: "バックエンド", StartDate: &start},
+		}},
+		GeneratedAt: start,
+	}
+	out := r.ToJSONResume("https://example.com")
+	if out.Basics.Name != "山田 太郎" || out.Basics.Label != "バックエンドエンジニア" || out.Basics.Summary != "**Go** が好きです" {
+		t.Errorf("basics = %+v", out.Basics)
+	}
+	if len(out.Education) != 1 || out.Education[0].StudyType != "学士" || out.Education[0].StartDate != "2022-04" {
+		t.Errorf("education = %+v", out.Education)
+	}
+	if len(out.Skills) != 1 || out.Skills[0].Level != "上級" {
+		t.Errorf("skills = %+v", out.Skills)
+	}
+	if len(out.Projects) != 1 || out.Projects[0].URL != "https://example.com/Portfolio/7" || out.Projects[0].Roles[0] != "バックエンド" {
+		t.Errorf("projects = %+v", out.Projects)
+	}
+	if out.Work == nil || out.Basics.Profiles == nil {
+		t.Error("empty sections should be [] rather than null")
+	}
+}
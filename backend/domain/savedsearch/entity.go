@@ -0,0 +1,144 @@
+// backend/domain/savedsearch/entity.go
+package savedsearch
+
+import (
+	"fmt"
+	"time"
+)
+
+// Target は保存した検索の対象です
+type Target string
+
+const (
+	TargetPosts      Target = "posts"      // 作品フィード
+	TargetCandidates Target = "candidates" // 候補者（採用担当のみ）
+)
+
+// Frequency は新着通知メールの頻度です
+type Frequency string
+
+const (
+	FrequencyDaily  Frequency = "daily"
+	FrequencyWeekly Frequency = "weekly"
+)
+
+// Interval は通知の間隔を返します
+func (f Frequency) Interval() time.Duration {
+	if f == FrequencyWeekly {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+const maxNameLength = 100
+
+// SavedSearch はユーザーが保存した検索条件と、新着通知の設定を表すドメインエンティティです
+type SavedSearch struct {
+	ID     uint
+	UserID uint
+	Name   string
+	Target Target
+
+	Keyword        string
+	Genres         []string
+	Skills         []string
+	GraduationYear string
+
+	Frequency        Frequency
+	Active           bool      // false の場合は通知メールを送らない
+	UnsubscribeToken string    // メール内の配信停止リンク用（ログイン不要）
+	LastRunAt        time.Time // この時刻より後に登録されたものを新着として扱う
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewSavedSearch は SavedSearch を生成するファクトリメソッドです
+// 作成時点より後に登録されたものから通知の対象になります
+func NewSavedSearch(
+	userID uint,
+	name string,
+	target Target,
+	keyword string,
+	genres, skills []string,
+	graduationYear string,
+	frequency Frequency,
+	unsubscribeToken string,
+	now time.Time,
+) (*SavedSearch, error) {
+	if userID == 0 {
+		return nil, fmt.Errorf("ユーザーは必須です")
+	}
+	if target != TargetPosts && target != TargetCandidates {
+		return nil, fmt.Errorf("検索対象が不正です: %s", target)
+	}
+	if unsubscribeToken == "" {
+		return nil, fmt.Errorf("配信停止トークンは必須です")
+	}
+	s := &SavedSearch{
+		UserID:           userID,
+		Target:           target,
+		Active:           true,
+		UnsubscribeToken: unsubscribeToken,
+		LastRunAt:        now,
+		CreatedAt:        now,
+	}
+	if err := s.Update(name, keyword, genres, skills, graduationYear, frequency, now); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Update は検索条件と通知頻度を書き換える振る舞い
+// 検索対象は作成後に変更できません
+func (s *SavedSearch) Update(
+	name, keyword string,
+	genres, skills []string,
+	graduationYear string,
+	frequency Frequency,
+	now time.Time,
+) error {
+	if name == "" {
+		return fmt.Errorf("検索条件の名前は必須です")
+	}
+	if len([]rune(name)) > maxNameLength {
+		return fmt.Errorf("検索条件の名前は%d文字以内で入力してください", maxNameLength)
+	}
+	if frequency != FrequencyDaily && frequency != FrequencyWeekly {
+		return fmt.Errorf("通知頻度が不正です: %s", frequency)
+	}
+	if keyword == "" && len(genres) == 0 && len(skills) == 0 && graduationYear == "" {
+		return fmt.Errorf("検索条件を1つ以上指定してください")
+	}
+	s.Name = name
+	s.Keyword = keyword
+	s.Genres = genres
+	s.Skills = skills
+	s.GraduationYear = graduationYear
+	s.Frequency = frequency
+	s.UpdatedAt = now
+	return nil
+}
+
+// IsDue は通知メールを送る時期かどうかを返します
+func (s *SavedSearch) IsDue(now time.Time) bool {
+	return s.Active && !now.Before(s.LastRunAt.Add(s.Frequency.Interval()))
+}
+
+// MarkRun は now までの新着を通知済みにします
+func (s *SavedSearch) MarkRun(now time.Time) {
+	s.LastRunAt = now
+}
+
+// Unsubscribe は通知メールの配信を停止します（検索条件自体は残ります）
+func (s *SavedSearch) Unsubscribe() {
+	s.Active = false
+}
+
+// Resubscribe は配信を再開します。停止中の新着はまとめて送らず、再開時点から数え直します
+func (s *SavedSearch) Resubscribe(now time.Time) {
+	if !s.Active {
+		s.Active = true
+		s.LastRunAt = now
+	}
+}
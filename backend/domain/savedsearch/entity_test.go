@@ -0,0 +1,53 @@
+// backend/domain/savedsearch/entity_test.go
+package savedsearch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewSavedSearch_Validation(t *testing.T) {
+	now := time.Now()
+	if _, err := NewSavedSearch(1, "Go", TargetPosts, "", nil, nil, "", FrequencyDaily, "token", now); err == nil {
+		t.Error("expected error when no criteria are given")
+	}
+	if _, err := NewSavedSearch(1, "Go", Target("jobs"), "go", nil, nil, "", FrequencyDaily, "token", now); err == nil {
+		t.Error("expected error for unknown target")
+	}
+	if _, err := NewSavedSearch(1, "Go", TargetPosts, "go", nil, nil, "", Frequency("hourly"), "token", now); err == nil {
+		t.Error("expected error for unknown frequency")
+	}
+}
+
+func TestSavedSearch_IsDue(t *testing.T) {
+	start := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	s, err := NewSavedSearch(1, "Go の作品", TargetPosts, "", nil, []string{"Go"}, "", FrequencyWeekly, "token", start)
+	if err != nil {
+		t.Fatalf("NewSavedSearch failed: %v", err)
+	}
+
+	if s.IsDue(start.Add(6 * 24 * time.Hour)) {
+		t.Error("weekly search should not be due after 6 days")
+	}
+	due := start.Add(7 * 24 * time.Hour)
+	if !s.IsDue(due) {
+		t.Error("weekly search should be due after 7 days")
+	}
+
+	s.MarkRun(due)
+	if s.IsDue(due.Add(time.Hour)) {
+		t.Error("search should not be due right after a run")
+	}
+
+	s.Unsubscribe()
+	if s.IsDue(due.Add(30 * 24 * time.Hour)) {
+		t.Error("unsubscribed search should never be due")
+	}
+
+	// 再開時は停止中の新着をまとめて送らない
+	resumed := due.Add(30 * 24 * time.Hour)
+	s.Resubscribe(resumed)
+	if !s.Active || !s.LastRunAt.Equal(resumed) {
+		t.Errorf("Resubscribe should reset LastRunAt, got active=%v lastRunAt=%v", s.Active, s.LastRunAt)
+	}
+}
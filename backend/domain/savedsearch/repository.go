@@ -0,0 +1,14 @@
+// backend/domain/savedsearch/repository.go
+package savedsearch
+
+// Repository は保存した検索条件の永続化インターフェースです
+type Repository interface {
+	CreateSavedSearch(s *SavedSearch) error
+	UpdateSavedSearch(s *SavedSearch) error
+	DeleteSavedSearch(id uint) error
+	GetSavedSearchByID(id uint) (*SavedSearch, error)
+	GetSavedSearchesByUserID(userID uint) ([]*SavedSearch, error)
+	FindByUnsubscribeToken(token string) (*SavedSearch, error)
+	// 通知が有効な検索条件をすべて返す（通知時期の判定は呼び出し側で行う）
+	GetActiveSavedSearches() ([]*SavedSearch, error)
+}
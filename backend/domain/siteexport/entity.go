@@ -0,0 +1,163 @@
+// backend/domain/siteexport/entity.go
+package siteexport
+
+import (
+	domainExternalLink "backend/domain/externallink"
+	domainPortfolio "backend/domain/portfolio"
+	domainUser "backend/domain/user"
+	domainUserSkill "backend/domain/userskill"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Theme は書き出すサイトの見た目です
+type Theme string
+
+const (
+	ThemeSimple Theme = "simple" // 白地にカードで作品を並べる（既定）
+	ThemeDark   Theme = "dark"   // 黒地に作品を 1 列で大きく並べる
+)
+
+// Themes は選べる見た目の一覧です
+var Themes = []Theme{ThemeSimple, ThemeDark}
+
+// ParseTheme は文字列を見た目に変換します。空文字は既定の見た目として扱います
+func ParseTheme(s string) (Theme, error) {
+	if s == "" {
+		return ThemeSimple, nil
+	}
+	for _, t := range Themes {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("サイトのテーマが不正です: %s", s)
+}
+
+// Status は書き出しの進み具合です
+type Status string
+
+const (
+	StatusPending   Status = "pending"   // 受け付けて、ジョブが拾うのを待っている
+	StatusRunning   Status = "running"   // ジョブが ZIP を作っている
+	StatusCompleted Status = "completed" // ダウンロードできる
+	StatusFailed    Status = "failed"    // 作れなかった。Error に理由を残す
+)
+
+const (
+	// RetentionPeriod は作った ZIP をダウンロードできる期間です。過ぎたらファイルごと削除する
+	RetentionPeriod = 7 * 24 * time.Hour
+	// StaleAfter は作成中のまま止まった書き出しを失敗とみなすまでの時間です（作成中にプロセスが落ちた場合など）
+	StaleAfter = 30 * time.Minute
+)
+
+// ErrInvalidTransition は今の状態からは進められない操作をしたときのエラーです
+var ErrInvalidTransition = errors.New("invalid site export status transition")
+
+// Export は本人のポートフォリオを静的サイトの ZIP に書き出す依頼 1 件です
+type Export struct {
+	ID          uint       `json:"id"`
+	UserID      uint       `json:"userId"`
+	Theme       Theme      `json:"theme"`
+	Status      Status     `json:"status"`
+	FilePath    string     `json:"-"`               // 作った ZIP の保存先。完了するまで空
+	Size        int64      `json:"size"`            // ZIP のバイト数
+	Error       string     `json:"error,omitempty"` // 失敗した理由
+	CreatedAt   time.Time  `json:"createdAt"`
+	StartedAt   *time.Time `json:"startedAt"`
+	CompletedAt *time.Time `json:"completedAt"`
+	ExpiresAt   *time.Time `json:"expiresAt"` // ダウンロードできる期限。完了・失敗したときに決まり、過ぎたら依頼ごと削除する
+}
+
+// NewExport は書き出しの依頼を生成するファクトリメソッドです
+func NewExport(userID uint, theme Theme, now time.Time) (*Export, error) {
+	if userID == 0 {
+		return nil, fmt.Errorf("ユーザーは必須です")
+	}
+	if _, err := ParseTheme(string(theme)); err != nil {
+		return nil, err
+	}
+	return &Export{UserID: userID, Theme: theme, Status: StatusPending, CreatedAt: now}, nil
+}
+
+// Start はジョブが書き出しを始めたことを記録します
+func (e *Export) Start(now time.Time) error {
+	if e.Status != StatusPending {
+		return ErrInvalidTransition
+	}
+	e.Status = StatusRunning
+	e.StartedAt = &now
+	return nil
+}
+
+// Complete は ZIP を保存し終えたことを記録し、ダウンロードの期限を決めます
+func (e *Export) Complete(path string, size int64, now time.Time) error {
+	if e.Status != StatusRunning {
+		return ErrInvalidTransition
+	}
+	expiresAt := now.Add(RetentionPeriod)
+	e.Status = StatusCompleted
+	e.FilePath = path
+	e.Size = size
+	e.CompletedAt = &now
+	e.ExpiresAt = &expiresAt
+	return nil
+}
+
+// Fail は書き出せなかったことを理由とともに記録します
+func (e *Export) Fail(reason string, now time.Time) error {
+	if e.Status != StatusPending && e.Status != StatusRunning {
+		return ErrInvalidTransition
+	}
+	expiresAt := now.Add(RetentionPeriod)
+	e.Status = StatusFailed
+	e.Error = reason
+	e.CompletedAt = &now
+	e.ExpiresAt = &expiresAt
+	return nil
+}
+
+// IsActive は待ち・作成中で、同じユーザーの新しい依頼を受け付けない状態かを返します
+// 作成中のまま StaleAfter を過ぎたものは止まったとみなし、数えない
+func (e *Export) IsActive(now time.Time) bool {
+	switch e.Status {
+	case StatusPending:
+		return true
+	case StatusRunning:
+		return !e.IsStale(now)
+	}
+	return false
+}
+
+// IsStale は作成中のまま StaleAfter を過ぎたかを返します
+func (e *Export) IsStale(now time.Time) bool {
+	return e.Status == StatusRunning && e.StartedAt != nil && now.Sub(*e.StartedAt) > StaleAfter
+}
+
+// IsDownloadable は ZIP をダウンロードできるかを返します
+func (e *Export) IsDownloadable(now time.Time) bool {
+	return e.Status == StatusCompleted && e.ExpiresAt != nil && now.Before(*e.ExpiresAt)
+}
+
+// FileName はダウンロードさせるときの ZIP のファイル名です
+func (e *Export) FileName() string {
+	return fmt.Sprintf("portfolio-site-%s.zip", e.CreatedAt.Format("20060102"))
+}
+
+// Site は静的サイトに載せるプロフィールと作品です
+// 自己紹介・作品の説明文は変換済みの HTML（SelfIntroductionHTML, DescriptionHTML）を使います
+type Site struct {
+	User        *domainUser.UserModel
+	Skills      []*domainUserSkill.UserSkill
+	Links       []*domainExternalLink.Link
+	Posts       []*domainPortfolio.Post
+	SiteURL     string // 元の投稿ページへのリンクに使う
+	GeneratedAt time.Time
+}
+
+// Builder は Site を静的サイトの ZIP に書き出すインターフェースです
+type Builder interface {
+	Build(w io.Writer, site *Site, theme Theme) error
+}
@@ -0,0 +1,84 @@
+// backend/domain/siteexport/entity_test.go
+package siteexport
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseTheme(t *testing.T) {
+	if got, err := ParseTheme(""); err != nil || got != ThemeSimple {
+		t.Errorf("ParseTheme(\"\") = %q, %v; want simple", got, err)
+	}
+	if got, err := ParseTheme("dark"); err != nil || got != ThemeDark {
+		t.Errorf("ParseTheme(dark) = %q, %v", got, err)
+	}
+	if _, err := ParseTheme("neon"); err == nil {
+		t.Error("unknown theme should be rejected")
+	}
+}
+
+func TestExport_Lifecycle(t *testing.T) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	e, err := NewExport(1, ThemeSimple, now)
+	if err != nil {
+		t.Fatalf("NewExport: %v", err)
+	}
+	if !e.IsActive(now) || e.IsDownloadable(now) {
+		t.Error("a pending export should be active and not downloadable")
+	}
+	if err := e.Complete("exports/1.zip", 10, now); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("completing a pending export = %v, want ErrInvalidTransition", err)
+	}
+
+	if err := e.Start(now); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if err := e.Start(now); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("starting twice = %v, want ErrInvalidTransition", err)
+	}
+	if e.IsStale(now.Add(StaleAfter)) || !e.IsStale(now.Add(StaleAfter+time.Second)) {
+		t.Error("a running export should become stale only after StaleAfter")
+	}
+	if e.IsActive(now.Add(time.Hour)) {
+		t.Error("a stale export should not block new requests")
+	}
+
+	done := now.Add(time.Minute)
+	if err := e.Complete("exports/1.zip", 10, done); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if e.IsActive(done) || !e.IsDownloadable(done) {
+		t.Error("a completed export should be downloadable")
+	}
+	if e.IsDownloadable(done.Add(RetentionPeriod)) {
+		t.Error("an export should not be downloadable after RetentionPeriod")
+	}
+	if err := e.Fail("boom", done); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("failing a completed export = %v, want ErrInvalidTransition", err)
+	}
+}
+
+func TestExport_Fail(t *testing.T) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	e, _ := NewExport(1, ThemeDark, now)
+	if err := e.Fail("ユーザーが見つかりません", now); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+	if e.IsActive(now) || e.IsDownloadable(now) {
+		t.Error("a failed export should be neither active nor downloadable")
+	}
+	if e.ExpiresAt == nil || !e.ExpiresAt.Equal(now.Add(RetentionPeriod)) {
+		t.Errorf("ExpiresAt = %v, want the failed export to be purged after RetentionPeriod", e.ExpiresAt)
+	}
+}
+
+func TestNewExport_Validation(t *testing.T) {
+	if _, err := NewExport(0, ThemeSimple, time.Now()); err == nil {
+		t.Error("user is required")
+	}
+	if _, err := NewExport(1, Theme("neon"), time.Now()); err == nil {
+		t.Error("unknown theme should be rejected")
+	}
+}
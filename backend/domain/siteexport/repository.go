@@ -0,0 +1,20 @@
+// backend/domain/siteexport/repository.go
+package siteexport
+
+import "time"
+
+// Repository は静的サイトの書き出し依頼の永続化インターフェースです
+type Repository interface {
+	Create(e *Export) error
+	GetByID(id uint) (*Export, error)
+	// GetByUserID はユーザーの書き出し依頼を新しい順に返します
+	GetByUserID(userID uint) ([]*Export, error)
+	// GetByStatus は status の書き出し依頼を古い順に limit 件まで返します
+	GetByStatus(status Status, limit int) ([]*Export, error)
+	// UpdateStatus は依頼が from の状態のときだけ e の状態・ファイル・日時を保存します
+	// 他のレプリカのジョブが先に更新していた場合は保存せず false を返す
+	UpdateStatus(e *Export, from Status) (bool, error)
+	// GetExpired はダウンロードの期限が now より前の依頼を返します
+	GetExpired(now time.Time) ([]*Export, error)
+	Delete(id uint) error
+}
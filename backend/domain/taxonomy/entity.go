@@ -0,0 +1,394 @@
+// backend/domain/taxonomy/entity.go
+package taxonomy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Kind は選択肢の種類です（URL のパスにもそのまま使います）
+type Kind string
+
+const (
+	KindJobType Kind = "job-types" // 希望職種
+	KindSkill   Kind = "skills"    // スキル
+	KindGenre   Kind = "genres"    // 作品ジャンル
+)
+
+// Kinds はすべての種類です
+var Kinds = []Kind{KindJobType, KindSkill, KindGenre}
+
+// ParseKind は文字列を Kind に変換します
+func ParseKind(s string) (Kind, error) {
+	for _, k := range Kinds {
+		if string(k) == s {
+			return k, nil
+		}
+	}
+	return "", fmt.Errorf("選択肢の種類が不正です: %s", s)
+}
+
+// SeedEntry は初期データの選択肢 1 件です
+type SeedEntry struct {
+	Name     string
+	Category string // スキルのみ
+	Aliases  []string
+}
+
+// SkillCategory はスキルの分類です（スキル以外の選択肢では空）
+type SkillCategory string
+
+const (
+	CategoryLanguage       SkillCategory = "language"       // プログラミング言語
+	CategoryFramework      SkillCategory = "framework"      // フレームワーク・ライブラリ
+	CategoryDatabase       SkillCategory = "database"       // データベース
+	CategoryInfrastructure SkillCategory = "infrastructure" // クラウド・インフラ
+	CategoryTool           SkillCategory = "tool"           // 開発ツール
+	CategoryDesign         SkillCategory = "design"         // デザイン
+	CategoryOther          SkillCategory = "other"
+)
+
+// SkillCategories は表示順に並べたスキルの分類です
+var SkillCategories = []SkillCategory{
+	CategoryLanguage, CategoryFramework, CategoryDatabase, CategoryInfrastructure, CategoryTool, CategoryDesign, CategoryOther,
+}
+
+// ParseSkillCategory は文字列を SkillCategory に変換します。空文字は "other" として扱います
+func ParseSkillCategory(s string) (SkillCategory, error) {
+	if s == "" {
+		return CategoryOther, nil
+	}
+	for _, c := range SkillCategories {
+		if string(c) == s {
+			return c, nil
+		}
+	}
+	return "", fmt.Errorf("スキルの分類が不正です: %s", s)
+}
+
+const (
+	maxNameLength = 100
+	maxAliases    = 20
+)
+
+// Option は職種・スキル・ジャンルの選択肢 1 件を表すドメインエンティティです
+// 廃止した選択肢は入力候補に出さなくなるだけで、登録済みの値はそのまま残ります
+type Option struct {
+	ID           uint
+	Kind         Kind
+	Name         string
+	DisplayOrder int // 小さいほど先に表示
+	RetiredAt    *time.Time
+
+	Category SkillCategory // スキルのみ
+	Aliases  []string      // 表記ゆれ（例: React に対する "React.js"）。入力時に Name に読み替える
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewOption は選択肢を生成するファクトリメソッドです
+func NewOption(kind Kind, name string, displayOrder int) (*Option, error) {
+	if _, err := ParseKind(string(kind)); err != nil {
+		return nil, err
+	}
+	o := &Option{Kind: kind, DisplayOrder: displayOrder}
+	if kind == KindSkill {
+		o.Category = CategoryOther
+	}
+	if err := o.Rename(name); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// SetCategory はスキルの分類を変更します
+func (o *Option) SetCategory(category SkillCategory) error {
+	if o.Kind != KindSkill {
+		return fmt.Errorf("分類はスキルにのみ設定できます")
+	}
+	if _, err := ParseSkillCategory(string(category)); err != nil {
+		return err
+	}
+	o.Category = category
+	return nil
+}
+
+// SetAliases は表記ゆれの一覧を置き換えます
+// 名前そのものや、正規化すると同じになる重複は取り除きます
+func (o *Option) SetAliases(aliases []string) error {
+	seen := map[string]bool{Key(o.Name): true}
+	cleaned := make([]string, 0, len(aliases))
+	for _, a := range aliases {
+		a = strings.TrimSpace(a)
+		k := Key(a)
+		if k == "" || seen[k] {
+			continue
+		}
+		if utf8.RuneCountInString(a) > maxNameLength {
+			return fmt.Errorf("別名は%d文字以内で入力してください", maxNameLength)
+		}
+		seen[k] = true
+		cleaned = append(cleaned, a)
+	}
+	if len(cleaned) > maxAliases {
+		return fmt.Errorf("別名は%d件までです", maxAliases)
+	}
+	o.Aliases = cleaned
+	return nil
+}
+
+// AddAlias は表記ゆれを 1 件追加します（統合した選択肢の名前を残すときに使う）
+func (o *Option) AddAlias(alias string) error {
+	return o.SetAliases(append(append([]string{}, o.Aliases...), alias))
+}
+
+// Keys は名前と別名の正規化キーを返します
+func (o *Option) Keys() []string {
+	keys := []string{Key(o.Name)}
+	for _, a := range o.Aliases {
+		keys = append(keys, Key(a))
+	}
+	return keys
+}
+
+// Rename は表示名を変更します
+func (o *Option) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("名前は必須です")
+	}
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return fmt.Errorf("名前は%d文字以内で入力してください", maxNameLength)
+	}
+	o.Name = name
+	return nil
+}
+
+// Retire は選択肢を廃止し、入力候補に表示しないようにします
+func (o *Option) Retire(now time.Time) {
+	if o.RetiredAt == nil {
+		o.RetiredAt = &now
+	}
+}
+
+// Restore は廃止した選択肢を元に戻します
+func (o *Option) Restore() {
+	o.RetiredAt = nil
+}
+
+func (o *Option) IsRetired() bool {
+	return o.RetiredAt != nil
+}
+
+// Key は表記ゆれを吸収するための正規化キーを返します
+// 全角英数字を半角にし、小文字化したうえで空白・"-"・"_"・"." を取り除きます
+// （"React", "react", "Ｒｅａｃｔ" は同じキー、"React.js" は "reactjs" になるので別名で対応する）
+func Key(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(s)) {
+		if r >= '！' && r <= '～' {
+			r = r - '！' + '!'
+			if r >= 'A' && r <= 'Z' {
+				r += 'a' - 'A'
+			}
+		}
+		switch r {
+		case ' ', '\u3000', '\t', '-', '_', '.':
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// ReplaceValue はユーザーや投稿に登録された値の from を to に置き換えます
+// 置き換えた結果 to が重複する場合は最初の 1 つだけ残します。置き換えが無ければ changed は false です
+func ReplaceValue(values []string, from, to string) (replaced []string, changed bool) {
+	replaced = make([]string, 0, len(values))
+	seenTo := false
+	for _, v := range values {
+		if v == from {
+			v = to
+			changed = true
+		}
+		if v == to {
+			if seenTo {
+				continue
+			}
+			seenTo = true
+		}
+		replaced = append(replaced, v)
+	}
+	return replaced, changed
+}
+
+// Resolver は入力された値を、名前または別名が一致する選択肢の正式名に読み替えます
+type Resolver struct {
+	byKey map[string]*Option
+}
+
+// NewResolver は options（廃止済みを含む）から Resolver を作ります
+func NewResolver(options []*Option) *Resolver {
+	r := &Resolver{byKey: make(map[string]*Option)}
+	for _, o := range options {
+		for _, k := range o.Keys() {
+			if _, exists := r.byKey[k]; !exists {
+				r.byKey[k] = o
+			}
+		}
+	}
+	return r
+}
+
+// Lookup は値に一致する選択肢を返します
+func (r *Resolver) Lookup(value string) (*Option, bool) {
+	o, ok := r.byKey[Key(value)]
+	return o, ok
+}
+
+// Normalize は values を正式名に読み替え、同じ選択肢の重複を取り除きます
+// 未登録の値は前後の空白を除いてそのまま残します
+func (r *Resolver) Normalize(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	normalized := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if o, ok := r.Lookup(v); ok {
+			v = o.Name
+		}
+		k := Key(v)
+		if k == "" || seen[k] {
+			continue
+		}
+		seen[k] = true
+		normalized = append(normalized, v)
+	}
+	return normalized
+}
+
+// Suggestion は入力補完の候補 1 件です
+type Suggestion struct {
+	Option       *Option
+	MatchedAlias string // 別名に一致した場合はその別名
+	rank         int
+}
+
+// 候補の並び順（小さいほど上位）
+const (
+	rankExact = iota
+	rankNamePrefix
+	rankAliasPrefix
+	rankContains
+	rankFuzzy
+)
+
+// Suggest は query に前方一致・部分一致・タイプミスを許した一致をする選択肢を、近い順に最大 limit 件返します
+func Suggest(options []*Option, query string, limit int) []Suggestion {
+	q := Key(query)
+	if q == "" || limit <= 0 {
+		return nil
+	}
+
+	var suggestions []Suggestion
+	for _, o := range options {
+		best := Suggestion{Option: o, rank: -1}
+		terms := append([]string{o.Name}, o.Aliases...)
+		for i, term := range terms {
+			rank := matchRank(Key(term), q, i > 0)
+			if rank >= 0 && (best.rank < 0 || rank < best.rank) {
+				best.rank = rank
+				best.MatchedAlias = ""
+				if i > 0 {
+					best.MatchedAlias = term
+				}
+			}
+		}
+		if best.rank >= 0 {
+			suggestions = append(suggestions, best)
+		}
+	}
+
+	// 同じ順位なら短い名前（より一般的なもの）、表示順、名前の順
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.rank != b.rank {
+			return a.rank < b.rank
+		}
+		if la, lb := utf8.RuneCountInString(a.Option.Name), utf8.RuneCountInString(b.Option.Name); la != lb {
+			return la < lb
+		}
+		if a.Option.DisplayOrder != b.Option.DisplayOrder {
+			return a.Option.DisplayOrder < b.Option.DisplayOrder
+		}
+		return a.Option.Name < b.Option.Name
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// matchRank は正規化済みの term が q にどの程度一致するかを返します。一致しない場合は -1
+func matchRank(term, q string, alias bool) int {
+	switch {
+	case term == q:
+		return rankExact
+	case strings.HasPrefix(term, q):
+		if alias {
+			return rankAliasPrefix
+		}
+		return rankNamePrefix
+	case utf8.RuneCountInString(q) >= 3 && strings.Contains(term, q):
+		// 1〜2 文字の部分一致は候補が多すぎるので前方一致だけにする
+		return rankContains
+	}
+
+	// タイプミスは入力の長さに応じて 1〜2 文字まで許す（前方一致として比べる）
+	qr, tr := []rune(q), []rune(term)
+	allowed := 0
+	switch {
+	case len(qr) >= 6:
+		allowed = 2
+	case len(qr) >= 3:
+		allowed = 1
+	}
+	if allowed == 0 {
+		return -1
+	}
+	if len(tr) > len(qr) {
+		tr = tr[:len(qr)]
+	}
+	if editDistance(qr, tr) <= allowed {
+		return rankFuzzy
+	}
+	return -1
+}
+
+// editDistance は隣接文字の入れ替えも 1 回と数える編集距離です
+func editDistance(a, b []rune) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
@@ -0,0 +1,116 @@
+// backend/domain/taxonomy/entity_test.go
+package taxonomy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReplaceValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      []string
+		want        []string
+		wantChanged bool
+	}{
+		{name: "replaced", values: []string{"golang", "SQL"}, want: []string{"Go", "SQL"}, wantChanged: true},
+		{name: "dedupe when target exists", values: []string{"Go", "SQL", "golang"}, want: []string{"Go", "SQL"}, wantChanged: true},
+		{name: "not contained", values: []string{"Rust"}, want: []string{"Rust"}},
+		{name: "case sensitive", values: []string{"Golang"}, want: []string{"Golang"}},
+		{name: "empty", values: nil, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := ReplaceValue(tt.values, "golang", "Go")
+			if !reflect.DeepEqual(got, tt.want) || changed != tt.wantChanged {
+				t.Errorf("ReplaceValue(%v) = %v, %v; want %v, %v", tt.values, got, changed, tt.want, tt.wantChanged)
+			}
+		})
+	}
+}
+
+func TestNewOption(t *testing.T) {
+	if _, err := NewOption("colors", "Red", 0); err == nil {
+		t.Error("unknown kind should be rejected")
+	}
+	if _, err := NewOption(KindSkill, "  ", 0); err == nil {
+		t.Error("blank name should be rejected")
+	}
+	o, err := NewOption(KindSkill, " Go ", 10)
+	if err != nil {
+		t.Fatalf("NewOption: %v", err)
+	}
+	if o.Name != "Go" || o.IsRetired() {
+		t.Errorf("option = %+v", o)
+	}
+}
+
+func TestKey(t *testing.T) {
+	tests := map[string]string{
+		"React":    "react",
+		" react ":  "react",
+		"Ｒｅａｃｔ":    "react",
+		"React.js": "reactjs",
+		"C++":      "c++",
+		"Vue 3":    "vue3",
+		"node-js":  "nodejs",
+		"Ｃ＃":       "c#",
+		"機械学習・AI":  "機械学習・ai",
+		"":         "",
+	}
+	for in, want := range tests {
+		if got := Key(in); got != want {
+			t.Errorf("Key(%q) = %q; want %q", in, got, want)
+		}
+	}
+}
+
+func TestResolver_Normalize(t *testing.T) {
+	react := &Option{Kind: KindSkill, Name: "React", Aliases: []string{"React.js", "ReactJS"}}
+	goOpt := &Option{Kind: KindSkill, Name: "Go", Aliases: []string{"golang"}}
+	r := NewResolver([]*Option{react, goOpt})
+
+	got := r.Normalize([]string{"react", "React.js", " Golang ", "Elm", "elm", ""})
+	want := []string{"React", "Go", "Elm"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Normalize = %v; want %v", got, want)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	options := []*Option{
+		{Name: "React", Aliases: []string{"React.js"}, DisplayOrder: 10},
+		{Name: "React Native", DisplayOrder: 20},
+		{Name: "Redux", DisplayOrder: 30},
+		{Name: "Go", Aliases: []string{"golang"}, DisplayOrder: 40},
+		{Name: "PostgreSQL", Aliases: []string{"postgres"}, DisplayOrder: 50},
+		{Name: "TypeScript", DisplayOrder: 60},
+	}
+	names := func(s []Suggestion) []string {
+		var out []string
+		for _, v := range s {
+			out = append(out, v.Option.Name)
+		}
+		return out
+	}
+
+	if got := names(Suggest(options, "re", 10)); !reflect.DeepEqual(got, []string{"React", "Redux", "React Native"}) {
+		t.Errorf("prefix = %v", got)
+	}
+	if got := names(Suggest(options, "react", 1)); !reflect.DeepEqual(got, []string{"React"}) {
+		t.Errorf("exact with limit = %v", got)
+	}
+	if got := Suggest(options, "golang", 10); len(got) != 1 || got[0].Option.Name != "Go" || got[0].MatchedAlias != "golang" {
+		t.Errorf("alias = %+v", got)
+	}
+	if got := names(Suggest(options, "raect", 10)); len(got) == 0 || got[0] != "React" {
+		t.Errorf("typo = %v", got)
+	}
+	if got := names(Suggest(options, "script", 10)); !reflect.DeepEqual(got, []string{"TypeScript"}) {
+		t.Errorf("contains = %v", got)
+	}
+	if got := Suggest(options, "xy", 10); len(got) != 0 {
+		t.Errorf("no match = %v", names(got))
+	}
+}
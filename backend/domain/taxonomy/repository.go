@@ -0,0 +1,20 @@
+// backend/domain/taxonomy/repository.go
+package taxonomy
+
+// Repository は選択肢の永続化インターフェースです
+// 選択肢の別名 (Aliases) も選択肢と一緒に読み書きします
+type Repository interface {
+	// 表示順、名前の順に返す。includeRetired が false の場合は廃止済みを除く
+	List(kind Kind, includeRetired bool) ([]*Option, error)
+	GetByID(kind Kind, id uint) (*Option, error)
+	Create(o *Option) error
+	// previousName を指定した場合は、ユーザー・投稿などに登録済みの値も新しい名前に書き換える
+	Update(o *Option, previousName string) error
+	Delete(o *Option) error
+	// source を削除し、登録済みの値を target の名前に書き換えて書き換えた行数を返す（target の別名も保存する）
+	Merge(source, target *Option) (int64, error)
+	// ids の並び順に表示順を振り直す
+	Reorder(kind Kind, ids []uint) error
+	// ユーザー・投稿などに登録済みの値を normalize で書き換え、書き換えた行数を返す
+	NormalizeStoredValues(kind Kind, normalize func([]string) []string) (int64, error)
+}
@@ -0,0 +1,29 @@
+// backend/domain/unitofwork/unit_of_work.go
+package unitofwork
+
+import (
+	domainCareer "backend/domain/career"
+	domainPortfolio "backend/domain/portfolio"
+	domainUser "backend/domain/user"
+	domainUserSkill "backend/domain/userskill"
+)
+
+// Tx は 1 つのトランザクションの中で使うリポジトリです
+// ここから取得したリポジトリへの変更は、UnitOfWork.Do の fn が成功したときにまとめて確定する
+type Tx interface {
+	Users() domainUser.IUserRepository
+	Posts() domainPortfolio.Repository
+	UserSkills() domainUserSkill.Repository
+	Careers() domainCareer.Repository
+	// OnRollback はトランザクションを取り消したときに実行する後始末を登録します
+	// DB の外で行った変更（保存したアップロードファイルなど）を元に戻すのに使い、登録と逆の順に実行する
+	OnRollback(fn func())
+}
+
+// UnitOfWork は複数のリポジトリへの変更を 1 つのトランザクションで行うためのインターフェースです
+// サービス層はトランザクションの始め方を知らずに、このインターフェースだけに依存します
+type UnitOfWork interface {
+	// Do は fn を 1 つのトランザクションで実行します
+	// fn がエラーを返すか panic した場合、または確定に失敗した場合は変更を取り消し、OnRollback で登録した後始末を実行する
+	Do(fn func(tx Tx) error) error
+}
@@ -2,11 +2,32 @@
 package user
 
 import (
+	"errors"
 	"fmt"
 	"net/mail"
 	"time"
 )
 
+// Role はユーザーの種別です
+type Role string
+
+const (
+	RoleStudent   Role = "student"   // 作品を投稿する学生（デフォルト）
+	RoleRecruiter Role = "recruiter" // 企業側の採用担当
+	RoleAdmin     Role = "admin"     // 運営
+)
+
+// ErrVersionConflict は読み込んだあとにほかの操作で更新されたユーザーへの保存です
+var ErrVersionConflict = errors.New("the user was updated by another request")
+
+// ProfileVisibility はプロフィールの公開範囲です
+type ProfileVisibility string
+
+const (
+	VisibilityPublic  ProfileVisibility = "public"  // 誰でも閲覧・検索できる
+	VisibilityPrivate ProfileVisibility = "private" // 本人以外には公開しない
+)
+
 // User はユーザーに関するドメインエンティティです。
 // フィールドは DB のスキーマに依存せず、ビジネスロジックに沿った形で保持します。
 type UserModel struct {
@@ -18,6 +39,7 @@ type UserModel struct {
 	VerificationExpiresAt time.Time // トークンの有効期限
 	PasswordResetToken    string    // パスワードリセット用トークン
 	PasswordResetExpires  time.Time // リセットトークンの有効期限
+	Role                  Role      // ユーザー種別
 
 	// プロフィール情報（最低限必要な情報）
 	FirstName        string
@@ -25,18 +47,30 @@ type UserModel struct {
 	FirstNameKana    string
 	LastNameKana     string
 	ProfileImageURL  string
-	SelfIntroduction string
+	SelfIntroduction string // Markdown の原文
+	// SelfIntroductionHTML は SelfIntroduction を変換・サニタイズした HTML です（保存せず、読み込み時に設定する）
+	SelfIntroductionHTML string
 
 	SchoolName      string
 	Department      string
 	Laboratory      string
-	GraduationYear  string // GORMモデルが文字列なので、ドメインも string に合わせる
+	GraduationYear  string // GORMモデルが文字列なので、ドメインも string に合わせる（先頭の学歴の卒業年）
 	DesiredJobTypes []string
 	Skills          []string
 
+	// 公開設定
+	ProfileVisibility    ProfileVisibility
+	HiddenFromRecruiters bool // true の場合は企業の候補者検索に表示しない
+
+	// 運営による利用停止
+	SuspendedAt      *time.Time
+	SuspensionReason string
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt time.Time
+	// Version は楽観的排他制御の版番号です。保存するたびに 1 増え、ETag に使う
+	Version int
 }
 
 // NewUser は新規登録時に呼ぶファクトリメソッドです。
@@ -66,6 +100,7 @@ func NewUser(email, password string) (*UserModel, error) {
 		VerificationExpiresAt: time.Time{},
 		PasswordResetToken:    "",
 		PasswordResetExpires:  time.Time{},
+		Role:                  RoleStudent,
 
 		FirstName:       "",
 		LastName:        "",
@@ -80,6 +115,9 @@ func NewUser(email, password string) (*UserModel, error) {
 		DesiredJobTypes: []string{},
 		Skills:          []string{},
 
+		ProfileVisibility:    VisibilityPublic,
+		HiddenFromRecruiters: false,
+
 		CreatedAt: now,
 		UpdatedAt: now,
 	}, nil
@@ -126,6 +164,51 @@ func (u *UserModel) ResetPassword(token, newHash string) error {
 	return nil
 }
 
+// IsRecruiter は企業側ユーザーかどうかを返します
+func (u *UserModel) IsRecruiter() bool {
+	return u.Role == RoleRecruiter
+}
+
+// BecomeRecruiter は企業アカウントに所属したユーザーを採用担当に切り替える振る舞い
+// 運営ユーザーの権限は落とさない
+func (u *UserModel) BecomeRecruiter() {
+	if u.Role == RoleAdmin || u.Role == RoleRecruiter {
+		return
+	}
+	u.Role = RoleRecruiter
+	u.UpdatedAt = time.Now()
+}
+
+// CheckVersion は読み込んだときの版番号 version が今の版番号と同じかを確認する振る舞い。version が 0 なら版を問わない
+func (u *UserModel) CheckVersion(version int) error {
+	if version != 0 && version != u.Version {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// UpdatePrivacy はプロフィールの公開範囲と企業検索への表示可否を変更する振る舞い
+func (u *UserModel) UpdatePrivacy(visibility ProfileVisibility, hiddenFromRecruiters bool) error {
+	switch visibility {
+	case VisibilityPublic, VisibilityPrivate:
+	default:
+		return fmt.Errorf("公開範囲が不正です")
+	}
+	u.ProfileVisibility = visibility
+	u.HiddenFromRecruiters = hiddenFromRecruiters
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsDiscoverableByRecruiters は企業の候補者検索に表示してよいかを返します
+// 本登録済みの学生で、プロフィールを公開し、企業検索を拒否していない場合のみ true
+func (u *UserModel) IsDiscoverableByRecruiters() bool {
+	return u.Role == RoleStudent &&
+		u.IsVerified &&
+		u.ProfileVisibility == VisibilityPublic &&
+		!u.HiddenFromRecruiters
+}
+
 // UpdateProfile はプロフィール情報を一括で更新する振る舞い
 func (u *UserModel) UpdateProfile(
 	firstName, lastName, firstNameKana, lastNameKana,
@@ -155,3 +238,71 @@ func (u *UserModel) UpdateProfile(
 	u.UpdatedAt = time.Now()
 	return nil
 }
+
+// Suspend は運営が利用を停止する振る舞い。運営ユーザーは停止できません
+func (u *UserModel) Suspend(reason string, now time.Time) error {
+	if u.Role == RoleAdmin {
+		return fmt.Errorf("運営ユーザーは利用停止にできません")
+	}
+	if reason == "" {
+		return fmt.Errorf("利用停止の理由は必須です")
+	}
+	u.SuspendedAt = &now
+	u.SuspensionReason = reason
+	u.UpdatedAt = now
+	return nil
+}
+
+// Unsuspend は利用停止を解除する振る舞い
+func (u *UserModel) Unsuspend(now time.Time) {
+	u.SuspendedAt = nil
+	u.SuspensionReason = ""
+	u.UpdatedAt = now
+}
+
+// IsSuspended は利用停止中かどうかを返します
+func (u *UserModel) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+// PublicProfile は他のユーザーに見せてよいプロフィール項目だけを返します
+// メールアドレスや認証情報、公開設定は含めません
+func (u *UserModel) PublicProfile() UserModel {
+	return UserModel{
+		ID:                   u.ID,
+		Role:                 u.Role,
+		FirstName:            u.FirstName,
+		LastName:             u.LastName,
+		FirstNameKana:        u.FirstNameKana,
+		LastNameKana:         u.LastNameKana,
+		ProfileImageURL:      u.ProfileImageURL,
+		SelfIntroduction:     u.SelfIntroduction,
+		SelfIntroductionHTML: u.SelfIntroductionHTML,
+		SchoolName:           u.SchoolName,
+		Department:           u.Department,
+		Laboratory:           u.Laboratory,
+		GraduationYear:       u.GraduationYear,
+		DesiredJobTypes:      u.DesiredJobTypes,
+		Skills:               u.Skills,
+		CreatedAt:            u.CreatedAt,
+	}
+}
+
+// IsVisibleTo は viewerID のユーザーがこのプロフィールを閲覧できるかを返します
+// 非公開・利用停止中のプロフィールは本人にしか見せません
+func (u *UserModel) IsVisibleTo(viewerID uint) bool {
+	if u.ID == viewerID {
+		return true
+	}
+	return u.ProfileVisibility == VisibilityPublic && !u.IsSuspended()
+}
+
+// SetEducationSummary はプロフィールの学校名・学部・研究室・卒業年を設定します
+// 値は表示順が先頭の学歴から求めたもので、検索や一覧表示に使います
+func (u *UserModel) SetEducationSummary(schoolName, department, laboratory, graduationYear string) {
+	u.SchoolName = schoolName
+	u.Department = department
+	u.Laboratory = laboratory
+	u.GraduationYear = graduationYear
+	u.UpdatedAt = time.Now()
+}
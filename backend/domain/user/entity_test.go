@@ -2,6 +2,7 @@
 package user
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -104,3 +105,42 @@ func TestNewUser_Validation(t *testing.T) {
 		})
 	}
 }
+
+func TestUserModel_Suspend(t *testing.T) {
+	now := time.Now()
+
+	u := &UserModel{Role: RoleStudent}
+	if err := u.Suspend("", now); err == nil {
+		t.Error("expected error when reason is empty")
+	}
+	if err := u.Suspend("スパム投稿の繰り返し", now); err != nil {
+		t.Fatalf("Suspend failed: %v", err)
+	}
+	if !u.IsSuspended() {
+		t.Error("user should be suspended")
+	}
+	u.Unsuspend(now)
+	if u.IsSuspended() || u.SuspensionReason != "" {
+		t.Errorf("user should be unsuspended, got reason %q", u.SuspensionReason)
+	}
+
+	admin := &UserModel{Role: RoleAdmin}
+	if err := admin.Suspend("誤操作", now); err == nil || admin.IsSuspended() {
+		t.Error("admin users must not be suspendable")
+	}
+}
+
+func TestUserModel_CheckVersion(t *testing.T) {
+	u := &UserModel{Version: 3}
+	if err := u.CheckVersion(3); err != nil {
+		t.Errorf("same version should pass, got %v", err)
+	}
+	if err := u.CheckVersion(0); err != nil {
+		t.Errorf("version 0 should skip the check, got %v", err)
+	}
+	for _, v := range []int{2, 4, -1} {
+		if err := u.CheckVersion(v); !errors.Is(err, ErrVersionConflict) {
+			t.Errorf("CheckVersion(%d) = %v, want ErrVersionConflict", v, err)
+		}
+	}
+}
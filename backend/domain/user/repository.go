@@ -22,6 +22,7 @@ type IUserRepository interface {
 	FindByID(id uint) (*UserModel, error)
 
 	// プロフィール更新など、変更の保存
+	// 版番号が u.Version のままのときだけ保存して版番号を進め、ほかの操作が先に保存していたら ErrVersionConflict を返す
 	UpdateUser(u *UserModel) error
 
 	// 未認証ユーザーのソフトデリート
@@ -0,0 +1,177 @@
+// backend/domain/userskill/entity.go
+package userskill
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Level はスキルの習熟度です。空文字は未設定を表します
+type Level string
+
+const (
+	LevelBeginner     Level = "beginner"     // 学習中・授業で使った程度
+	LevelIntermediate Level = "intermediate" // 個人開発で一通り使える
+	LevelAdvanced     Level = "advanced"     // チーム開発やインターンで使った
+	LevelExpert       Level = "expert"       // 人に教えられる・設計判断ができる
+)
+
+// Levels は習熟度の一覧です（低い順）
+var Levels = []Level{LevelBeginner, LevelIntermediate, LevelAdvanced, LevelExpert}
+
+const (
+	MaxYearsOfExperience = 50 // 経験年数の上限
+	MaxPostsPerSkill     = 6  // 1つのスキルに紐づけられる作品の上限
+)
+
+// ParseLevel は文字列を習熟度に変換します。空文字は未設定として受け付けます
+func ParseLevel(s string) (Level, error) {
+	if s == "" {
+		return "", nil
+	}
+	for _, l := range Levels {
+		if string(l) == s {
+			return l, nil
+		}
+	}
+	return "", fmt.Errorf("習熟度が不正です: %s", s)
+}
+
+// DemoPost はスキルを使った作品として表示する投稿の概要です
+type DemoPost struct {
+	ID           uint
+	Title        string
+	ThumbnailURL string
+}
+
+// UserSkill はユーザーがプロフィールに登録したスキル 1 件を表すドメインエンティティです
+// Name はスキルの選択肢に揃えた名前で、UserModel.Skills と同じ並びで保持します
+type UserSkill struct {
+	ID                uint
+	UserID            uint
+	Name              string
+	Level             Level
+	YearsOfExperience float64
+	PostIDs           []uint // このスキルを使った作品
+	DisplayOrder      int
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+
+	// 以下は読み出し時に設定する集計値で、永続化しない
+	EndorsementCount int
+	Endorsed         bool       // 閲覧者が推薦済みか
+	Posts            []DemoPost // PostIDs のうち表示できる作品
+}
+
+// NewUserSkill は習熟度未設定のスキルを生成するファクトリメソッドです
+func NewUserSkill(userID uint, name string) (*UserSkill, error) {
+	if userID == 0 {
+		return nil, fmt.Errorf("ユーザーは必須です")
+	}
+	if name == "" {
+		return nil, fmt.Errorf("スキル名は必須です")
+	}
+	now := time.Now()
+	return &UserSkill{
+		UserID:    userID,
+		Name:      name,
+		PostIDs:   []uint{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// SetProficiency は習熟度と経験年数を設定する振る舞い
+func (s *UserSkill) SetProficiency(level Level, years float64) error {
+	if _, err := ParseLevel(string(level)); err != nil {
+		return err
+	}
+	if years < 0 || years > MaxYearsOfExperience {
+		return fmt.Errorf("経験年数は0〜%d年で入力してください", MaxYearsOfExperience)
+	}
+	s.Level = level
+	s.YearsOfExperience = years
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetPosts はスキルを使った作品を設定する振る舞い。重複は取り除きます
+// 作品が本人のものかどうかは呼び出し側で確認します
+func (s *UserSkill) SetPosts(postIDs []uint) error {
+	ids := make([]uint, 0, len(postIDs))
+	seen := make(map[uint]bool, len(postIDs))
+	for _, id := range postIDs {
+		if id == 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	if len(ids) > MaxPostsPerSkill {
+		return fmt.Errorf("1つのスキルに紐づけられる作品は%d件までです", MaxPostsPerSkill)
+	}
+	s.PostIDs = ids
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// Reconcile は登録済みのスキルを names の並びに合わせます
+// 名前が一致するスキルは習熟度・推薦を引き継ぎ、新しい名前は未設定のスキルとして追加します
+// names にないスキルは removed として返します
+func Reconcile(userID uint, existing []*UserSkill, names []string) (skills []*UserSkill, removed []*UserSkill, err error) {
+	byName := make(map[string]*UserSkill, len(existing))
+	for _, s := range existing {
+		byName[s.Name] = s
+	}
+	skills = make([]*UserSkill, 0, len(names))
+	for i, name := range names {
+		s, ok := byName[name]
+		if ok {
+			delete(byName, name)
+		} else if s, err = NewUserSkill(userID, name); err != nil {
+			return nil, nil, err
+		}
+		s.DisplayOrder = i
+		skills = append(skills, s)
+	}
+	for _, s := range existing {
+		if _, ok := byName[s.Name]; ok {
+			removed = append(removed, s)
+		}
+	}
+	return skills, removed, nil
+}
+
+// SortByEndorsements は推薦の多い順に並べ替えます。同数の場合は本人が登録した順を保ちます
+func SortByEndorsements(skills []*UserSkill) {
+	sort.SliceStable(skills, func(i, j int) bool {
+		if skills[i].EndorsementCount != skills[j].EndorsementCount {
+			return skills[i].EndorsementCount > skills[j].EndorsementCount
+		}
+		return skills[i].DisplayOrder < skills[j].DisplayOrder
+	})
+}
+
+// Endorsement は他のユーザーによるスキルの推薦です。推薦者ごとに 1 スキル 1 回まで
+type Endorsement struct {
+	ID          uint
+	UserSkillID uint
+	EndorserID  uint
+	CreatedAt   time.Time
+}
+
+// NewEndorsement は Endorsement を生成するファクトリメソッドです。自分のスキルは推薦できません
+func NewEndorsement(skill *UserSkill, endorserID uint) (*Endorsement, error) {
+	if endorserID == 0 {
+		return nil, fmt.Errorf("推薦するユーザーは必須です")
+	}
+	if skill.UserID == endorserID {
+		return nil, fmt.Errorf("自分のスキルは推薦できません")
+	}
+	return &Endorsement{
+		UserSkillID: skill.ID,
+		EndorserID:  endorserID,
+		CreatedAt:   time.Now(),
+	}, nil
+}
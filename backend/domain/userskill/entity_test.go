@@ -0,0 +1,80 @@
+// backend/domain/userskill/entity_test.go
+package userskill
+
+import "testing"
+
+func TestUserSkill_SetProficiency(t *testing.T) {
+	s, err := NewUserSkill(1, "Go")
+	if err != nil {
+		t.Fatalf("NewUserSkill failed: %v", err)
+	}
+	if err := s.SetProficiency(LevelAdvanced, 2.5); err != nil {
+		t.Fatalf("SetProficiency failed: %v", err)
+	}
+	if err := s.SetProficiency(Level("master"), 1); err == nil {
+		t.Error("expected error for unknown level")
+	}
+	if err := s.SetProficiency(LevelBeginner, -1); err == nil {
+		t.Error("expected error for negative years")
+	}
+	if s.Level != LevelAdvanced || s.YearsOfExperience != 2.5 {
+		t.Errorf("invalid input should not change proficiency: %s %v", s.Level, s.YearsOfExperience)
+	}
+}
+
+func TestUserSkill_SetPosts(t *testing.T) {
+	s, _ := NewUserSkill(1, "Go")
+	if err := s.SetPosts([]uint{3, 3, 0, 5}); err != nil {
+		t.Fatalf("SetPosts failed: %v", err)
+	}
+	if len(s.PostIDs) != 2 || s.PostIDs[0] != 3 || s.PostIDs[1] != 5 {
+		t.Errorf("PostIDs = %v, want [3 5]", s.PostIDs)
+	}
+	if err := s.SetPosts([]uint{1, 2, 3, 4, 5, 6, 7}); err == nil {
+		t.Error("expected error when too many posts are linked")
+	}
+}
+
+func TestReconcile_KeepsExistingSkills(t *testing.T) {
+	goSkill := &UserSkill{ID: 1, UserID: 1, Name: "Go", Level: LevelExpert}
+	rust := &UserSkill{ID: 2, UserID: 1, Name: "Rust"}
+
+	skills, removed, err := Reconcile(1, []*UserSkill{goSkill, rust}, []string{"React", "Go"})
+	if err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if len(skills) != 2 || skills[0].Name != "React" || skills[0].ID != 0 || skills[1] != goSkill {
+		t.Fatalf("unexpected skills: %+v", skills)
+	}
+	if goSkill.DisplayOrder != 1 || goSkill.Level != LevelExpert {
+		t.Errorf("existing skill should keep its level and move to index 1: %+v", goSkill)
+	}
+	if len(removed) != 1 || removed[0] != rust {
+		t.Errorf("removed = %+v, want [Rust]", removed)
+	}
+}
+
+func TestSortByEndorsements(t *testing.T) {
+	skills := []*UserSkill{
+		{Name: "Go", DisplayOrder: 0, EndorsementCount: 1},
+		{Name: "React", DisplayOrder: 1, EndorsementCount: 3},
+		{Name: "SQL", DisplayOrder: 2, EndorsementCount: 1},
+	}
+	SortByEndorsements(skills)
+	want := []string{"React", "Go", "SQL"}
+	for i, s := range skills {
+		if s.Name != want[i] {
+			t.Fatalf("order = %v at %d, want %v", s.Name, i, want)
+		}
+	}
+}
+
+func TestNewEndorsement_RejectsSelf(t *testing.T) {
+	s := &UserSkill{ID: 1, UserID: 7, Name: "Go"}
+	if _, err := NewEndorsement(s, 7); err == nil {
+		t.Error("expected error when endorsing own skill")
+	}
+	if _, err := NewEndorsement(s, 8); err != nil {
+		t.Errorf("NewEndorsement failed: %v", err)
+	}
+}
@@ -0,0 +1,17 @@
+// backend/domain/userskill/repository.go
+package userskill
+
+// Repository はプロフィールのスキルと推薦の永続化インターフェースです
+type Repository interface {
+	// 推薦数 (EndorsementCount) を設定して DisplayOrder 順に返す
+	GetByUserID(userID uint) ([]*UserSkill, error)
+	GetByID(id uint) (*UserSkill, error)
+	// skills を保存し、removed のスキルを推薦ごと削除する
+	Save(skills []*UserSkill, removed []*UserSkill) error
+
+	CreateEndorsement(e *Endorsement) error
+	DeleteEndorsement(userSkillID, endorserID uint) error
+	HasEndorsed(userSkillID, endorserID uint) (bool, error)
+	// skillIDs のうち endorserID が推薦済みのスキル ID を返す
+	EndorsedSkillIDs(endorserID uint, skillIDs []uint) ([]uint, error)
+}
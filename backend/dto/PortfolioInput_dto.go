@@ -2,9 +2,89 @@
 
 package dto
 
+import "time"
+
 type CreatePostInput struct {
 	Title       string   `json:"title" binding:"required"`
 	Description string   `json:"description" binding:"required"`
 	Genres      []string `json:"genres" binding:"required"`
 	Skills      []string `json:"skills"`
+
+	ProjectDetailsInput
+
+	// 画像ファイルと同じ順に並べた代替テキスト・キャプション
+	Images          []PostImageInput `json:"images"`
+	CoverImageIndex int              `json:"coverImageIndex"`
+}
+
+// ProjectDetailsInput は作品の制作情報です（すべて任意）
+type ProjectDetailsInput struct {
+	RepositoryURL string           `json:"repositoryUrl"`
+	DemoURL       string           `json:"demoUrl"`
+	Role          string           `json:"role"`
+	TeamSize      int              `json:"teamSize"`
+	StartDate     string           `json:"startDate"` // "YYYY-MM"
+	EndDate       string           `json:"endDate"`   // 開発中なら空
+	TechStack     []TechStackInput `json:"techStack" binding:"max=30"`
+}
+
+// PostContentInput は下書きの作成と投稿の編集・自動保存の入力です
+// 下書きはタイトルだけで保存でき、ジャンル・画像は公開するときに揃っていればよい
+type PostContentInput struct {
+	Title       string   `json:"title" binding:"required"`
+	Description string   `json:"description" binding:"max=20000"`
+	Genres      []string `json:"genres"`
+	Skills      []string `json:"skills"`
+
+	ProjectDetailsInput
+
+	// 画像は POST /Portfolio/:id/images でアップロード済みのものを URL で指定する。並び順が表示順になる
+	Images          []PostImageEditInput `json:"images" binding:"max=10,dive"`
+	CoverImageIndex int                  `json:"coverImageIndex"`
+}
+
+// PostImageEditInput は投稿に登録済みの画像 1 枚分の説明です
+type PostImageEditInput struct {
+	URL     string `json:"url" binding:"required"`
+	AltText string `json:"altText"`
+	Caption string `json:"caption"`
+}
+
+// SchedulePostInput は予約投稿の公開日時です（RFC 3339）
+type SchedulePostInput struct {
+	PublishAt time.Time `json:"publishAt" binding:"required"`
+}
+
+// TechStackInput は技術スタック 1 件です
+type TechStackInput struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// PostImageInput は画像 1 枚分の説明です
+type PostImageInput struct {
+	AltText string `json:"altText"`
+	Caption string `json:"caption"`
+}
+
+// PostSearchInput は GET /Portfolio/getAllPosts の絞り込み条件です
+// 配列は ?genre=Web&genre=ゲーム のように同じキーを繰り返して指定します
+type PostSearchInput struct {
+	Keyword        string   `form:"q"`
+	Genres         []string `form:"genre"`
+	Skills         []string `form:"skill"`
+	GraduationYear string   `form:"graduationYear"`
+	Sort           string   `form:"sort" binding:"omitempty,oneof=newest trending"` // 省略時は newest。trending はジャンルと組み合わせるとジャンル別のトレンドになる
+}
+
+// InviteCollaboratorInput は共同制作者の招待です。ユーザー ID かメールアドレスのどちらかで指定する
+type InviteCollaboratorInput struct {
+	UserID uint   `json:"userId"`
+	Email  string `json:"email" binding:"omitempty,email"`
+}
+
+// ContributionInput は共同制作者本人の担当と貢献内容です
+type ContributionInput struct {
+	Role         string `json:"role" binding:"max=100"`
+	Contribution string `json:"contribution" binding:"max=2000"`
 }
@@ -0,0 +1,12 @@
+package dto
+
+// RecordViewInput は投稿・プロフィールのページを開いたときにフロントエンドから送る閲覧の記録です
+type RecordViewInput struct {
+	Type     string `json:"type" binding:"required,oneof=post profile"`
+	ID       uint   `json:"id" binding:"required"`
+	Referrer string `json:"referrer" binding:"max=2048"` // ページの document.referrer
+}
+
+type InsightsQuery struct {
+	Days int `form:"days" binding:"omitempty,min=1,max=365"` // 省略時は 30 日
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// AuditLogSearchInput は GET /admin/audit-logs のクエリです
+// 日時は RFC3339 形式 (例: 2024-04-01T00:00:00+09:00) で指定します
+type AuditLogSearchInput struct {
+	ActorID    uint       `form:"actorId"`
+	TargetType string     `form:"targetType"`
+	TargetID   uint       `form:"targetId"`
+	Actions    []string   `form:"action"`
+	From       *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To         *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Page       int        `form:"page"`
+	PerPage    int        `form:"perPage"`
+}
+
+type PageInput struct {
+	Page    int `form:"page"`
+	PerPage int `form:"perPage"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+type CollectionInput struct {
+	Title       string `json:"title" binding:"required,max=100"`
+	Description string `json:"description" binding:"max=2000"`
+	CoverPostID *uint  `json:"coverPostId"` // コレクション内の投稿。省略時は先頭の投稿の画像を表紙にする
+}
+
+// CollectionPostsInput はコレクションの投稿を表示順に丸ごと指定します
+type CollectionPostsInput struct {
+	PostIDs []uint `json:"postIds" binding:"max=100"`
+}
+
+type AddCollectionPostInput struct {
+	PostID uint `json:"postId" binding:"required"`
+}
+
+type ReorderCollectionsInput struct {
+	IDs []uint `json:"ids" binding:"required"` // 表示したい順に並べた ID
+}
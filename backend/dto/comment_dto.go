@@ -0,0 +1,5 @@
+package dto
+
+type CreateCommentInput struct {
+	Body string `json:"body" binding:"required"`
+}
@@ -0,0 +1,8 @@
+// dto/github_dto.go
+
+package dto
+
+// ImportGitHubReposInput は下書きの投稿として取り込むリポジトリ名（"name" または "owner/name"）です
+type ImportGitHubReposInput struct {
+	Repositories []string `json:"repositories" binding:"required,min=1,max=20"`
+}
@@ -0,0 +1,46 @@
+package dto
+
+import "time"
+
+type CreateOrganizationInput struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+	WebsiteURL  string `json:"websiteUrl"`
+}
+
+type AddOrganizationMemberInput struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// ViewDisclosureInput は採用担当として閲覧したとき、学生に企業名を知らせるかの設定です
+type ViewDisclosureInput struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+type JobPostingInput struct {
+	OrganizationID uint       `json:"organizationId"`
+	Title          string     `json:"title" binding:"required"`
+	Description    string     `json:"description"`
+	EmploymentType string     `json:"employmentType" binding:"required"`
+	JobTypes       []string   `json:"jobTypes" binding:"required"`
+	Skills         []string   `json:"skills"`
+	Location       string     `json:"location"`
+	Deadline       *time.Time `json:"deadline"`
+}
+
+type JobSearchInput struct {
+	Keyword        string `form:"q"`
+	JobType        string `form:"jobType"`
+	Skill          string `form:"skill"`
+	Location       string `form:"location"`
+	EmploymentType string `form:"employmentType"`
+}
+
+type ApplyJobInput struct {
+	Message string `json:"message"`
+	PostIDs []uint `json:"postIds"`
+}
+
+type UpdateApplicationStatusInput struct {
+	Status string `json:"status" binding:"required"`
+}
@@ -0,0 +1,8 @@
+// dto/markdown_dto.go
+
+package dto
+
+// RenderMarkdownInput はエディタのプレビューで変換する Markdown です
+type RenderMarkdownInput struct {
+	Source string `json:"source" binding:"max=20000"`
+}
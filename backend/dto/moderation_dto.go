@@ -0,0 +1,31 @@
+package dto
+
+type CreateReportInput struct {
+	TargetType string `json:"targetType" binding:"required"` // "post", "comment", "user"
+	TargetID   uint   `json:"targetId" binding:"required"`
+	Category   string `json:"category" binding:"required"`
+	Detail     string `json:"detail"`
+}
+
+// ReportListInput は GET /admin/reports のクエリです
+type ReportListInput struct {
+	Status     string `form:"status"` // 省略時は "open"
+	TargetType string `form:"targetType"`
+	Page       int    `form:"page"`
+	PerPage    int    `form:"perPage"`
+}
+
+type CloseReportInput struct {
+	Status string `json:"status" binding:"required"` // "resolved" または "dismissed"
+	Note   string `json:"note"`                      // 入力された場合は対象への運営メモとして残す
+}
+
+type ModerationReasonInput struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+type CreateModerationNoteInput struct {
+	TargetType string `json:"targetType" binding:"required"`
+	TargetID   uint   `json:"targetId" binding:"required"`
+	Body       string `json:"body" binding:"required"`
+}
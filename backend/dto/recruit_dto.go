@@ -0,0 +1,21 @@
+package dto
+
+// CandidateSearchInput は GET /recruit/candidates のクエリです
+// 配列は ?skill=Go&skill=SQL のように同じキーを繰り返して指定します
+type CandidateSearchInput struct {
+	DesiredJobTypes []string `form:"jobType"`
+	Skills          []string `form:"skill"`
+	GraduationYear  string   `form:"graduationYear"`
+	SchoolName      string   `form:"school"`
+	PostGenres      []string `form:"postGenre"`
+	PostSkills      []string `form:"postSkill"`
+	JobID           uint     `form:"jobId"` // 関連度の計算に使う自社の求人
+	Page            int      `form:"page"`
+	PerPage         int      `form:"perPage"`
+	Format          string   `form:"format"` // "csv" で CSV をダウンロード
+}
+
+type PrivacySettingsInput struct {
+	ProfileVisibility    string `json:"profileVisibility" binding:"required"`
+	HiddenFromRecruiters bool   `json:"hiddenFromRecruiters"`
+}
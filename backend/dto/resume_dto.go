@@ -0,0 +1,7 @@
+package dto
+
+// ResumeQuery は履歴書の体裁と載せる作品の指定です
+type ResumeQuery struct {
+	Template string `form:"template" binding:"omitempty,oneof=standard modern"` // 省略時は standard
+	PostIDs  []uint `form:"posts" binding:"max=6"`                              // ?posts=3&posts=1 の順に載せる。省略時は新しい作品から 6 件
+}
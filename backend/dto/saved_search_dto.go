@@ -0,0 +1,21 @@
+package dto
+
+type CreateSavedSearchInput struct {
+	Name           string   `json:"name" binding:"required"`
+	Target         string   `json:"target" binding:"required"` // "posts" または "candidates"
+	Keyword        string   `json:"keyword"`
+	Genres         []string `json:"genres"`
+	Skills         []string `json:"skills"`
+	GraduationYear string   `json:"graduationYear"`
+	Frequency      string   `json:"frequency" binding:"required"` // "daily" または "weekly"
+}
+
+type UpdateSavedSearchInput struct {
+	Name           string   `json:"name" binding:"required"`
+	Keyword        string   `json:"keyword"`
+	Genres         []string `json:"genres"`
+	Skills         []string `json:"skills"`
+	GraduationYear string   `json:"graduationYear"`
+	Frequency      string   `json:"frequency" binding:"required"`
+	Active         *bool    `json:"active"` // 省略した場合は配信の停止・再開をしない
+}
@@ -0,0 +1,6 @@
+package dto
+
+// SiteExportInput は静的サイトの書き出しの指定です
+type SiteExportInput struct {
+	Theme string `json:"theme" binding:"omitempty,oneof=simple dark"` // 省略時は simple
+}
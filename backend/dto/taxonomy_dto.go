@@ -0,0 +1,22 @@
+package dto
+
+type TaxonomyOptionInput struct {
+	Name         string    `json:"name" binding:"required"`
+	DisplayOrder *int      `json:"displayOrder"` // 省略時は作成なら末尾、更新なら変更しない
+	Category     *string   `json:"category"`     // スキルのみ。"language", "framework" など
+	Aliases      *[]string `json:"aliases"`      // 表記ゆれ。指定した場合は一覧を置き換える
+}
+
+type MergeTaxonomyOptionInput struct {
+	TargetID uint `json:"targetId" binding:"required"` // 統合先
+}
+
+type ReorderTaxonomyOptionsInput struct {
+	IDs []uint `json:"ids" binding:"required"` // 表示したい順に並べた ID
+}
+
+// SuggestInput は入力補完のクエリです
+type SuggestInput struct {
+	Query string `form:"q"`
+	Limit int    `form:"limit"`
+}
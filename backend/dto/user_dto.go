@@ -13,3 +13,49 @@ type MinimumUserInfoInput struct {
 	Skills           *[]string `json:"skills"`
 	SelfIntroduction *string   `json:"selfIntroduction"`
 }
+
+type UserSkillInput struct {
+	Name              string  `json:"name" binding:"required"`
+	Level             string  `json:"level"` // "beginner" / "intermediate" / "advanced" / "expert"。空なら未設定
+	YearsOfExperience float64 `json:"yearsOfExperience"`
+	PostIDs           []uint  `json:"postIds"`
+}
+
+// UpdateUserSkillsInput はスキルの一覧を表示順に丸ごと指定します
+type UpdateUserSkillsInput struct {
+	Skills []UserSkillInput `json:"skills" binding:"max=50,dive"`
+}
+
+type EducationInput struct {
+	Degree     string `json:"degree"` // "bachelor", "master" など。空なら未設定
+	SchoolName string `json:"schoolName" binding:"required"`
+	Department string `json:"department"`
+	Laboratory string `json:"laboratory"`
+	StartDate  string `json:"startDate"` // "YYYY-MM"
+	EndDate    string `json:"endDate"`   // 卒業（予定）年月 "YYYY-MM"。未定なら空
+}
+
+type ReorderEducationsInput struct {
+	IDs []uint `json:"ids" binding:"required"` // 表示したい順に並べた ID。先頭がプロフィールの学校になる
+}
+
+type ExperienceInput struct {
+	Type        string   `json:"type"` // "internship", "part_time" など。空なら other
+	CompanyName string   `json:"companyName" binding:"required"`
+	Role        string   `json:"role" binding:"required"`
+	StartDate   string   `json:"startDate" binding:"required"` // "YYYY-MM"
+	EndDate     string   `json:"endDate"`                      // 継続中なら空
+	Description string   `json:"description"`
+	Skills      []string `json:"skills"`
+}
+
+// ExternalLinkInput はプロフィールの外部リンク 1 件です
+type ExternalLinkInput struct {
+	Type string `json:"type" binding:"required"` // "github", "x", "zenn", "qiita", "blog", "linkedin"
+	URL  string `json:"url" binding:"required"`
+}
+
+// UpdateExternalLinksInput は外部リンクを並び順どおりに丸ごと置き換えます
+type UpdateExternalLinksInput struct {
+	Links []ExternalLinkInput `json:"links" binding:"max=10,dive"`
+}
@@ -0,0 +1,72 @@
+package analytics
+
+import "time"
+
+// ViewEventModel は閲覧 1 件の生データです。同じ閲覧者・対象・日は 1 行だけにする
+type ViewEventModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	TargetType     string `gorm:"size:16;not null;uniqueIndex:idx_view_events_visit"`
+	TargetID       uint   `gorm:"not null;uniqueIndex:idx_view_events_visit"`
+	VisitorKey     string `gorm:"size:64;not null;uniqueIndex:idx_view_events_visit"`
+	Day            string `gorm:"size:10;not null;uniqueIndex:idx_view_events_visit;index"`
+	OwnerID        uint   `gorm:"not null"`
+	ViewerID       uint   `gorm:"not null;default:0"`
+	OrganizationID *uint
+	ReferrerHost   string `gorm:"size:255;not null;default:''"`
+}
+
+func (ViewEventModel) TableName() string {
+	return "view_events"
+}
+
+// DailyViewStatModel は閲覧対象ごと・日ごとの閲覧数の集計です
+type DailyViewStatModel struct {
+	ID         uint   `gorm:"primaryKey"`
+	OwnerID    uint   `gorm:"not null;index:idx_daily_view_stats_owner_day"`
+	Day        string `gorm:"size:10;not null;index:idx_daily_view_stats_owner_day;uniqueIndex:idx_daily_view_stats_target_day"`
+	TargetType string `gorm:"size:16;not null;uniqueIndex:idx_daily_view_stats_target_day"`
+	TargetID   uint   `gorm:"not null;uniqueIndex:idx_daily_view_stats_target_day"`
+	Views      int    `gorm:"not null"`
+}
+
+func (DailyViewStatModel) TableName() string {
+	return "daily_view_stats"
+}
+
+// DailyReferrerStatModel は閲覧された本人ごと・日ごとの参照元の集計です
+type DailyReferrerStatModel struct {
+	ID           uint   `gorm:"primaryKey"`
+	OwnerID      uint   `gorm:"not null;uniqueIndex:idx_daily_referrer_stats_owner_day_host"`
+	Day          string `gorm:"size:10;not null;uniqueIndex:idx_daily_referrer_stats_owner_day_host;index"`
+	ReferrerHost string `gorm:"size:255;not null;uniqueIndex:idx_daily_referrer_stats_owner_day_host"`
+	Views        int    `gorm:"not null"`
+}
+
+func (DailyReferrerStatModel) TableName() string {
+	return "daily_referrer_stats"
+}
+
+// DailyOrganizationViewStatModel は閲覧された本人ごと・日ごとの企業の採用担当からの閲覧数です
+type DailyOrganizationViewStatModel struct {
+	ID             uint   `gorm:"primaryKey"`
+	OwnerID        uint   `gorm:"not null;uniqueIndex:idx_daily_organization_view_stats_owner_day_org"`
+	Day            string `gorm:"size:10;not null;uniqueIndex:idx_daily_organization_view_stats_owner_day_org;index"`
+	OrganizationID uint   `gorm:"not null;uniqueIndex:idx_daily_organization_view_stats_owner_day_org"`
+	Views          int    `gorm:"not null"`
+}
+
+func (DailyOrganizationViewStatModel) TableName() string {
+	return "daily_organization_view_stats"
+}
+
+// RollupModel は集計済みの日です
+type RollupModel struct {
+	Day        string `gorm:"primaryKey;size:10"`
+	RolledUpAt time.Time
+}
+
+func (RollupModel) TableName() string {
+	return "analytics_rollups"
+}
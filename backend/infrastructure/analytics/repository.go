@@ -0,0 +1,176 @@
+package analytics
+
+import (
+	domainAnalytics "backend/domain/analytics"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// analyticsRepo は domain/analytics.Repository の具象実装です
+type analyticsRepo struct {
+	db *gorm.DB
+}
+
+// NewAnalyticsRepo は GORM を使った閲覧の集計のリポジトリを生成します
+func NewAnalyticsRepo(db *gorm.DB) domainAnalytics.Repository {
+	return &analyticsRepo{db: db}
+}
+
+func (r *analyticsRepo) RecordView(v *domainAnalytics.View) (bool, error) {
+	vm := ViewEventModel{
+		CreatedAt:      v.CreatedAt,
+		TargetType:     string(v.TargetType),
+		TargetID:       v.TargetID,
+		VisitorKey:     v.VisitorKey,
+		Day:            v.Day,
+		OwnerID:        v.OwnerID,
+		ViewerID:       v.ViewerID,
+		OrganizationID: v.OrganizationID,
+		ReferrerHost:   v.ReferrerHost,
+	}
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&vm)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	v.ID = vm.ID
+	return result.RowsAffected > 0, nil
+}
+
+func (r *analyticsRepo) RolledUpThrough() (string, error) {
+	var day *string
+	if err := r.db.Model(&RollupModel{}).Select("MAX(day)").Scan(&day).Error; err != nil {
+		return "", err
+	}
+	if day == nil {
+		return "", nil
+	}
+	return *day, nil
+}
+
+func (r *analyticsRepo) FirstViewDay() (string, error) {
+	var day *string
+	if err := r.db.Model(&ViewEventModel{}).Select("MIN(day)").Scan(&day).Error; err != nil {
+		return "", err
+	}
+	if day == nil {
+		return "", nil
+	}
+	return *day, nil
+}
+
+// Rollup は day の集計を削除してから生データを集計し直すので、同じ日を何度実行してもよい
+func (r *analyticsRepo) Rollup(day string, now time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		statements := []struct {
+			model  interface{}
+			insert string
+		}{
+			{&DailyViewStatModel{}, `INSERT INTO daily_view_stats (owner_id, day, target_type, target_id, views)
+				SELECT owner_id, day, target_type, target_id, COUNT(*) FROM view_events
+				WHERE day = ? GROUP BY owner_id, day, target_type, target_id`},
+			{&DailyReferrerStatModel{}, `INSERT INTO daily_referrer_stats (owner_id, day, referrer_host, views)
+				SELECT owner_id, day, referrer_host, COUNT(*) FROM view_events
+				WHERE day = ? GROUP BY owner_id, day, referrer_host`},
+			{&DailyOrganizationViewStatModel{}, `INSERT INTO daily_organization_view_stats (owner_id, day, organization_id, views)
+				SELECT owner_id, day, organization_id, COUNT(*) FROM view_events
+				WHERE day = ? AND organization_id IS NOT NULL GROUP BY owner_id, day, organization_id`},
+		}
+		for _, s := range statements {
+			if err := tx.Where("day = ?", day).Delete(s.model).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec(s.insert, day).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "day"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rolled_up_at"}),
+		}).Create(&RollupModel{Day: day, RolledUpAt: now}).Error
+	})
+}
+
+func (r *analyticsRepo) DeleteViewsBefore(day string) error {
+	return r.db.Where("day < ?", day).Delete(&ViewEventModel{}).Error
+}
+
+func (r *analyticsRepo) GetDailyViews(ownerID uint, from, to string) ([]domainAnalytics.DailyViews, error) {
+	var rows []struct {
+		Day          string
+		PostViews    int
+		ProfileViews int
+	}
+	if err := r.db.Model(&DailyViewStatModel{}).
+		Select("day, "+
+			"SUM(CASE WHEN target_type = ? THEN views ELSE 0 END) AS post_views, "+
+			"SUM(CASE WHEN target_type = ? THEN views ELSE 0 END) AS profile_views",
+			domainAnalytics.TargetPost, domainAnalytics.TargetProfile).
+		Where("owner_id = ? AND day BETWEEN ? AND ?", ownerID, from, to).
+		Group("day").
+		Order("day").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	views := make([]domainAnalytics.DailyViews, 0, len(rows))
+	for _, row := range rows {
+		views = append(views, domainAnalytics.DailyViews{Date: row.Day, PostViews: row.PostViews, ProfileViews: row.ProfileViews})
+	}
+	return views, nil
+}
+
+func (r *analyticsRepo) GetTopTargets(ownerID uint, targetType domainAnalytics.TargetType, from, to string, limit int) ([]domainAnalytics.TargetViews, error) {
+	var rows []domainAnalytics.TargetViews
+	if err := r.db.Model(&DailyViewStatModel{}).
+		Select("target_id, SUM(views) AS views").
+		Where("owner_id = ? AND target_type = ? AND day BETWEEN ? AND ?", ownerID, targetType, from, to).
+		Group("target_id").
+		Order("views DESC, target_id ASC").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *analyticsRepo) GetReferrers(ownerID uint, from, to string, limit int) ([]domainAnalytics.ReferrerViews, error) {
+	var rows []struct {
+		ReferrerHost string
+		Views        int
+	}
+	if err := r.db.Model(&DailyReferrerStatModel{}).
+		Select("referrer_host, SUM(views) AS views").
+		Where("owner_id = ? AND day BETWEEN ? AND ?", ownerID, from, to).
+		Group("referrer_host").
+		Order("views DESC, referrer_host ASC").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	referrers := make([]domainAnalytics.ReferrerViews, 0, len(rows))
+	for _, row := range rows {
+		referrers = append(referrers, domainAnalytics.ReferrerViews{Host: row.ReferrerHost, Views: row.Views})
+	}
+	return referrers, nil
+}
+
+func (r *analyticsRepo) GetOrganizationViews(ownerID uint, from, to string) ([]domainAnalytics.OrganizationViews, error) {
+	var rows []struct {
+		OrganizationID uint
+		Views          int
+	}
+	if err := r.db.Model(&DailyOrganizationViewStatModel{}).
+		Select("organization_id, SUM(views) AS views").
+		Where("owner_id = ? AND day BETWEEN ? AND ?", ownerID, from, to).
+		Group("organization_id").
+		Order("views DESC, organization_id ASC").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	orgs := make([]domainAnalytics.OrganizationViews, 0, len(rows))
+	for _, row := range rows {
+		orgs = append(orgs, domainAnalytics.OrganizationViews{OrganizationID: row.OrganizationID, Views: row.Views})
+	}
+	return orgs, nil
+}
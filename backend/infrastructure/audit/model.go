@@ -0,0 +1,21 @@
+package audit
+
+import "time"
+
+// EntryModel は GORM タグ付きの永続化用監査ログモデルです
+type EntryModel struct {
+	ID        uint      `gorm:"primaryKey"`
+	CreatedAt time.Time `gorm:"index"`
+
+	ActorID    uint   `gorm:"index"`
+	Action     string `gorm:"size:64;not null;index"`
+	TargetType string `gorm:"size:32"`
+	TargetID   uint
+	Detail     string `gorm:"type:text"`
+	IP         string `gorm:"size:64"`
+	UserAgent  string `gorm:"size:512"`
+}
+
+func (EntryModel) TableName() string {
+	return "audit_logs"
+}
@@ -0,0 +1,97 @@
+package audit
+
+import (
+	domainAudit "backend/domain/audit"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// auditRepo は domain/audit.Repository の具象実装です
+type auditRepo struct {
+	db *gorm.DB
+}
+
+// NewAuditRepo は GORM を使った監査ログリポジトリを生成します
+func NewAuditRepo(db *gorm.DB) domainAudit.Repository {
+	return &auditRepo{db: db}
+}
+
+func (r *auditRepo) Append(e *domainAudit.Entry) error {
+	pm := EntryModel{
+		CreatedAt:  e.CreatedAt,
+		ActorID:    e.ActorID,
+		Action:     string(e.Action),
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		Detail:     e.Detail,
+		IP:         e.IP,
+		UserAgent:  e.UserAgent,
+	}
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	e.ID = pm.ID
+	return nil
+}
+
+func (r *auditRepo) Find(f domainAudit.Filter) ([]*domainAudit.Entry, int64, error) {
+	q := r.db.Model(&EntryModel{})
+	if f.ActorID != 0 {
+		q = q.Where("actor_id = ?", f.ActorID)
+	}
+	if f.TargetType != "" {
+		q = q.Where("target_type = ?", f.TargetType)
+	}
+	if f.TargetID != 0 {
+		q = q.Where("target_id = ?", f.TargetID)
+	}
+	if len(f.Actions) > 0 {
+		actions := make([]string, len(f.Actions))
+		for i, a := range f.Actions {
+			actions[i] = string(a)
+		}
+		q = q.Where("action IN ?", actions)
+	}
+	if !f.From.IsZero() {
+		q = q.Where("created_at >= ?", f.From)
+	}
+	if !f.To.IsZero() {
+		q = q.Where("created_at < ?", f.To)
+	}
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var pms []EntryModel
+	if err := q.Order("created_at DESC, id DESC").Limit(f.Limit).Offset(f.Offset).Find(&pms).Error; err != nil {
+		return nil, 0, err
+	}
+	entries := make([]*domainAudit.Entry, 0, len(pms))
+	for i := range pms {
+		entries = append(entries, toDomain(&pms[i]))
+	}
+	return entries, total, nil
+}
+
+func (r *auditRepo) DeleteBefore(cutoff time.Time) (int64, error) {
+	result := r.db.Where("created_at < ?", cutoff).Delete(&EntryModel{})
+	return result.RowsAffected, result.Error
+}
+
+// toDomain は EntryModel → domain.Entry へのマッピング関数です
+func toDomain(pm *EntryModel) *domainAudit.Entry {
+	return &domainAudit.Entry{
+		ID:         pm.ID,
+		ActorID:    pm.ActorID,
+		Action:     domainAudit.Action(pm.Action),
+		TargetType: pm.TargetType,
+		TargetID:   pm.TargetID,
+		Detail:     pm.Detail,
+		IP:         pm.IP,
+		UserAgent:  pm.UserAgent,
+		CreatedAt:  pm.CreatedAt,
+	}
+}
@@ -0,0 +1,47 @@
+package career
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// EducationModel は学歴の永続化用モデルです
+type EducationModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	UserID       uint   `gorm:"not null;index"`
+	Degree       string `gorm:"size:32;not null;default:''"`
+	SchoolName   string `gorm:"size:255;not null"`
+	Department   string `gorm:"size:255"`
+	Laboratory   string `gorm:"size:255"`
+	StartDate    *time.Time
+	EndDate      *time.Time
+	DisplayOrder int `gorm:"not null;default:0"`
+}
+
+func (EducationModel) TableName() string {
+	return "educations"
+}
+
+// ExperienceModel は職歴の永続化用モデルです
+type ExperienceModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	UserID      uint      `gorm:"not null;index"`
+	Type        string    `gorm:"size:32;not null"`
+	CompanyName string    `gorm:"size:255;not null"`
+	Role        string    `gorm:"size:255;not null"`
+	StartDate   time.Time `gorm:"not null"`
+	EndDate     *time.Time
+	Description string         `gorm:"type:text"`
+	Skills      pq.StringArray `gorm:"type:text[]"`
+}
+
+func (ExperienceModel) TableName() string {
+	return "experiences"
+}
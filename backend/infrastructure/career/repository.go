@@ -0,0 +1,181 @@
+package career
+
+import (
+	domainCareer "backend/domain/career"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// careerRepo は domain/career.Repository の具象実装です
+type careerRepo struct {
+	db *gorm.DB
+}
+
+// NewCareerRepo は GORM を使った学歴・職歴のリポジトリを生成します
+func NewCareerRepo(db *gorm.DB) domainCareer.Repository {
+	return &careerRepo{db: db}
+}
+
+func (r *careerRepo) GetEducationsByUserID(userID uint) ([]*domainCareer.Education, error) {
+	var pms []EducationModel
+	if err := r.db.
+		Where("user_id = ?", userID).
+		Order("display_order ASC, id ASC").
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	educations := make([]*domainCareer.Education, 0, len(pms))
+	for i := range pms {
+		educations = append(educations, toEducationDomain(&pms[i]))
+	}
+	return educations, nil
+}
+
+func (r *careerRepo) GetEducationByID(id uint) (*domainCareer.Education, error) {
+	var pm EducationModel
+	if err := r.db.First(&pm, id).Error; err != nil {
+		return nil, err
+	}
+	return toEducationDomain(&pm), nil
+}
+
+func (r *careerRepo) CreateEducation(e *domainCareer.Education) error {
+	pm := toEducationPersistence(e)
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	e.ID = pm.ID
+	e.CreatedAt = pm.CreatedAt
+	e.UpdatedAt = pm.UpdatedAt
+	return nil
+}
+
+func (r *careerRepo) UpdateEducation(e *domainCareer.Education) error {
+	pm := toEducationPersistence(e)
+	return r.db.Save(&pm).Error
+}
+
+func (r *careerRepo) DeleteEducation(id uint) error {
+	return r.db.Delete(&EducationModel{}, id).Error
+}
+
+func (r *careerRepo) ReorderEducations(userID uint, ids []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			if err := tx.Model(&EducationModel{}).
+				Where("id = ? AND user_id = ?", id, userID).
+				Update("display_order", i).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *careerRepo) GetExperiencesByUserID(userID uint) ([]*domainCareer.Experience, error) {
+	var pms []ExperienceModel
+	if err := r.db.
+		Where("user_id = ?", userID).
+		Order("start_date DESC, id DESC").
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	experiences := make([]*domainCareer.Experience, 0, len(pms))
+	for i := range pms {
+		experiences = append(experiences, toExperienceDomain(&pms[i]))
+	}
+	return experiences, nil
+}
+
+func (r *careerRepo) GetExperienceByID(id uint) (*domainCareer.Experience, error) {
+	var pm ExperienceModel
+	if err := r.db.First(&pm, id).Error; err != nil {
+		return nil, err
+	}
+	return toExperienceDomain(&pm), nil
+}
+
+func (r *careerRepo) CreateExperience(e *domainCareer.Experience) error {
+	pm := toExperiencePersistence(e)
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	e.ID = pm.ID
+	e.CreatedAt = pm.CreatedAt
+	e.UpdatedAt = pm.UpdatedAt
+	return nil
+}
+
+func (r *careerRepo) UpdateExperience(e *domainCareer.Experience) error {
+	pm := toExperiencePersistence(e)
+	return r.db.Save(&pm).Error
+}
+
+func (r *careerRepo) DeleteExperience(id uint) error {
+	return r.db.Delete(&ExperienceModel{}, id).Error
+}
+
+func toEducationDomain(pm *EducationModel) *domainCareer.Education {
+	return &domainCareer.Education{
+		ID:           pm.ID,
+		UserID:       pm.UserID,
+		Degree:       domainCareer.Degree(pm.Degree),
+		SchoolName:   pm.SchoolName,
+		Department:   pm.Department,
+		Laboratory:   pm.Laboratory,
+		StartDate:    pm.StartDate,
+		EndDate:      pm.EndDate,
+		DisplayOrder: pm.DisplayOrder,
+		CreatedAt:    pm.CreatedAt,
+		UpdatedAt:    pm.UpdatedAt,
+	}
+}
+
+func toEducationPersistence(e *domainCareer.Education) EducationModel {
+	return EducationModel{
+		ID:           e.ID,
+		CreatedAt:    e.CreatedAt,
+		UpdatedAt:    e.UpdatedAt,
+		UserID:       e.UserID,
+		Degree:       string(e.Degree),
+		SchoolName:   e.SchoolName,
+		Department:   e.Department,
+		Laboratory:   e.Laboratory,
+		StartDate:    e.StartDate,
+		EndDate:      e.EndDate,
+		DisplayOrder: e.DisplayOrder,
+	}
+}
+
+func toExperienceDomain(pm *ExperienceModel) *domainCareer.Experience {
+	return &domainCareer.Experience{
+		ID:          pm.ID,
+		UserID:      pm.UserID,
+		Type:        domainCareer.ExperienceType(pm.Type),
+		CompanyName: pm.CompanyName,
+		Role:        pm.Role,
+		StartDate:   pm.StartDate,
+		EndDate:     pm.EndDate,
+		Description: pm.Description,
+		Skills:      []string(pm.Skills),
+		CreatedAt:   pm.CreatedAt,
+		UpdatedAt:   pm.UpdatedAt,
+	}
+}
+
+func toExperiencePersistence(e *domainCareer.Experience) ExperienceModel {
+	return ExperienceModel{
+		ID:          e.ID,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+		UserID:      e.UserID,
+		Type:        string(e.Type),
+		CompanyName: e.CompanyName,
+		Role:        e.Role,
+		StartDate:   e.StartDate,
+		EndDate:     e.EndDate,
+		Description: e.Description,
+		Skills:      pq.StringArray(e.Skills),
+	}
+}
@@ -0,0 +1,36 @@
+package collection
+
+import "time"
+
+// CollectionModel は投稿のコレクションの永続化用モデルです
+type CollectionModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	UserID        uint   `gorm:"not null;index"`
+	Title         string `gorm:"size:255;not null"`
+	Description   string `gorm:"type:text"`
+	CoverPostID   *uint
+	DisplayOrder  int        `gorm:"not null;default:0"`
+	FeaturedAt    *time.Time `gorm:"index"`
+	FeaturedOrder int        `gorm:"not null;default:0"`
+
+	Posts []CollectionPostModel `gorm:"foreignKey:CollectionID"`
+}
+
+func (CollectionModel) TableName() string {
+	return "collections"
+}
+
+// CollectionPostModel はコレクションに入っている投稿と、その表示順です
+type CollectionPostModel struct {
+	ID           uint `gorm:"primaryKey"`
+	CollectionID uint `gorm:"not null;uniqueIndex:idx_collection_posts_collection_post"`
+	PostID       uint `gorm:"not null;uniqueIndex:idx_collection_posts_collection_post;index"`
+	DisplayOrder int  `gorm:"not null;default:0"`
+}
+
+func (CollectionPostModel) TableName() string {
+	return "collection_posts"
+}
@@ -0,0 +1,184 @@
+package collection
+
+import (
+	domainCollection "backend/domain/collection"
+
+	"gorm.io/gorm"
+)
+
+// collectionRepo は domain/collection.Repository の具象実装です
+type collectionRepo struct {
+	db *gorm.DB
+}
+
+// NewCollectionRepo は GORM を使ったコレクションのリポジトリを生成します
+func NewCollectionRepo(db *gorm.DB) domainCollection.Repository {
+	return &collectionRepo{db: db}
+}
+
+func (r *collectionRepo) Create(c *domainCollection.Collection) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// 新しいコレクションはプロフィールの末尾に並べる
+		var count int64
+		if err := tx.Model(&CollectionModel{}).Where("user_id = ?", c.UserID).Count(&count).Error; err != nil {
+			return err
+		}
+		c.DisplayOrder = int(count)
+		cm := toPersistence(c)
+		if err := tx.Omit("Posts").Create(&cm).Error; err != nil {
+			return err
+		}
+		c.ID = cm.ID
+		c.CreatedAt = cm.CreatedAt
+		c.UpdatedAt = cm.UpdatedAt
+		return replacePosts(tx, c)
+	})
+}
+
+func (r *collectionRepo) GetByID(id uint) (*domainCollection.Collection, error) {
+	var cm CollectionModel
+	if err := r.db.Scopes(preloadPosts).First(&cm, id).Error; err != nil {
+		return nil, err
+	}
+	return toDomain(&cm), nil
+}
+
+func (r *collectionRepo) GetByUserID(userID uint) ([]*domainCollection.Collection, error) {
+	var cms []CollectionModel
+	if err := r.db.
+		Scopes(preloadPosts).
+		Where("user_id = ?", userID).
+		Order("display_order ASC, id ASC").
+		Find(&cms).Error; err != nil {
+		return nil, err
+	}
+	return toDomainList(cms), nil
+}
+
+func (r *collectionRepo) Update(c *domainCollection.Collection) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&CollectionModel{ID: c.ID}).
+			Updates(map[string]interface{}{
+				"title":          c.Title,
+				"description":    c.Description,
+				"cover_post_id":  c.CoverPostID,
+				"featured_at":    c.FeaturedAt,
+				"featured_order": c.FeaturedOrder,
+				"updated_at":     c.UpdatedAt,
+			}).Error; err != nil {
+			return err
+		}
+		return replacePosts(tx, c)
+	})
+}
+
+func (r *collectionRepo) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ?", id).Delete(&CollectionPostModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&CollectionModel{}, id).Error
+	})
+}
+
+func (r *collectionRepo) Reorder(userID uint, ids []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			if err := tx.Model(&CollectionModel{}).
+				Where("id = ? AND user_id = ?", id, userID).
+				Update("display_order", i).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *collectionRepo) GetFeatured() ([]*domainCollection.Collection, error) {
+	var cms []CollectionModel
+	if err := r.db.
+		Scopes(preloadPosts).
+		Where("featured_at IS NOT NULL").
+		Order("featured_order ASC, featured_at ASC").
+		Find(&cms).Error; err != nil {
+		return nil, err
+	}
+	return toDomainList(cms), nil
+}
+
+func (r *collectionRepo) ReorderFeatured(ids []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			if err := tx.Model(&CollectionModel{}).
+				Where("id = ? AND featured_at IS NOT NULL", id).
+				Update("featured_order", i).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// replacePosts はコレクションの投稿を PostIDs の並びで置き換えます
+func replacePosts(tx *gorm.DB, c *domainCollection.Collection) error {
+	if err := tx.Where("collection_id = ?", c.ID).Delete(&CollectionPostModel{}).Error; err != nil {
+		return err
+	}
+	if len(c.PostIDs) == 0 {
+		return nil
+	}
+	pms := make([]CollectionPostModel, 0, len(c.PostIDs))
+	for i, postID := range c.PostIDs {
+		pms = append(pms, CollectionPostModel{CollectionID: c.ID, PostID: postID, DisplayOrder: i})
+	}
+	return tx.Create(&pms).Error
+}
+
+func preloadPosts(db *gorm.DB) *gorm.DB {
+	return db.Preload("Posts", func(db *gorm.DB) *gorm.DB {
+		return db.Order("display_order ASC, id ASC")
+	})
+}
+
+func toDomainList(cms []CollectionModel) []*domainCollection.Collection {
+	collections := make([]*domainCollection.Collection, 0, len(cms))
+	for i := range cms {
+		collections = append(collections, toDomain(&cms[i]))
+	}
+	return collections
+}
+
+func toDomain(cm *CollectionModel) *domainCollection.Collection {
+	postIDs := make([]uint, 0, len(cm.Posts))
+	for _, pm := range cm.Posts {
+		postIDs = append(postIDs, pm.PostID)
+	}
+	return &domainCollection.Collection{
+		ID:            cm.ID,
+		UserID:        cm.UserID,
+		Title:         cm.Title,
+		Description:   cm.Description,
+		CoverPostID:   cm.CoverPostID,
+		DisplayOrder:  cm.DisplayOrder,
+		PostIDs:       postIDs,
+		FeaturedAt:    cm.FeaturedAt,
+		FeaturedOrder: cm.FeaturedOrder,
+		CreatedAt:     cm.CreatedAt,
+		UpdatedAt:     cm.UpdatedAt,
+	}
+}
+
+func toPersistence(c *domainCollection.Collection) CollectionModel {
+	return CollectionModel{
+		ID:            c.ID,
+		CreatedAt:     c.CreatedAt,
+		UpdatedAt:     c.UpdatedAt,
+		UserID:        c.UserID,
+		Title:         c.Title,
+		Description:   c.Description,
+		CoverPostID:   c.CoverPostID,
+		DisplayOrder:  c.DisplayOrder,
+		FeaturedAt:    c.FeaturedAt,
+		FeaturedOrder: c.FeaturedOrder,
+	}
+}
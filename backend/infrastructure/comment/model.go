@@ -0,0 +1,26 @@
+package comment
+
+import (
+	"time"
+
+	userInfra "backend/infrastructure/user"
+
+	"gorm.io/gorm"
+)
+
+// CommentModel は GORM タグ付きの永続化用コメントモデルです
+type CommentModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	PostID uint                `gorm:"not null;index"`
+	UserID uint                `gorm:"not null;index"`
+	User   userInfra.UserModel `gorm:"foreignKey:UserID;references:ID"`
+	Body   string              `gorm:"type:text;not null"`
+}
+
+func (CommentModel) TableName() string {
+	return "comments"
+}
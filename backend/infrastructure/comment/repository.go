@@ -0,0 +1,80 @@
+package comment
+
+import (
+	domainComment "backend/domain/comment"
+	domainUser "backend/domain/user"
+
+	"gorm.io/gorm"
+)
+
+// commentRepo は domain/comment.Repository の具象実装です
+type commentRepo struct {
+	db *gorm.DB
+}
+
+// NewCommentRepo は GORM を使ったコメントリポジトリを生成します
+func NewCommentRepo(db *gorm.DB) domainComment.Repository {
+	return &commentRepo{db: db}
+}
+
+func (r *commentRepo) CreateComment(c *domainComment.Comment) error {
+	pm := CommentModel{
+		PostID: c.PostID,
+		UserID: c.UserID,
+		Body:   c.Body,
+	}
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	c.ID = pm.ID
+	c.CreatedAt = pm.CreatedAt
+	c.UpdatedAt = pm.UpdatedAt
+	return nil
+}
+
+func (r *commentRepo) GetCommentByID(id uint) (*domainComment.Comment, error) {
+	var pm CommentModel
+	if err := r.db.Preload("User").First(&pm, id).Error; err != nil {
+		return nil, err
+	}
+	return toDomain(&pm), nil
+}
+
+// GetCommentsByPostID は投稿のコメントを古い順に返します
+func (r *commentRepo) GetCommentsByPostID(postID uint) ([]*domainComment.Comment, error) {
+	var pms []CommentModel
+	if err := r.db.
+		Preload("User").
+		Where("post_id = ?", postID).
+		Order("created_at ASC").
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	comments := make([]*domainComment.Comment, 0, len(pms))
+	for i := range pms {
+		comments = append(comments, toDomain(&pms[i]))
+	}
+	return comments, nil
+}
+
+// toDomain は CommentModel → domain.Comment へのマッピング関数です
+// コメント一覧に載せるため、ユーザーは公開プロフィール項目だけを詰めます
+func toDomain(pm *CommentModel) *domainComment.Comment {
+	return &domainComment.Comment{
+		ID:     pm.ID,
+		PostID: pm.PostID,
+		UserID: pm.UserID,
+		User: domainUser.UserModel{
+			ID:              pm.User.ID,
+			FirstName:       pm.User.FirstName,
+			LastName:        pm.User.LastName,
+			FirstNameKana:   pm.User.FirstNameKana,
+			LastNameKana:    pm.User.LastNameKana,
+			ProfileImageURL: pm.User.ProfileImageURL,
+			SchoolName:      pm.User.SchoolName,
+		},
+		Body:      pm.Body,
+		CreatedAt: pm.CreatedAt,
+		UpdatedAt: pm.UpdatedAt,
+	}
+}
@@ -0,0 +1,51 @@
+// Package dbutil は PostgreSQL と SQLite の差を吸収するクエリ補助関数をまとめます
+package dbutil
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// IsPostgres は接続先が PostgreSQL かどうかを返します
+func IsPostgres(db *gorm.DB) bool {
+	return db.Dialector.Name() == "postgres"
+}
+
+// ArrayContains は text[] カラムに value が含まれる条件を付与します
+func ArrayContains(db *gorm.DB, column, value string) *gorm.DB {
+	return db.Where(ArrayContainsExpr(db, column), ArrayContainsArg(db, value))
+}
+
+// ArrayContainsExpr はサブクエリなどに埋め込むための「text[] に含まれる」条件式を返します
+// プレースホルダには ArrayContainsArg の値を渡してください
+func ArrayContainsExpr(db *gorm.DB, column string) string {
+	if IsPostgres(db) {
+		// = ANY() では GIN インデックスが使われないため @> で書く
+		return fmt.Sprintf("%s @> ARRAY[?]::text[]", column)
+	}
+	// SQLite では pq.StringArray が "{a,b}" 形式の文字列で保存されるので部分一致で代用する
+	return fmt.Sprintf(`%s LIKE ? ESCAPE '\'`, column)
+}
+
+// ArrayContainsArg は ArrayContainsExpr のプレースホルダに渡す値を返します
+func ArrayContainsArg(db *gorm.DB, value string) string {
+	if IsPostgres(db) {
+		return value
+	}
+	return "%" + escapeLike(value) + "%"
+}
+
+// ContainsFold は大文字小文字を区別しない部分一致の条件を付与します
+func ContainsFold(db *gorm.DB, column, value string) *gorm.DB {
+	pattern := "%" + escapeLike(value) + "%"
+	if IsPostgres(db) {
+		return db.Where(fmt.Sprintf(`%s ILIKE ? ESCAPE '\'`, column), pattern)
+	}
+	return db.Where(fmt.Sprintf(`LOWER(%s) LIKE LOWER(?) ESCAPE '\'`, column), pattern)
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,17 @@
+package engagement
+
+import "time"
+
+// ReactionModel は投稿へのいいね・ブックマークの永続化用モデルです
+type ReactionModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	PostID uint   `gorm:"not null;uniqueIndex:idx_post_reactions_post_user_kind"`
+	UserID uint   `gorm:"not null;uniqueIndex:idx_post_reactions_post_user_kind;index"`
+	Kind   string `gorm:"size:16;not null;uniqueIndex:idx_post_reactions_post_user_kind"`
+}
+
+func (ReactionModel) TableName() string {
+	return "post_reactions"
+}
@@ -0,0 +1,70 @@
+package engagement
+
+import (
+	domainEngagement "backend/domain/engagement"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// reactionRepo は domain/engagement.Repository の具象実装です
+type reactionRepo struct {
+	db *gorm.DB
+}
+
+// NewReactionRepo は GORM を使ったいいね・ブックマークのリポジトリを生成します
+func NewReactionRepo(db *gorm.DB) domainEngagement.Repository {
+	return &reactionRepo{db: db}
+}
+
+func (r *reactionRepo) Add(reaction *domainEngagement.Reaction) (bool, error) {
+	rm := ReactionModel{
+		CreatedAt: reaction.CreatedAt,
+		PostID:    reaction.PostID,
+		UserID:    reaction.UserID,
+		Kind:      string(reaction.Kind),
+	}
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&rm)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *reactionRepo) Remove(postID, userID uint, kind domainEngagement.Kind) (bool, error) {
+	result := r.db.
+		Where("post_id = ? AND user_id = ? AND kind = ?", postID, userID, kind).
+		Delete(&ReactionModel{})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *reactionRepo) GetPostIDs(userID uint, kind domainEngagement.Kind) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&ReactionModel{}).
+		Where("user_id = ? AND kind = ?", userID, kind).
+		Order("created_at DESC, id DESC").
+		Pluck("post_id", &ids).Error
+	return ids, err
+}
+
+func (r *reactionRepo) GetSummary(postID, viewerID uint) (*domainEngagement.Summary, error) {
+	var rows []struct {
+		Kind  string
+		Count int
+		Mine  int
+	}
+	if err := r.db.Model(&ReactionModel{}).
+		Select("kind, COUNT(*) AS count, SUM(CASE WHEN user_id = ? THEN 1 ELSE 0 END) AS mine", viewerID).
+		Where("post_id = ?", postID).
+		Group("kind").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	summary := &domainEngagement.Summary{PostID: postID}
+	for _, row := range rows {
+		switch domainEngagement.Kind(row.Kind) {
+		case domainEngagement.KindLike:
+			summary.Likes, summary.Liked = row.Count, row.Mine > 0
+		case domainEngagement.KindBookmark:
+			summary.Bookmarks, summary.Bookmarked = row.Count, row.Mine > 0
+		}
+	}
+	return summary, nil
+}
@@ -0,0 +1,19 @@
+package externallink
+
+import "time"
+
+// LinkModel はプロフィールの外部リンクの永続化用モデルです
+type LinkModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	UserID       uint   `gorm:"not null;index"`
+	Type         string `gorm:"size:32;not null"`
+	URL          string `gorm:"size:2048;not null"`
+	DisplayOrder int    `gorm:"not null;default:0"`
+}
+
+func (LinkModel) TableName() string {
+	return "external_links"
+}
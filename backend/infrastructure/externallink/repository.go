@@ -0,0 +1,74 @@
+package externallink
+
+import (
+	domainExternalLink "backend/domain/externallink"
+
+	"gorm.io/gorm"
+)
+
+// linkRepo は domain/externallink.Repository の具象実装です
+type linkRepo struct {
+	db *gorm.DB
+}
+
+// NewLinkRepo は GORM を使った外部リンクのリポジトリを生成します
+func NewLinkRepo(db *gorm.DB) domainExternalLink.Repository {
+	return &linkRepo{db: db}
+}
+
+func (r *linkRepo) GetByUserID(userID uint) ([]*domainExternalLink.Link, error) {
+	var pms []LinkModel
+	if err := r.db.
+		Where("user_id = ?", userID).
+		Order("display_order ASC, id ASC").
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	links := make([]*domainExternalLink.Link, 0, len(pms))
+	for i := range pms {
+		links = append(links, toDomain(&pms[i]))
+	}
+	return links, nil
+}
+
+func (r *linkRepo) ReplaceForUser(userID uint, links []*domainExternalLink.Link) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&LinkModel{}).Error; err != nil {
+			return err
+		}
+		for i, l := range links {
+			l.DisplayOrder = i
+			pm := toPersistence(l)
+			pm.ID = 0
+			if err := tx.Create(&pm).Error; err != nil {
+				return err
+			}
+			l.ID = pm.ID
+		}
+		return nil
+	})
+}
+
+func toDomain(pm *LinkModel) *domainExternalLink.Link {
+	return &domainExternalLink.Link{
+		ID:           pm.ID,
+		UserID:       pm.UserID,
+		Type:         domainExternalLink.Type(pm.Type),
+		URL:          pm.URL,
+		DisplayOrder: pm.DisplayOrder,
+		CreatedAt:    pm.CreatedAt,
+		UpdatedAt:    pm.UpdatedAt,
+	}
+}
+
+func toPersistence(l *domainExternalLink.Link) LinkModel {
+	return LinkModel{
+		ID:           l.ID,
+		CreatedAt:    l.CreatedAt,
+		UpdatedAt:    l.UpdatedAt,
+		UserID:       l.UserID,
+		Type:         string(l.Type),
+		URL:          l.URL,
+		DisplayOrder: l.DisplayOrder,
+	}
+}
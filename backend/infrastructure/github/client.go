@@ -0,0 +1,124 @@
+package github
+
+import (
+	domainGitHub "backend/domain/github"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultBaseURL は GitHub REST API の URL です。GITHUB_API_BASE_URL で差し替えられます
+	DefaultBaseURL = "https://api.github.com"
+
+	// 言語を取得するリポジトリの上限（1 リポジトリごとに API を 1 回呼ぶため）
+	maxLanguageRequests = 30
+	perPage             = 100
+)
+
+// client は GitHub REST API を使った domain/github.Client の実装です
+type client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient は baseURL の GitHub API に接続するクライアントを生成します
+// token は空でもよい（未認証の場合は API の回数制限が厳しくなる）
+func NewClient(baseURL, token string) domainGitHub.Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type repoResponse struct {
+	Name            string    `json:"name"`
+	FullName        string    `json:"full_name"`
+	Description     string    `json:"description"`
+	HTMLURL         string    `json:"html_url"`
+	Homepage        string    `json:"homepage"`
+	Fork            bool      `json:"fork"`
+	StargazersCount int       `json:"stargazers_count"`
+	Topics          []string  `json:"topics"`
+	PushedAt        time.Time `json:"pushed_at"`
+}
+
+func (c *client) ListRepositories(username string) ([]*domainGitHub.Repo, error) {
+	var res []repoResponse
+	path := fmt.Sprintf("/users/%s/repos?type=owner&sort=pushed&per_page=%d", url.PathEscape(username), perPage)
+	if err := c.get(path, &res); err != nil {
+		return nil, err
+	}
+
+	repos := make([]*domainGitHub.Repo, 0, len(res))
+	requested := 0
+	for _, r := range res {
+		repo := &domainGitHub.Repo{
+			Name:        r.Name,
+			FullName:    r.FullName,
+			Description: r.Description,
+			HTMLURL:     r.HTMLURL,
+			Homepage:    r.Homepage,
+			Fork:        r.Fork,
+			Stars:       r.StargazersCount,
+			Topics:      r.Topics,
+			PushedAt:    r.PushedAt,
+			Languages:   map[string]int{},
+		}
+		// フォークは本人の成果ではないので言語を取得しない
+		if !r.Fork && requested < maxLanguageRequests {
+			requested++
+			if err := c.get("/repos/"+escapeFullName(r.FullName)+"/languages", &repo.Languages); err != nil {
+				return nil, err
+			}
+		}
+		repos = append(repos, repo)
+	}
+	return repos, nil
+}
+
+func (c *client) get(path string, out interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("github api request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return domainGitHub.ErrUserNotFound
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") == "0":
+		return domainGitHub.ErrRateLimited
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("github api returned status %d for %s", resp.StatusCode, path)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// escapeFullName は "owner/repo" の各部分をパス用にエスケープします
+func escapeFullName(fullName string) string {
+	parts := strings.SplitN(fullName, "/", 2)
+	for i := range parts {
+		parts[i] = url.PathEscape(parts[i])
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,68 @@
+package github
+
+import (
+	domainGitHub "backend/domain/github"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newStubServer は GitHub API の代わりに固定のレスポンスを返すサーバーです
+func newStubServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users/octocat/repos", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			t.Errorf("Authorization header = %q", r.Header.Get("Authorization"))
+		}
+		w.Write([]byte(`[
+			{"name": "api", "full_name": "octocat/api", "description": "REST API", "html_url": "https://github.com/octocat/api", "fork": false, "stargazers_count": 12, "topics": ["go"]},
+			{"name": "linux", "full_name": "octocat/linux", "html_url": "https://github.com/octocat/linux", "fork": true, "stargazers_count": 0}
+		]`))
+	})
+	mux.HandleFunc("/repos/octocat/api/languages", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"Go": 12000, "Makefile": 300}`))
+	})
+	mux.HandleFunc("/repos/octocat/linux/languages", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("languages should not be requested for forks")
+	})
+	mux.HandleFunc("/users/limited/repos", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.WriteHeader(http.StatusForbidden)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestClient_ListRepositories(t *testing.T) {
+	server := newStubServer(t)
+	defer server.Close()
+
+	repos, err := NewClient(server.URL, "test-token").ListRepositories("octocat")
+	if err != nil {
+		t.Fatalf("ListRepositories failed: %v", err)
+	}
+	if len(repos) != 2 {
+		t.Fatalf("got %d repos, want 2", len(repos))
+	}
+	api := repos[0]
+	if api.Stars != 12 || api.Languages["Go"] != 12000 || api.HTMLURL != "https://github.com/octocat/api" {
+		t.Errorf("unexpected repo: %+v", api)
+	}
+	if !repos[1].Fork || len(repos[1].Languages) != 0 {
+		t.Errorf("fork should have no languages: %+v", repos[1])
+	}
+}
+
+func TestClient_Errors(t *testing.T) {
+	server := newStubServer(t)
+	defer server.Close()
+	c := NewClient(server.URL, "test-token")
+
+	if _, err := c.ListRepositories("nobody"); !errors.Is(err, domainGitHub.ErrUserNotFound) {
+		t.Errorf("unknown user: got %v, want ErrUserNotFound", err)
+	}
+	if _, err := c.ListRepositories("limited"); !errors.Is(err, domainGitHub.ErrRateLimited) {
+		t.Errorf("rate limited: got %v, want ErrRateLimited", err)
+	}
+}
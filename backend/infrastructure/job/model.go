@@ -0,0 +1,58 @@
+package job
+
+import (
+	"time"
+
+	organizationInfra "backend/infrastructure/organization"
+	userInfra "backend/infrastructure/user"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// JobPostingModel は GORM タグ付きの永続化用求人モデルです
+type JobPostingModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	OrganizationID  uint                                `gorm:"not null;index"`
+	Organization    organizationInfra.OrganizationModel `gorm:"foreignKey:OrganizationID;references:ID"`
+	CreatedByUserID uint                                `gorm:"not null"`
+
+	Title          string         `gorm:"size:255;not null"`
+	Description    string         `gorm:"type:text"`
+	EmploymentType string         `gorm:"size:32;not null"`
+	JobTypes       pq.StringArray `gorm:"type:text[]"`
+	Skills         pq.StringArray `gorm:"type:text[]"`
+	Location       string         `gorm:"size:255"`
+
+	Status      string `gorm:"size:32;not null;index"`
+	Deadline    *time.Time
+	PublishedAt *time.Time
+	ClosedAt    *time.Time
+}
+
+func (JobPostingModel) TableName() string {
+	return "job_postings"
+}
+
+// ApplicationModel は求人への応募の永続化モデルです
+type ApplicationModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	JobPostingID uint                `gorm:"not null;uniqueIndex:idx_applications_job_user"`
+	JobPosting   JobPostingModel     `gorm:"foreignKey:JobPostingID;references:ID"`
+	UserID       uint                `gorm:"not null;uniqueIndex:idx_applications_job_user;index"`
+	User         userInfra.UserModel `gorm:"foreignKey:UserID;references:ID"`
+	Message      string              `gorm:"type:text"`
+	PostIDs      pq.Int64Array       `gorm:"type:bigint[]"`
+	Status       string              `gorm:"size:32;not null"`
+}
+
+func (ApplicationModel) TableName() string {
+	return "job_applications"
+}
@@ -0,0 +1,274 @@
+package job
+
+import (
+	domainJob "backend/domain/job"
+	domainOrganization "backend/domain/organization"
+	domainUser "backend/domain/user"
+	"backend/infrastructure/dbutil"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// jobRepo は domain/job.Repository の具象実装です
+type jobRepo struct {
+	db *gorm.DB
+}
+
+// NewJobRepo は GORM を使った求人リポジトリを生成します
+func NewJobRepo(db *gorm.DB) domainJob.Repository {
+	return &jobRepo{db: db}
+}
+
+func (r *jobRepo) CreateJobPosting(j *domainJob.JobPosting) error {
+	pm := toPostingPersistence(j)
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	j.ID = pm.ID
+	j.CreatedAt = pm.CreatedAt
+	j.UpdatedAt = pm.UpdatedAt
+	return nil
+}
+
+func (r *jobRepo) UpdateJobPosting(j *domainJob.JobPosting) error {
+	pm := toPostingPersistence(j)
+	return r.db.Omit("Organization").Save(&pm).Error
+}
+
+func (r *jobRepo) DeleteJobPosting(id uint) error {
+	return r.db.Delete(&JobPostingModel{}, id).Error
+}
+
+func (r *jobRepo) GetJobPostingByID(id uint) (*domainJob.JobPosting, error) {
+	var pm JobPostingModel
+	if err := r.db.Preload("Organization").First(&pm, id).Error; err != nil {
+		return nil, err
+	}
+	return toPostingDomain(&pm), nil
+}
+
+func (r *jobRepo) GetJobPostingsByOrganizationID(organizationID uint) ([]*domainJob.JobPosting, error) {
+	var pms []JobPostingModel
+	if err := r.db.
+		Preload("Organization").
+		Where("organization_id = ?", organizationID).
+		Order("created_at DESC").
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	return toPostingDomains(pms), nil
+}
+
+// SearchJobPostings は公開中で受付期間内の求人を新しい順に返します
+func (r *jobRepo) SearchJobPostings(c domainJob.SearchCriteria) ([]*domainJob.JobPosting, error) {
+	q := r.db.Model(&JobPostingModel{}).
+		Preload("Organization").
+		Where("status = ?", string(domainJob.PostingPublished)).
+		Where("deadline IS NULL OR deadline > ?", c.OpenAt)
+	if c.Keyword != "" {
+		q = q.Where(
+			dbutil.ContainsFold(r.db, "title", c.Keyword).
+				Or(dbutil.ContainsFold(r.db, "description", c.Keyword)),
+		)
+	}
+	if c.JobType != "" {
+		q = dbutil.ArrayContains(q, "job_types", c.JobType)
+	}
+	if c.Skill != "" {
+		q = dbutil.ArrayContains(q, "skills", c.Skill)
+	}
+	if c.Location != "" {
+		q = dbutil.ContainsFold(q, "location", c.Location)
+	}
+	if c.EmploymentType != "" {
+		q = q.Where("employment_type = ?", string(c.EmploymentType))
+	}
+
+	var pms []JobPostingModel
+	if err := q.Order("published_at DESC").Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	return toPostingDomains(pms), nil
+}
+
+func (r *jobRepo) CreateApplication(a *domainJob.Application) error {
+	pm := toApplicationPersistence(a)
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	a.ID = pm.ID
+	a.CreatedAt = pm.CreatedAt
+	a.UpdatedAt = pm.UpdatedAt
+	return nil
+}
+
+func (r *jobRepo) UpdateApplication(a *domainJob.Application) error {
+	pm := toApplicationPersistence(a)
+	return r.db.Omit("JobPosting", "User").Save(&pm).Error
+}
+
+func (r *jobRepo) GetApplicationByID(id uint) (*domainJob.Application, error) {
+	var pm ApplicationModel
+	if err := r.applicationQuery().First(&pm, id).Error; err != nil {
+		return nil, err
+	}
+	return toApplicationDomain(&pm), nil
+}
+
+func (r *jobRepo) FindApplication(jobPostingID, userID uint) (*domainJob.Application, error) {
+	var pm ApplicationModel
+	if err := r.applicationQuery().
+		Where("job_posting_id = ? AND user_id = ?", jobPostingID, userID).
+		First(&pm).Error; err != nil {
+		return nil, err
+	}
+	return toApplicationDomain(&pm), nil
+}
+
+func (r *jobRepo) GetApplicationsByJobPostingID(jobPostingID uint) ([]*domainJob.Application, error) {
+	var pms []ApplicationModel
+	if err := r.applicationQuery().
+		Where("job_posting_id = ?", jobPostingID).
+		Order("created_at ASC").
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	return toApplicationDomains(pms), nil
+}
+
+func (r *jobRepo) GetApplicationsByUserID(userID uint) ([]*domainJob.Application, error) {
+	var pms []ApplicationModel
+	if err := r.applicationQuery().
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	return toApplicationDomains(pms), nil
+}
+
+func (r *jobRepo) applicationQuery() *gorm.DB {
+	return r.db.
+		Preload("JobPosting").
+		Preload("JobPosting.Organization").
+		Preload("User")
+}
+
+// --------------------------------------------------
+// 永続化モデル <-> ドメインモデル
+// --------------------------------------------------
+
+func toPostingPersistence(j *domainJob.JobPosting) JobPostingModel {
+	return JobPostingModel{
+		ID:              j.ID,
+		CreatedAt:       j.CreatedAt,
+		UpdatedAt:       j.UpdatedAt,
+		OrganizationID:  j.OrganizationID,
+		CreatedByUserID: j.CreatedByUserID,
+		Title:           j.Title,
+		Description:     j.Description,
+		EmploymentType:  string(j.EmploymentType),
+		JobTypes:        j.JobTypes,
+		Skills:          j.Skills,
+		Location:        j.Location,
+		Status:          string(j.Status),
+		Deadline:        j.Deadline,
+		PublishedAt:     j.PublishedAt,
+		ClosedAt:        j.ClosedAt,
+	}
+}
+
+func toPostingDomain(pm *JobPostingModel) *domainJob.JobPosting {
+	return &domainJob.JobPosting{
+		ID:             pm.ID,
+		OrganizationID: pm.OrganizationID,
+		Organization: domainOrganization.Organization{
+			ID:          pm.Organization.ID,
+			Name:        pm.Organization.Name,
+			Description: pm.Organization.Description,
+			WebsiteURL:  pm.Organization.WebsiteURL,
+		},
+		CreatedByUserID: pm.CreatedByUserID,
+		Title:           pm.Title,
+		Description:     pm.Description,
+		EmploymentType:  domainJob.EmploymentType(pm.EmploymentType),
+		JobTypes:        pm.JobTypes,
+		Skills:          pm.Skills,
+		Location:        pm.Location,
+		Status:          domainJob.PostingStatus(pm.Status),
+		Deadline:        pm.Deadline,
+		PublishedAt:     pm.PublishedAt,
+		ClosedAt:        pm.ClosedAt,
+		CreatedAt:       pm.CreatedAt,
+		UpdatedAt:       pm.UpdatedAt,
+	}
+}
+
+func toPostingDomains(pms []JobPostingModel) []*domainJob.JobPosting {
+	postings := make([]*domainJob.JobPosting, 0, len(pms))
+	for i := range pms {
+		postings = append(postings, toPostingDomain(&pms[i]))
+	}
+	return postings
+}
+
+func toApplicationPersistence(a *domainJob.Application) ApplicationModel {
+	postIDs := make(pq.Int64Array, len(a.PostIDs))
+	for i, id := range a.PostIDs {
+		postIDs[i] = int64(id)
+	}
+	return ApplicationModel{
+		ID:           a.ID,
+		CreatedAt:    a.CreatedAt,
+		UpdatedAt:    a.UpdatedAt,
+		JobPostingID: a.JobPostingID,
+		UserID:       a.UserID,
+		Message:      a.Message,
+		PostIDs:      postIDs,
+		Status:       string(a.Status),
+	}
+}
+
+// toApplicationDomain は応募者のプロフィールのうち、企業に見せる項目だけを詰めます
+func toApplicationDomain(pm *ApplicationModel) *domainJob.Application {
+	postIDs := make([]uint, len(pm.PostIDs))
+	for i, id := range pm.PostIDs {
+		postIDs[i] = uint(id)
+	}
+	return &domainJob.Application{
+		ID:           pm.ID,
+		JobPostingID: pm.JobPostingID,
+		JobPosting:   *toPostingDomain(&pm.JobPosting),
+		UserID:       pm.UserID,
+		Applicant: domainUser.UserModel{
+			ID:               pm.User.ID,
+			Email:            pm.User.Email,
+			FirstName:        pm.User.FirstName,
+			LastName:         pm.User.LastName,
+			FirstNameKana:    pm.User.FirstNameKana,
+			LastNameKana:     pm.User.LastNameKana,
+			ProfileImageURL:  pm.User.ProfileImageURL,
+			SchoolName:       pm.User.SchoolName,
+			Department:       pm.User.Department,
+			Laboratory:       pm.User.Laboratory,
+			GraduationYear:   pm.User.GraduationYear,
+			DesiredJobTypes:  []string(pm.User.DesiredJobTypes),
+			Skills:           []string(pm.User.Skills),
+			SelfIntroduction: pm.User.SelfIntroduction,
+		},
+		Message:   pm.Message,
+		PostIDs:   postIDs,
+		Status:    domainJob.ApplicationStatus(pm.Status),
+		CreatedAt: pm.CreatedAt,
+		UpdatedAt: pm.UpdatedAt,
+	}
+}
+
+func toApplicationDomains(pms []ApplicationModel) []*domainJob.Application {
+	applications := make([]*domainJob.Application, 0, len(pms))
+	for i := range pms {
+		applications = append(applications, toApplicationDomain(&pms[i]))
+	}
+	return applications
+}
@@ -0,0 +1,59 @@
+package markdown
+
+import (
+	"sync"
+
+	domainMarkdown "backend/domain/markdown"
+)
+
+// memoryCache はプロセス内のキャッシュです。上限を超えたら古く登録したものから捨てます
+type memoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]domainMarkdown.Cached
+	order      []string
+}
+
+// NewMemoryCache は maxEntries 件まで保持するキャッシュを生成します
+func NewMemoryCache(maxEntries int) domainMarkdown.Cache {
+	return &memoryCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]domainMarkdown.Cached),
+	}
+}
+
+func (c *memoryCache) Get(key string) (domainMarkdown.Cached, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.entries[key]
+	return v, ok
+}
+
+func (c *memoryCache) Set(key string, value domainMarkdown.Cached) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		c.order = append(c.order, key)
+	}
+	c.entries[key] = value
+	for len(c.entries) > c.maxEntries && len(c.order) > 0 {
+		oldest := c.order[0]
+		c.order = c.order[1:]
+		delete(c.entries, oldest)
+	}
+}
+
+func (c *memoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		return
+	}
+	delete(c.entries, key)
+	for i, k := range c.order {
+		if k == key {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
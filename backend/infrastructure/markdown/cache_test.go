@@ -0,0 +1,24 @@
+package markdown
+
+import (
+	"testing"
+
+	domainMarkdown "backend/domain/markdown"
+)
+
+func TestMemoryCache_Evicts(t *testing.T) {
+	c := NewMemoryCache(2)
+	c.Set("a", domainMarkdown.Cached{HTML: "1"})
+	c.Set("b", domainMarkdown.Cached{HTML: "2"})
+	c.Set("c", domainMarkdown.Cached{HTML: "3"})
+	if _, ok := c.Get("a"); ok {
+		t.Error("oldest entry should be evicted")
+	}
+	c.Delete("b")
+	if _, ok := c.Get("b"); ok {
+		t.Error("deleted entry should be gone")
+	}
+	if v, ok := c.Get("c"); !ok || v.HTML != "3" {
+		t.Errorf("Get(c) = %+v, %v", v, ok)
+	}
+}
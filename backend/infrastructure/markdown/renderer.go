@@ -0,0 +1,73 @@
+package markdown
+
+import (
+	"bytes"
+
+	domainMarkdown "backend/domain/markdown"
+
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/extension"
+)
+
+// highlightStyle はコードブロックの配色です（インラインの style で出力する）
+const highlightStyle = "github"
+
+// renderer は goldmark で HTML に変換し、bluemonday の許可リストでサニタイズする Renderer です
+type renderer struct {
+	md     goldmark.Markdown
+	policy *bluemonday.Policy
+}
+
+// NewRenderer は GFM（表・取り消し線・自動リンク）とコードのハイライトに対応した Renderer を生成します
+func NewRenderer() domainMarkdown.Renderer {
+	md := goldmark.New(
+		goldmark.WithExtensions(
+			extension.Table,
+			extension.Strikethrough,
+			extension.Linkify,
+			highlighting.NewHighlighting(
+				highlighting.WithStyle(highlightStyle),
+				highlighting.WithFormatOptions(chromahtml.WithClasses(false)),
+			),
+		),
+	)
+	return &renderer{md: md, policy: newPolicy()}
+}
+
+func (r *renderer) Render(source string) (string, error) {
+	var buf bytes.Buffer
+	if err := r.md.Convert([]byte(source), &buf); err != nil {
+		return "", err
+	}
+	return r.policy.Sanitize(buf.String()), nil
+}
+
+// newPolicy は説明文・自己紹介で許可するタグと属性です
+// 画像・iframe・フォームなどは許可しない。外部リンクには rel="nofollow noopener" を付けて別タブで開く
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+	p.AllowElements(
+		"p", "br", "hr",
+		"h1", "h2", "h3", "h4", "h5", "h6",
+		"strong", "em", "del", "blockquote",
+		"ul", "ol", "li",
+		"code", "pre", "span",
+		"table", "thead", "tbody", "tr", "th", "td",
+	)
+	p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	p.AllowStyles("text-align").MatchingEnum("left", "center", "right").OnElements("th", "td")
+	// シンタックスハイライトの配色（chroma がインラインで出力する）
+	p.AllowStyles("color", "background-color", "font-weight", "font-style", "text-decoration").
+		OnElements("pre", "span")
+
+	p.AllowAttrs("href").OnElements("a")
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.RequireParseableURLs(true)
+	p.AllowRelativeURLs(true)
+	p.RequireNoFollowOnFullyQualifiedLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}
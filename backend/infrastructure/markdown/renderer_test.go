@@ -0,0 +1,46 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	r := NewRenderer()
+	html, err := r.Render("# 見出し\n\n[GitHub](https://github.com/octocat) と [内部](/Portfolio/1)\n\n```go\nfunc main() {}\n```\n")
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	for _, want := range []string{
+		"<h1>見出し</h1>",
+		`href="https://github.com/octocat" rel="nofollow noopener" target="_blank"`,
+		`<a href="/Portfolio/1">`,
+		"<pre",
+		`<span style="color:`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("rendered HTML should contain %q:\n%s", want, html)
+		}
+	}
+}
+
+func TestRenderer_Sanitize(t *testing.T) {
+	r := NewRenderer()
+	for _, source := range []string{
+		"<script>alert(1)</script>",
+		"[x](javascript:alert(1))",
+		"<img src=x onerror=alert(1)>",
+		"<a href=\"https://example.com\" onclick=\"alert(1)\">x</a>",
+		"![x](https://example.com/a.png)",
+	} {
+		html, err := r.Render(source)
+		if err != nil {
+			t.Fatalf("Render failed: %v", err)
+		}
+		for _, banned := range []string{"<script", "javascript:", "onerror", "onclick", "<img"} {
+			if strings.Contains(html, banned) {
+				t.Errorf("Render(%q) = %q, should not contain %q", source, html, banned)
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package moderation
+
+import "time"
+
+// ReportModel は GORM タグ付きの永続化用通報モデルです
+type ReportModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	ReporterID uint   `gorm:"not null;index"`
+	TargetType string `gorm:"size:32;not null;index:idx_reports_target"`
+	TargetID   uint   `gorm:"not null;index:idx_reports_target"`
+	Category   string `gorm:"size:32;not null"`
+	Detail     string `gorm:"type:text"`
+
+	Status       string `gorm:"size:32;not null;index"`
+	ResolvedByID *uint
+	ResolvedAt   *time.Time
+}
+
+func (ReportModel) TableName() string {
+	return "reports"
+}
+
+// NoteModel は運営メモの永続化モデルです
+type NoteModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	TargetType string `gorm:"size:32;not null;index:idx_moderation_notes_target"`
+	TargetID   uint   `gorm:"not null;index:idx_moderation_notes_target"`
+	AuthorID   uint   `gorm:"not null"`
+	Body       string `gorm:"type:text;not null"`
+}
+
+func (NoteModel) TableName() string {
+	return "moderation_notes"
+}
@@ -0,0 +1,146 @@
+package moderation
+
+import (
+	domainModeration "backend/domain/moderation"
+
+	"gorm.io/gorm"
+)
+
+// moderationRepo は domain/moderation.Repository の具象実装です
+type moderationRepo struct {
+	db *gorm.DB
+}
+
+// NewModerationRepo は GORM を使った通報・運営メモのリポジトリを生成します
+func NewModerationRepo(db *gorm.DB) domainModeration.Repository {
+	return &moderationRepo{db: db}
+}
+
+func (r *moderationRepo) CreateReport(rep *domainModeration.Report) error {
+	pm := toReportPersistence(rep)
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	rep.ID = pm.ID
+	rep.CreatedAt = pm.CreatedAt
+	rep.UpdatedAt = pm.UpdatedAt
+	return nil
+}
+
+func (r *moderationRepo) UpdateReport(rep *domainModeration.Report) error {
+	pm := toReportPersistence(rep)
+	return r.db.Save(&pm).Error
+}
+
+func (r *moderationRepo) GetReportByID(id uint) (*domainModeration.Report, error) {
+	var pm ReportModel
+	if err := r.db.First(&pm, id).Error; err != nil {
+		return nil, err
+	}
+	return toReportDomain(&pm), nil
+}
+
+func (r *moderationRepo) FindReports(f domainModeration.ReportFilter) ([]*domainModeration.Report, int64, error) {
+	q := r.db.Model(&ReportModel{})
+	if f.Status != "" {
+		q = q.Where("status = ?", string(f.Status))
+	}
+	if f.TargetType != "" {
+		q = q.Where("target_type = ?", string(f.TargetType))
+	}
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var pms []ReportModel
+	if err := q.Order("created_at ASC").Limit(f.Limit).Offset(f.Offset).Find(&pms).Error; err != nil {
+		return nil, 0, err
+	}
+	reports := make([]*domainModeration.Report, 0, len(pms))
+	for i := range pms {
+		reports = append(reports, toReportDomain(&pms[i]))
+	}
+	return reports, total, nil
+}
+
+func (r *moderationRepo) HasOpenReport(reporterID uint, targetType domainModeration.TargetType, targetID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&ReportModel{}).
+		Where("reporter_id = ? AND target_type = ? AND target_id = ? AND status = ?",
+			reporterID, string(targetType), targetID, string(domainModeration.ReportOpen)).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *moderationRepo) CreateNote(n *domainModeration.Note) error {
+	pm := NoteModel{
+		CreatedAt:  n.CreatedAt,
+		TargetType: string(n.TargetType),
+		TargetID:   n.TargetID,
+		AuthorID:   n.AuthorID,
+		Body:       n.Body,
+	}
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	n.ID = pm.ID
+	return nil
+}
+
+func (r *moderationRepo) GetNotes(targetType domainModeration.TargetType, targetID uint) ([]*domainModeration.Note, error) {
+	var pms []NoteModel
+	if err := r.db.
+		Where("target_type = ? AND target_id = ?", string(targetType), targetID).
+		Order("created_at ASC").
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	notes := make([]*domainModeration.Note, 0, len(pms))
+	for _, pm := range pms {
+		notes = append(notes, &domainModeration.Note{
+			ID:         pm.ID,
+			TargetType: domainModeration.TargetType(pm.TargetType),
+			TargetID:   pm.TargetID,
+			AuthorID:   pm.AuthorID,
+			Body:       pm.Body,
+			CreatedAt:  pm.CreatedAt,
+		})
+	}
+	return notes, nil
+}
+
+// toReportDomain は ReportModel → domain.Report へのマッピング関数です
+func toReportDomain(pm *ReportModel) *domainModeration.Report {
+	return &domainModeration.Report{
+		ID:           pm.ID,
+		ReporterID:   pm.ReporterID,
+		TargetType:   domainModeration.TargetType(pm.TargetType),
+		TargetID:     pm.TargetID,
+		Category:     domainModeration.ReasonCategory(pm.Category),
+		Detail:       pm.Detail,
+		Status:       domainModeration.ReportStatus(pm.Status),
+		ResolvedByID: pm.ResolvedByID,
+		ResolvedAt:   pm.ResolvedAt,
+		CreatedAt:    pm.CreatedAt,
+		UpdatedAt:    pm.UpdatedAt,
+	}
+}
+
+// toReportPersistence は domain.Report → ReportModel へのマッピング関数です
+func toReportPersistence(rep *domainModeration.Report) ReportModel {
+	return ReportModel{
+		ID:           rep.ID,
+		CreatedAt:    rep.CreatedAt,
+		UpdatedAt:    rep.UpdatedAt,
+		ReporterID:   rep.ReporterID,
+		TargetType:   string(rep.TargetType),
+		TargetID:     rep.TargetID,
+		Category:     string(rep.Category),
+		Detail:       rep.Detail,
+		Status:       string(rep.Status),
+		ResolvedByID: rep.ResolvedByID,
+		ResolvedAt:   rep.ResolvedAt,
+	}
+}
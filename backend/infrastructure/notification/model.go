@@ -0,0 +1,20 @@
+package notification
+
+import "time"
+
+// NotificationModel は GORM タグ付きの永続化用通知モデルです
+type NotificationModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	UserID uint   `gorm:"not null;index"`
+	Type   string `gorm:"size:64;not null"`
+	Title  string `gorm:"size:255;not null"`
+	Body   string `gorm:"type:text"`
+	Link   string `gorm:"size:512"`
+	ReadAt *time.Time
+}
+
+func (NotificationModel) TableName() string {
+	return "notifications"
+}
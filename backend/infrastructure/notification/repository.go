@@ -0,0 +1,100 @@
+package notification
+
+import (
+	"time"
+
+	domainNotification "backend/domain/notification"
+
+	"gorm.io/gorm"
+)
+
+// notificationRepo は domain/notification.Repository の具象実装です
+type notificationRepo struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepo は GORM を使った通知リポジトリを生成します
+func NewNotificationRepo(db *gorm.DB) domainNotification.Repository {
+	return &notificationRepo{db: db}
+}
+
+func (r *notificationRepo) CreateNotification(n *domainNotification.Notification) error {
+	pm := NotificationModel{
+		CreatedAt: n.CreatedAt,
+		UserID:    n.UserID,
+		Type:      string(n.Type),
+		Title:     n.Title,
+		Body:      n.Body,
+		Link:      n.Link,
+	}
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	n.ID = pm.ID
+	n.CreatedAt = pm.CreatedAt
+	return nil
+}
+
+func (r *notificationRepo) GetNotificationsByUserID(userID uint, limit int) ([]*domainNotification.Notification, error) {
+	var pms []NotificationModel
+	if err := r.db.
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	notifications := make([]*domainNotification.Notification, 0, len(pms))
+	for i := range pms {
+		notifications = append(notifications, toDomain(&pms[i]))
+	}
+	return notifications, nil
+}
+
+func (r *notificationRepo) CountUnread(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&NotificationModel{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *notificationRepo) MarkAsRead(userID uint, id uint) error {
+	result := r.db.Model(&NotificationModel{}).
+		Where("id = ? AND user_id = ? AND read_at IS NULL", id, userID).
+		Update("read_at", time.Now().UTC())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// 既読済みか、他人の通知かを区別する
+		var count int64
+		if err := r.db.Model(&NotificationModel{}).Where("id = ? AND user_id = ?", id, userID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return gorm.ErrRecordNotFound
+		}
+	}
+	return nil
+}
+
+func (r *notificationRepo) MarkAllAsRead(userID uint) error {
+	return r.db.Model(&NotificationModel{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", time.Now().UTC()).Error
+}
+
+// toDomain は NotificationModel → domain.Notification へのマッピング関数です
+func toDomain(pm *NotificationModel) *domainNotification.Notification {
+	return &domainNotification.Notification{
+		ID:        pm.ID,
+		UserID:    pm.UserID,
+		Type:      domainNotification.Type(pm.Type),
+		Title:     pm.Title,
+		Body:      pm.Body,
+		Link:      pm.Link,
+		ReadAt:    pm.ReadAt,
+		CreatedAt: pm.CreatedAt,
+	}
+}
@@ -0,0 +1,38 @@
+package organization
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// OrganizationModel は GORM タグ付きの永続化用企業モデルです
+type OrganizationModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	Name        string `gorm:"size:255;not null"`
+	Description string `gorm:"type:text"`
+	WebsiteURL  string `gorm:"size:512"`
+	Status      string `gorm:"size:16;not null;default:pending;index"`
+	ApprovedAt  *time.Time
+}
+
+func (OrganizationModel) TableName() string {
+	return "organizations"
+}
+
+// MemberModel は企業とユーザーの所属関係です
+type MemberModel struct {
+	OrganizationID uint   `gorm:"primaryKey"`
+	UserID         uint   `gorm:"primaryKey;index"`
+	Role           string `gorm:"size:32;not null"`
+	DiscloseViews  bool   `gorm:"not null;default:false"`
+	CreatedAt      time.Time
+}
+
+func (MemberModel) TableName() string {
+	return "organization_members"
+}
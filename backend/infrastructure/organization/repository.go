@@ -0,0 +1,169 @@
+package organization
+
+import (
+	domainOrganization "backend/domain/organization"
+
+	"gorm.io/gorm"
+)
+
+// organizationRepo は domain/organization.Repository の具象実装です
+type organizationRepo struct {
+	db *gorm.DB
+}
+
+// NewOrganizationRepo は GORM を使った企業リポジトリを生成します
+func NewOrganizationRepo(db *gorm.DB) domainOrganization.Repository {
+	return &organizationRepo{db: db}
+}
+
+func (r *organizationRepo) CreateOrganization(o *domainOrganization.Organization, ownerUserID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		pm := OrganizationModel{
+			Name:        o.Name,
+			Description: o.Description,
+			WebsiteURL:  o.WebsiteURL,
+			Status:      string(o.Status),
+			ApprovedAt:  o.ApprovedAt,
+		}
+		if err := tx.Create(&pm).Error; err != nil {
+			return err
+		}
+		member := MemberModel{
+			OrganizationID: pm.ID,
+			UserID:         ownerUserID,
+			Role:           string(domainOrganization.MemberRoleOwner),
+		}
+		if err := tx.Create(&member).Error; err != nil {
+			return err
+		}
+		o.ID = pm.ID
+		o.CreatedAt = pm.CreatedAt
+		o.UpdatedAt = pm.UpdatedAt
+		return nil
+	})
+}
+
+func (r *organizationRepo) GetOrganizationByID(id uint) (*domainOrganization.Organization, error) {
+	var pm OrganizationModel
+	if err := r.db.First(&pm, id).Error; err != nil {
+		return nil, err
+	}
+	return toDomain(&pm), nil
+}
+
+func (r *organizationRepo) GetOrganizationsByUserID(userID uint) ([]*domainOrganization.Organization, error) {
+	var pms []OrganizationModel
+	if err := r.db.
+		Joins("JOIN organization_members ON organization_members.organization_id = organizations.id").
+		Where("organization_members.user_id = ?", userID).
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	orgs := make([]*domainOrganization.Organization, 0, len(pms))
+	for i := range pms {
+		orgs = append(orgs, toDomain(&pms[i]))
+	}
+	return orgs, nil
+}
+
+func (r *organizationRepo) GetOrganizationsByStatus(status domainOrganization.Status) ([]*domainOrganization.Organization, error) {
+	var pms []OrganizationModel
+	if err := r.db.Where("status = ?", string(status)).Order("created_at ASC").Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	orgs := make([]*domainOrganization.Organization, 0, len(pms))
+	for i := range pms {
+		orgs = append(orgs, toDomain(&pms[i]))
+	}
+	return orgs, nil
+}
+
+func (r *organizationRepo) UpdateStatus(o *domainOrganization.Organization) error {
+	return r.db.Model(&OrganizationModel{}).Where("id = ?", o.ID).Updates(map[string]interface{}{
+		"status":      string(o.Status),
+		"approved_at": o.ApprovedAt,
+		"updated_at":  o.UpdatedAt,
+	}).Error
+}
+
+func (r *organizationRepo) AddMember(m *domainOrganization.Member) error {
+	pm := MemberModel{
+		OrganizationID: m.OrganizationID,
+		UserID:         m.UserID,
+		Role:           string(m.Role),
+	}
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	m.CreatedAt = pm.CreatedAt
+	return nil
+}
+
+func (r *organizationRepo) FindMember(organizationID, userID uint) (*domainOrganization.Member, error) {
+	var pm MemberModel
+	if err := r.db.
+		Where("organization_id = ? AND user_id = ?", organizationID, userID).
+		First(&pm).Error; err != nil {
+		return nil, err
+	}
+	return &domainOrganization.Member{
+		OrganizationID: pm.OrganizationID,
+		UserID:         pm.UserID,
+		Role:           domainOrganization.MemberRole(pm.Role),
+		DiscloseViews:  pm.DiscloseViews,
+		CreatedAt:      pm.CreatedAt,
+	}, nil
+}
+
+func (r *organizationRepo) GetMemberUserIDs(organizationID uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&MemberModel{}).
+		Where("organization_id = ?", organizationID).
+		Pluck("user_id", &ids).Error
+	return ids, err
+}
+
+func (r *organizationRepo) SetDiscloseViews(organizationID, userID uint, enabled bool) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if enabled {
+			if err := tx.Model(&MemberModel{}).
+				Where("user_id = ? AND organization_id <> ?", userID, organizationID).
+				Update("disclose_views", false).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&MemberModel{}).
+			Where("organization_id = ? AND user_id = ?", organizationID, userID).
+			Update("disclose_views", enabled).Error
+	})
+}
+
+func (r *organizationRepo) GetDisclosedOrganizationID(userID uint) (uint, error) {
+	var ids []uint
+	if err := r.db.Model(&MemberModel{}).
+		Joins("JOIN organizations ON organizations.id = organization_members.organization_id AND organizations.deleted_at IS NULL").
+		Where("organization_members.user_id = ? AND organization_members.disclose_views = ?", userID, true).
+		Where("organizations.status = ?", string(domainOrganization.StatusApproved)).
+		Limit(1).
+		Pluck("organization_members.organization_id", &ids).Error; err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return ids[0], nil
+}
+
+// toDomain は OrganizationModel → domain.Organization へのマッピング関数です
+func toDomain(pm *OrganizationModel) *domainOrganization.Organization {
+	return &domainOrganization.Organization{
+		ID:          pm.ID,
+		Name:        pm.Name,
+		Description: pm.Description,
+		WebsiteURL:  pm.WebsiteURL,
+		Status:      domainOrganization.Status(pm.Status),
+		ApprovedAt:  pm.ApprovedAt,
+		CreatedAt:   pm.CreatedAt,
+		UpdatedAt:   pm.UpdatedAt,
+	}
+}
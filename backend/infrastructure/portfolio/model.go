@@ -22,8 +22,29 @@ type PostModel struct {
 	Genres      pq.StringArray      `gorm:"type:text[]"`
 	Skills      pq.StringArray      `gorm:"type:text[]"`
 	Images      []ImageModel        `gorm:"foreignKey:PostID"`
+	Status      string              `gorm:"size:16;not null;default:published;index"`
 	UserID      uint                `gorm:"not null;index"`
 	User        userInfra.UserModel `gorm:"foreignKey:UserID;references:ID"`
+
+	// 制作情報（すべて任意）
+	RepositoryURL string `gorm:"size:2048"`
+	DemoURL       string `gorm:"size:2048"`
+	Role          string `gorm:"size:255"`
+	TeamSize      int    `gorm:"not null;default:0"`
+	StartDate     *time.Time
+	EndDate       *time.Time
+	TechStack     []TechStackModel `gorm:"foreignKey:PostID"`
+
+	PublishAt   *time.Time `gorm:"index"`
+	PublishedAt *time.Time `gorm:"index"`
+	ArchivedAt  *time.Time
+
+	Collaborators []CollaboratorModel `gorm:"foreignKey:PostID"`
+
+	HiddenAt     *time.Time `gorm:"index"`
+	HiddenReason string     `gorm:"type:text"`
+
+	Version int `gorm:"not null;default:1"`
 }
 
 // ImageModel は永続化層の画像モデルです
@@ -32,6 +53,89 @@ type ImageModel struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
-	URL    string `gorm:"not null"`
-	PostID uint   `gorm:"not null;index"`
+	URL          string `gorm:"not null"`
+	AltText      string `gorm:"size:255;not null;default:''"`
+	Caption      string `gorm:"size:255;not null;default:''"`
+	DisplayOrder int    `gorm:"not null;default:0"`
+	IsCover      bool   `gorm:"not null;default:false"`
+	PostID       uint   `gorm:"not null;index"`
+}
+
+// TechStackModel は投稿の技術スタック 1 件です
+type TechStackModel struct {
+	ID uint `gorm:"primaryKey"`
+
+	PostID       uint   `gorm:"not null;index"`
+	Name         string `gorm:"size:64;not null"`
+	Version      string `gorm:"size:32;not null;default:''"`
+	DisplayOrder int    `gorm:"not null;default:0"`
+}
+
+func (TechStackModel) TableName() string {
+	return "post_tech_stacks"
+}
+
+// CollaboratorModel は投稿の共同制作者の永続化用モデルです
+type CollaboratorModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	PostID       uint                `gorm:"not null;uniqueIndex:idx_post_collaborators_post_user"`
+	UserID       uint                `gorm:"not null;uniqueIndex:idx_post_collaborators_post_user;index"`
+	User         userInfra.UserModel `gorm:"foreignKey:UserID;references:ID"`
+	Status       string              `gorm:"size:16;not null"`
+	Role         string              `gorm:"size:255;not null;default:''"`
+	Contribution string              `gorm:"type:text"`
+	InvitedBy    uint                `gorm:"not null"`
+	AcceptedAt   *time.Time
+}
+
+func (CollaboratorModel) TableName() string {
+	return "post_collaborators"
+}
+
+// RevisionModel は投稿の内容の版です。内容は revisionContent を JSON にして保存します
+type RevisionModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	PostID       uint   `gorm:"not null;uniqueIndex:idx_post_revisions_post_number"`
+	Number       int    `gorm:"not null;uniqueIndex:idx_post_revisions_post_number"`
+	EditorID     uint   `gorm:"not null"`
+	Reason       string `gorm:"size:16;not null"`
+	RestoredFrom int    `gorm:"not null;default:0"`
+	Content      string `gorm:"type:text;not null"`
+}
+
+func (RevisionModel) TableName() string {
+	return "post_revisions"
+}
+
+// revisionContent は版の内容の保存形式です。ドメインの型を変えても過去の版を読めるよう、ここで JSON のキーを固定する
+type revisionContent struct {
+	Title         string                  `json:"title"`
+	Description   string                  `json:"description"`
+	Genres        []string                `json:"genres"`
+	Skills        []string                `json:"skills"`
+	Images        []revisionImage         `json:"images"`
+	RepositoryURL string                  `json:"repositoryUrl,omitempty"`
+	DemoURL       string                  `json:"demoUrl,omitempty"`
+	Role          string                  `json:"role,omitempty"`
+	TeamSize      int                     `json:"teamSize,omitempty"`
+	StartDate     *time.Time              `json:"startDate,omitempty"`
+	EndDate       *time.Time              `json:"endDate,omitempty"`
+	TechStack     []revisionTechStackItem `json:"techStack"`
+}
+
+type revisionImage struct {
+	URL     string `json:"url"`
+	AltText string `json:"altText"`
+	Caption string `json:"caption,omitempty"`
+	IsCover bool   `json:"isCover,omitempty"`
+}
+
+type revisionTechStackItem struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
 }
@@ -2,9 +2,15 @@
 package portfolio
 
 import (
+	"encoding/json"
+	"time"
+
 	"backend/domain/portfolio"
 	domainUser "backend/domain/user"
+	"backend/infrastructure/dbutil"
+	userInfra "backend/infrastructure/user"
 
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
@@ -21,16 +27,33 @@ func NewPostRepo(db *gorm.DB) portfolio.Repository {
 // Create はドメインモデルを永続化モデルにマッピングして保存します
 func (r *postRepo) CreatePost(p *portfolio.Post) error {
 	pm := PostModel{
-		Title:       p.Title,
-		Description: p.Description,
-		Genres:      p.Genres,
-		Skills:      p.Skills,
-		UserID:      p.UserID,
+		Title:         p.Title,
+		Description:   p.Description,
+		Genres:        p.Genres,
+		Skills:        p.Skills,
+		Status:        string(p.Status),
+		UserID:        p.UserID,
+		RepositoryURL: p.RepositoryURL,
+		DemoURL:       p.DemoURL,
+		Role:          p.Role,
+		TeamSize:      p.TeamSize,
+		StartDate:     p.StartDate,
+		EndDate:       p.EndDate,
+		PublishAt:     p.PublishAt,
+		PublishedAt:   p.PublishedAt,
+		ArchivedAt:    p.ArchivedAt,
+		Images:        toImageModels(p.ID, p.Images),
+		TechStack:     toTechStackModels(p.ID, p.TechStack),
+		Version:       1,
 	}
-	for _, img := range p.Images {
-		pm.Images = append(pm.Images, ImageModel{URL: img.URL})
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
 	}
-	return r.db.Create(&pm).Error
+	p.ID = pm.ID
+	p.CreatedAt = pm.CreatedAt
+	p.UpdatedAt = pm.UpdatedAt
+	p.Version = pm.Version
+	return nil
 }
 
 // FindByID は GORMから取得したモデルをドメインモデルに変換します
@@ -38,14 +61,11 @@ func (r *postRepo) GetPostByID(id uint) (*portfolio.Post, error) {
 	var pm PostModel
 	if err := r.db.
 		Preload("User").
-		Preload("Images").
+		Scopes(preloadDetails).
+		Where("hidden_at IS NULL AND status = ?", portfolio.StatusPublished).
 		First(&pm, id).Error; err != nil {
 		return nil, err
 	}
-	imgs := make([]portfolio.Image, len(pm.Images))
-	for i, im := range pm.Images {
-		imgs[i] = portfolio.Image{URL: im.URL}
-	}
 
 	du := domainUser.UserModel{
 		ID:               pm.User.ID,
@@ -64,41 +84,51 @@ func (r *postRepo) GetPostByID(id uint) (*portfolio.Post, error) {
 		ProfileImageURL:  pm.User.ProfileImageURL,
 	}
 	return &portfolio.Post{
-		ID:          pm.ID,
-		Title:       pm.Title,
-		Description: pm.Description,
-		Genres:      pm.Genres,
-		Skills:      pm.Skills,
-		Images:      imgs,
-		UserID:      pm.UserID,
-		User:        du,
-		CreatedAt:   pm.CreatedAt,
-		UpdatedAt:   pm.UpdatedAt,
+		ID:             pm.ID,
+		Title:          pm.Title,
+		Description:    pm.Description,
+		Genres:         pm.Genres,
+		Skills:         pm.Skills,
+		Images:         toDomainImages(pm.Images),
+		Status:         portfolio.Status(pm.Status),
+		ProjectDetails: toDomainDetails(&pm),
+		UserID:         pm.UserID,
+		User:           du,
+		CreatedAt:      pm.CreatedAt,
+		UpdatedAt:      pm.UpdatedAt,
+		Version:        pm.Version,
+		PublishedAt:    pm.PublishedAt,
+		Collaborators:  toDomainCollaborators(pm.Collaborators),
 	}, nil
 }
 
 // FindByUserID はユーザーIDで絞り込み、結果をドメインモデルにマッピングします
 func (r *postRepo) GetPostsByUserID(userID uint) ([]*portfolio.Post, error) {
 	var pms []PostModel
-	if err := r.db.Where("user_id = ?", userID).Preload("Images").Find(&pms).Error; err != nil {
+	if err := r.db.
+		Where("(user_id = ? OR id IN (?)) AND hidden_at IS NULL AND status = ?",
+			userID, acceptedPostIDs(r.db, userID), portfolio.StatusPublished).
+		Scopes(preloadDetails).
+		Find(&pms).Error; err != nil {
 		return nil, err
 	}
 	var posts []*portfolio.Post
 	for _, pm := range pms {
-		imgs := make([]portfolio.Image, len(pm.Images))
-		for i, im := range pm.Images {
-			imgs[i] = portfolio.Image{URL: im.URL}
-		}
 		posts = append(posts, &portfolio.Post{
-			ID:          pm.ID,
-			Title:       pm.Title,
-			Description: pm.Description,
-			Genres:      pm.Genres,
-			Skills:      pm.Skills,
-			Images:      imgs,
-			UserID:      pm.UserID,
-			CreatedAt:   pm.CreatedAt,
-			UpdatedAt:   pm.UpdatedAt,
+			ID:             pm.ID,
+			Title:          pm.Title,
+			Description:    pm.Description,
+			Genres:         pm.Genres,
+			Skills:         pm.Skills,
+			Images:         toDomainImages(pm.Images),
+			Status:         portfolio.Status(pm.Status),
+			ProjectDetails: toDomainDetails(&pm),
+			UserID:         pm.UserID,
+			CreatedAt:      pm.CreatedAt,
+			UpdatedAt:      pm.UpdatedAt,
+			Version:        pm.Version,
+			PublishedAt:    pm.PublishedAt,
+			Collaborators:  toDomainCollaborators(pm.Collaborators),
 		})
 	}
 	return posts, nil
@@ -109,7 +139,8 @@ func (r *postRepo) GetAllPosts() ([]*portfolio.Post, error) {
 	var pms []PostModel
 	if err := r.db.
 		Preload("User"). // ← ここを追加
-		Preload("Images").
+		Scopes(preloadDetails).
+		Where("hidden_at IS NULL AND status = ?", portfolio.StatusPublished).
 		Find(&pms).
 		Error; err != nil {
 		return nil, err
@@ -123,12 +154,375 @@ func (r *postRepo) GetAllPosts() ([]*portfolio.Post, error) {
 	return posts, nil
 }
 
+// SearchPosts は条件に合う投稿を c.Sort の順に返します
+func (r *postRepo) SearchPosts(c portfolio.SearchCriteria) ([]*portfolio.Post, error) {
+	q := r.db.Model(&PostModel{}).
+		Preload("User").
+		Scopes(preloadDetails).
+		Where("post_models.hidden_at IS NULL AND post_models.status = ?", portfolio.StatusPublished)
+	if c.Keyword != "" {
+		q = q.Where(
+			dbutil.ContainsFold(r.db, "post_models.title", c.Keyword).
+				Or(dbutil.ContainsFold(r.db, "post_models.description", c.Keyword)),
+		)
+	}
+	for _, genre := range c.Genres {
+		q = dbutil.ArrayContains(q, "post_models.genres", genre)
+	}
+	for _, skill := range c.Skills {
+		q = dbutil.ArrayContains(q, "post_models.skills", skill)
+	}
+	if c.GraduationYear != "" {
+		q = q.Where("post_models.user_id IN (?)",
+			r.db.Model(&userInfra.UserModel{}).Select("id").Where("graduation_year = ?", c.GraduationYear))
+	}
+	if !c.PublishedAfter.IsZero() {
+		q = q.Where("post_models.published_at > ?", c.PublishedAfter)
+	}
+
+	if c.Sort == portfolio.SortTrending {
+		q = q.Select("post_models.*").
+			Joins("LEFT JOIN post_trending_scores ON post_trending_scores.post_id = post_models.id").
+			Order("COALESCE(post_trending_scores.score, 0) DESC")
+	}
+
+	var pms []PostModel
+	if err := q.Order("post_models.published_at DESC, post_models.id DESC").Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	posts := make([]*portfolio.Post, 0, len(pms))
+	for i := range pms {
+		posts = append(posts, toDomain(&pms[i]))
+	}
+	return posts, nil
+}
+
+// GetPostByIDAnyStatus は本人の確認用に、公開状態によらず投稿を取得します
+func (r *postRepo) GetPostByIDAnyStatus(id uint) (*portfolio.Post, error) {
+	var pm PostModel
+	if err := r.db.
+		Preload("User").
+		Scopes(preloadDetails).
+		Where("hidden_at IS NULL").
+		First(&pm, id).Error; err != nil {
+		return nil, err
+	}
+	return toDomain(&pm), nil
+}
+
+// GetPostsByStatus は本人の投稿のうち指定した公開状態のものを更新の新しい順に返します
+func (r *postRepo) GetPostsByStatus(userID uint, statuses ...portfolio.Status) ([]*portfolio.Post, error) {
+	var pms []PostModel
+	if err := r.db.
+		Scopes(preloadDetails).
+		Where("user_id = ? AND hidden_at IS NULL AND status IN ?", userID, statuses).
+		Order("updated_at DESC").
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	posts := make([]*portfolio.Post, 0, len(pms))
+	for i := range pms {
+		posts = append(posts, toDomain(&pms[i]))
+	}
+	return posts, nil
+}
+
+func (r *postRepo) GetPostsByIDs(ids []uint) ([]*portfolio.Post, error) {
+	if len(ids) == 0 {
+		return []*portfolio.Post{}, nil
+	}
+	var pms []PostModel
+	if err := r.db.
+		Scopes(preloadDetails).
+		Where("id IN ? AND hidden_at IS NULL", ids).
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	posts := make([]*portfolio.Post, 0, len(pms))
+	for i := range pms {
+		posts = append(posts, toDomain(&pms[i]))
+	}
+	return posts, nil
+}
+
+// UpdatePost は投稿の内容を保存します。画像と技術スタックは入れ替える
+// 公開状態は予約投稿のジョブと競合しないよう UpdatePostStatus だけで保存する
+func (r *postRepo) UpdatePost(p *portfolio.Post) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&PostModel{ID: p.ID}).Where("version = ?", p.Version).Updates(map[string]interface{}{
+			"title":          p.Title,
+			"description":    p.Description,
+			"genres":         pq.StringArray(p.Genres),
+			"skills":         pq.StringArray(p.Skills),
+			"repository_url": p.RepositoryURL,
+			"demo_url":       p.DemoURL,
+			"role":           p.Role,
+			"team_size":      p.TeamSize,
+			"start_date":     p.StartDate,
+			"end_date":       p.EndDate,
+			"updated_at":     p.UpdatedAt,
+			"version":        p.Version + 1,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return portfolio.ErrVersionConflict
+		}
+		if err := tx.Where("post_id = ?", p.ID).Delete(&ImageModel{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("post_id = ?", p.ID).Delete(&TechStackModel{}).Error; err != nil {
+			return err
+		}
+		if images := toImageModels(p.ID, p.Images); len(images) > 0 {
+			if err := tx.Create(&images).Error; err != nil {
+				return err
+			}
+		}
+		if stack := toTechStackModels(p.ID, p.TechStack); len(stack) > 0 {
+			if err := tx.Create(&stack).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	p.Version++
+	return nil
+}
+
+// UpdatePostStatus は公開状態が from のままのときだけ公開状態を保存します
+// 公開状態の変更でも版番号は進める。内容の編集と競合させないよう、条件には版番号を使わない
+func (r *postRepo) UpdatePostStatus(p *portfolio.Post, from portfolio.Status) (bool, error) {
+	fields := statusFields(p)
+	fields["version"] = gorm.Expr("version + 1")
+	result := r.db.Model(&PostModel{}).
+		Where("id = ? AND status = ?", p.ID, from).
+		Updates(fields)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected != 1 {
+		return false, nil
+	}
+	if err := r.db.Model(&PostModel{}).Select("version").Where("id = ?", p.ID).Scan(&p.Version).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetDuePosts は公開日時を過ぎた予約投稿を公開日時の古い順に返します
+func (r *postRepo) GetDuePosts(now time.Time) ([]*portfolio.Post, error) {
+	var pms []PostModel
+	if err := r.db.
+		Scopes(preloadDetails).
+		Where("status = ? AND publish_at <= ? AND hidden_at IS NULL", portfolio.StatusScheduled, now).
+		Order("publish_at ASC, id ASC").
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	posts := make([]*portfolio.Post, 0, len(pms))
+	for i := range pms {
+		posts = append(posts, toDomain(&pms[i]))
+	}
+	return posts, nil
+}
+
+// DeletePost は投稿を論理削除します。画像・版・共同制作者は残す
+func (r *postRepo) DeletePost(id uint) error {
+	return r.db.Delete(&PostModel{}, id).Error
+}
+
+// CreateRevision は投稿ごとに次の番号を振って版を保存します
+// 同時に保存されて番号が重複した場合は一意制約で失敗する
+func (r *postRepo) CreateRevision(rev *portfolio.Revision) error {
+	content, err := json.Marshal(toRevisionContent(rev.Content))
+	if err != nil {
+		return err
+	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var last int
+		if err := tx.Model(&RevisionModel{}).
+			Where("post_id = ?", rev.PostID).
+			Select("COALESCE(MAX(number), 0)").
+			Scan(&last).Error; err != nil {
+			return err
+		}
+		rm := RevisionModel{
+			CreatedAt:    rev.CreatedAt,
+			PostID:       rev.PostID,
+			Number:       last + 1,
+			EditorID:     rev.EditorID,
+			Reason:       string(rev.Reason),
+			RestoredFrom: rev.RestoredFrom,
+			Content:      string(content),
+		}
+		if err := tx.Create(&rm).Error; err != nil {
+			return err
+		}
+		rev.ID = rm.ID
+		rev.Number = rm.Number
+		rev.CreatedAt = rm.CreatedAt
+		return nil
+	})
+}
+
+// GetRevisions は投稿の版を新しい順に返します
+func (r *postRepo) GetRevisions(postID uint) ([]*portfolio.Revision, error) {
+	var rms []RevisionModel
+	if err := r.db.Where("post_id = ?", postID).Order("number DESC").Find(&rms).Error; err != nil {
+		return nil, err
+	}
+	revs := make([]*portfolio.Revision, 0, len(rms))
+	for i := range rms {
+		rev, err := toDomainRevision(&rms[i])
+		if err != nil {
+			return nil, err
+		}
+		revs = append(revs, rev)
+	}
+	return revs, nil
+}
+
+// GetRevision は投稿の指定した番号の版を返します
+func (r *postRepo) GetRevision(postID uint, number int) (*portfolio.Revision, error) {
+	var rm RevisionModel
+	if err := r.db.Where("post_id = ? AND number = ?", postID, number).First(&rm).Error; err != nil {
+		return nil, err
+	}
+	return toDomainRevision(&rm)
+}
+
+func (r *postRepo) CreateCollaborator(c *portfolio.Collaborator) error {
+	cm := CollaboratorModel{
+		CreatedAt:    c.CreatedAt,
+		UpdatedAt:    c.UpdatedAt,
+		PostID:       c.PostID,
+		UserID:       c.UserID,
+		Status:       string(c.Status),
+		Role:         c.Role,
+		Contribution: c.Contribution,
+		InvitedBy:    c.InvitedBy,
+		AcceptedAt:   c.AcceptedAt,
+	}
+	if err := r.db.Create(&cm).Error; err != nil {
+		return err
+	}
+	c.ID = cm.ID
+	return nil
+}
+
+func (r *postRepo) GetCollaborator(postID, userID uint) (*portfolio.Collaborator, error) {
+	var cm CollaboratorModel
+	if err := r.db.Preload("User").Where("post_id = ? AND user_id = ?", postID, userID).First(&cm).Error; err != nil {
+		return nil, err
+	}
+	c := toDomainCollaborator(&cm)
+	return &c, nil
+}
+
+func (r *postRepo) GetCollaborators(postID uint) ([]*portfolio.Collaborator, error) {
+	var cms []CollaboratorModel
+	if err := r.db.Preload("User").Where("post_id = ?", postID).Order("id ASC").Find(&cms).Error; err != nil {
+		return nil, err
+	}
+	collaborators := make([]*portfolio.Collaborator, 0, len(cms))
+	for i := range cms {
+		c := toDomainCollaborator(&cms[i])
+		collaborators = append(collaborators, &c)
+	}
+	return collaborators, nil
+}
+
+// GetInvitations は招待中の依頼を新しい順に返します。削除・非表示になった投稿への招待は含めない
+func (r *postRepo) GetInvitations(userID uint) ([]*portfolio.Collaborator, error) {
+	var cms []CollaboratorModel
+	if err := r.db.
+		Where("user_id = ? AND status = ?", userID, portfolio.CollaboratorPending).
+		Where("post_id IN (?)", r.db.Model(&PostModel{}).Select("id").Where("hidden_at IS NULL")).
+		Order("id DESC").
+		Find(&cms).Error; err != nil {
+		return nil, err
+	}
+	postIDs := make([]uint, len(cms))
+	for i, cm := range cms {
+		postIDs[i] = cm.PostID
+	}
+	var pms []PostModel
+	if len(postIDs) > 0 {
+		if err := r.db.Select("id", "title").Where("id IN ?", postIDs).Find(&pms).Error; err != nil {
+			return nil, err
+		}
+	}
+	titles := make(map[uint]string, len(pms))
+	for _, pm := range pms {
+		titles[pm.ID] = pm.Title
+	}
+	invitations := make([]*portfolio.Collaborator, 0, len(cms))
+	for i := range cms {
+		c := toDomainCollaborator(&cms[i])
+		c.PostTitle = titles[c.PostID]
+		invitations = append(invitations, &c)
+	}
+	return invitations, nil
+}
+
+func (r *postRepo) UpdateCollaborator(c *portfolio.Collaborator) error {
+	return r.db.Model(&CollaboratorModel{}).Where("id = ?", c.ID).Updates(map[string]interface{}{
+		"status":       string(c.Status),
+		"role":         c.Role,
+		"contribution": c.Contribution,
+		"accepted_at":  c.AcceptedAt,
+		"updated_at":   c.UpdatedAt,
+	}).Error
+}
+
+func (r *postRepo) DeleteCollaborator(postID, userID uint) error {
+	return r.db.Where("post_id = ? AND user_id = ?", postID, userID).Delete(&CollaboratorModel{}).Error
+}
+
+// GetPostByIDIncludingHidden は運営の確認用に、非表示の投稿も含めて取得します
+func (r *postRepo) GetPostByIDIncludingHidden(id uint) (*portfolio.Post, error) {
+	var pm PostModel
+	if err := r.db.
+		Preload("User").
+		Scopes(preloadDetails).
+		First(&pm, id).Error; err != nil {
+		return nil, err
+	}
+	return toDomain(&pm), nil
+}
+
+// UpdatePostVisibility は非表示状態だけを保存します
+func (r *postRepo) UpdatePostVisibility(p *portfolio.Post) error {
+	return r.db.Model(&PostModel{ID: p.ID}).Updates(map[string]interface{}{
+		"hidden_at":     p.HiddenAt,
+		"hidden_reason": p.HiddenReason,
+	}).Error
+}
+
+// preloadDetails は画像と技術スタックを表示順に、承諾済みの共同制作者を承諾順に読み込みます
+func preloadDetails(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Images", func(db *gorm.DB) *gorm.DB { return db.Order("display_order ASC, id ASC") }).
+		Preload("TechStack", func(db *gorm.DB) *gorm.DB { return db.Order("display_order ASC, id ASC") }).
+		Preload("Collaborators", func(db *gorm.DB) *gorm.DB {
+			return db.Where("status = ?", portfolio.CollaboratorAccepted).Order("accepted_at ASC, id ASC")
+		}).
+		Preload("Collaborators.User")
+}
+
+// acceptedPostIDs は userID のユーザーが共同制作者として承諾した投稿の ID のサブクエリです
+func acceptedPostIDs(db *gorm.DB, userID uint) *gorm.DB {
+	return db.Model(&CollaboratorModel{}).Select("post_id").
+		Where("user_id = ? AND status = ?", userID, portfolio.CollaboratorAccepted)
+}
+
 // toDomain は PostModel → domain.Post へのマッピング関数です
 func toDomain(pm *PostModel) *portfolio.Post {
-	imgs := make([]portfolio.Image, len(pm.Images))
-	for i, im := range pm.Images {
-		imgs[i] = portfolio.Image{URL: im.URL}
-	}
 
 	user := domainUser.UserModel{
 		ID:               pm.User.ID,
@@ -150,15 +544,177 @@ func toDomain(pm *PostModel) *portfolio.Post {
 	}
 
 	return &portfolio.Post{
-		ID:          pm.ID,
-		Title:       pm.Title,
-		Description: pm.Description,
-		Genres:      pm.Genres,
-		Skills:      pm.Skills,
-		Images:      imgs,
-		UserID:      pm.UserID,
-		User:        user,
-		CreatedAt:   pm.CreatedAt,
-		UpdatedAt:   pm.UpdatedAt,
+		ID:             pm.ID,
+		Title:          pm.Title,
+		Description:    pm.Description,
+		Genres:         pm.Genres,
+		Skills:         pm.Skills,
+		Images:         toDomainImages(pm.Images),
+		Status:         portfolio.Status(pm.Status),
+		ProjectDetails: toDomainDetails(pm),
+		UserID:         pm.UserID,
+		User:           user,
+		CreatedAt:      pm.CreatedAt,
+		UpdatedAt:      pm.UpdatedAt,
+		Version:        pm.Version,
+		PublishAt:      pm.PublishAt,
+		PublishedAt:    pm.PublishedAt,
+		ArchivedAt:     pm.ArchivedAt,
+		Collaborators:  toDomainCollaborators(pm.Collaborators),
+		HiddenAt:       pm.HiddenAt,
+		HiddenReason:   pm.HiddenReason,
+	}
+}
+
+func toDomainImages(ims []ImageModel) []portfolio.Image {
+	imgs := make([]portfolio.Image, len(ims))
+	for i, im := range ims {
+		imgs[i] = portfolio.Image{
+			URL:          im.URL,
+			AltText:      im.AltText,
+			Caption:      im.Caption,
+			DisplayOrder: im.DisplayOrder,
+			IsCover:      im.IsCover,
+		}
+	}
+	return imgs
+}
+
+func toDomainDetails(pm *PostModel) portfolio.ProjectDetails {
+	stack := make([]portfolio.TechStackItem, len(pm.TechStack))
+	for i, item := range pm.TechStack {
+		stack[i] = portfolio.TechStackItem{Name: item.Name, Version: item.Version}
+	}
+	return portfolio.ProjectDetails{
+		RepositoryURL: pm.RepositoryURL,
+		DemoURL:       pm.DemoURL,
+		Role:          pm.Role,
+		TeamSize:      pm.TeamSize,
+		StartDate:     pm.StartDate,
+		EndDate:       pm.EndDate,
+		TechStack:     stack,
+	}
+}
+
+// statusFields は公開状態に関わる列の値です
+func statusFields(p *portfolio.Post) map[string]interface{} {
+	return map[string]interface{}{
+		"status":       string(p.Status),
+		"publish_at":   p.PublishAt,
+		"published_at": p.PublishedAt,
+		"archived_at":  p.ArchivedAt,
+		"updated_at":   p.UpdatedAt,
+	}
+}
+
+func toImageModels(postID uint, images []portfolio.Image) []ImageModel {
+	ims := make([]ImageModel, 0, len(images))
+	for _, img := range images {
+		ims = append(ims, ImageModel{
+			URL:          img.URL,
+			AltText:      img.AltText,
+			Caption:      img.Caption,
+			DisplayOrder: img.DisplayOrder,
+			IsCover:      img.IsCover,
+			PostID:       postID,
+		})
+	}
+	return ims
+}
+
+func toTechStackModels(postID uint, stack []portfolio.TechStackItem) []TechStackModel {
+	tms := make([]TechStackModel, 0, len(stack))
+	for i, item := range stack {
+		tms = append(tms, TechStackModel{PostID: postID, Name: item.Name, Version: item.Version, DisplayOrder: i})
+	}
+	return tms
+}
+
+func toRevisionContent(c portfolio.Content) revisionContent {
+	rc := revisionContent{
+		Title:         c.Title,
+		Description:   c.Description,
+		Genres:        c.Genres,
+		Skills:        c.Skills,
+		Images:        make([]revisionImage, 0, len(c.Images)),
+		RepositoryURL: c.RepositoryURL,
+		DemoURL:       c.DemoURL,
+		Role:          c.Role,
+		TeamSize:      c.TeamSize,
+		StartDate:     c.StartDate,
+		EndDate:       c.EndDate,
+		TechStack:     make([]revisionTechStackItem, 0, len(c.TechStack)),
+	}
+	for _, img := range c.Images {
+		rc.Images = append(rc.Images, revisionImage{URL: img.URL, AltText: img.AltText, Caption: img.Caption, IsCover: img.IsCover})
+	}
+	for _, item := range c.TechStack {
+		rc.TechStack = append(rc.TechStack, revisionTechStackItem{Name: item.Name, Version: item.Version})
+	}
+	return rc
+}
+
+func toDomainRevision(rm *RevisionModel) (*portfolio.Revision, error) {
+	var rc revisionContent
+	if err := json.Unmarshal([]byte(rm.Content), &rc); err != nil {
+		return nil, err
+	}
+	content := portfolio.Content{
+		Title:       rc.Title,
+		Description: rc.Description,
+		Genres:      rc.Genres,
+		Skills:      rc.Skills,
+		Images:      make([]portfolio.Image, len(rc.Images)),
+		ProjectDetails: portfolio.ProjectDetails{
+			RepositoryURL: rc.RepositoryURL,
+			DemoURL:       rc.DemoURL,
+			Role:          rc.Role,
+			TeamSize:      rc.TeamSize,
+			StartDate:     rc.StartDate,
+			EndDate:       rc.EndDate,
+			TechStack:     make([]portfolio.TechStackItem, len(rc.TechStack)),
+		},
+	}
+	for i, img := range rc.Images {
+		content.Images[i] = portfolio.Image{URL: img.URL, AltText: img.AltText, Caption: img.Caption, DisplayOrder: i, IsCover: img.IsCover}
+	}
+	for i, item := range rc.TechStack {
+		content.TechStack[i] = portfolio.TechStackItem{Name: item.Name, Version: item.Version}
+	}
+	return &portfolio.Revision{
+		ID:           rm.ID,
+		PostID:       rm.PostID,
+		Number:       rm.Number,
+		EditorID:     rm.EditorID,
+		Reason:       portfolio.RevisionReason(rm.Reason),
+		RestoredFrom: rm.RestoredFrom,
+		Content:      content,
+		CreatedAt:    rm.CreatedAt,
+	}, nil
+}
+
+func toDomainCollaborators(cms []CollaboratorModel) []portfolio.Collaborator {
+	collaborators := make([]portfolio.Collaborator, len(cms))
+	for i := range cms {
+		collaborators[i] = toDomainCollaborator(&cms[i])
+	}
+	return collaborators
+}
+
+func toDomainCollaborator(cm *CollaboratorModel) portfolio.Collaborator {
+	return portfolio.Collaborator{
+		ID:              cm.ID,
+		PostID:          cm.PostID,
+		UserID:          cm.UserID,
+		Status:          portfolio.CollaboratorStatus(cm.Status),
+		Role:            cm.Role,
+		Contribution:    cm.Contribution,
+		InvitedBy:       cm.InvitedBy,
+		AcceptedAt:      cm.AcceptedAt,
+		CreatedAt:       cm.CreatedAt,
+		UpdatedAt:       cm.UpdatedAt,
+		FirstName:       cm.User.FirstName,
+		LastName:        cm.User.LastName,
+		ProfileImageURL: cm.User.ProfileImageURL,
 	}
 }
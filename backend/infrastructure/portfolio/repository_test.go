@@ -0,0 +1,71 @@
+package portfolio
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"backend/domain/portfolio"
+	"backend/migrations"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	m, err := migrations.New(db)
+	if err != nil {
+		t.Fatalf("migrator: %v", err)
+	}
+	if _, err := m.Up(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+func TestPostRepo_UpdatePostVersion(t *testing.T) {
+	repo := NewPostRepo(openTestDB(t))
+	post, err := portfolio.NewDraftPost("作品", "説明", nil, nil, portfolio.ProjectDetails{}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.CreatePost(post); err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+	if post.Version != 1 {
+		t.Fatalf("new post version = %d, want 1", post.Version)
+	}
+	stale := *post
+
+	post.Title = "新しいタイトル"
+	post.UpdatedAt = time.Now()
+	if err := repo.UpdatePost(post); err != nil {
+		t.Fatalf("UpdatePost: %v", err)
+	}
+	if post.Version != 2 {
+		t.Errorf("version after update = %d, want 2", post.Version)
+	}
+
+	stale.Title = "古い画面からの保存"
+	if err := repo.UpdatePost(&stale); !errors.Is(err, portfolio.ErrVersionConflict) {
+		t.Fatalf("UpdatePost with a stale version = %v, want ErrVersionConflict", err)
+	}
+	if stale.Version != 1 {
+		t.Errorf("stale version should be kept on conflict, got %d", stale.Version)
+	}
+
+	saved, err := repo.GetPostByIDAnyStatus(post.ID)
+	if err != nil {
+		t.Fatalf("GetPostByIDAnyStatus: %v", err)
+	}
+	if saved.Title != "新しいタイトル" || saved.Version != 2 {
+		t.Errorf("saved post = %q (version %d), want the first update only", saved.Title, saved.Version)
+	}
+}
@@ -0,0 +1,14 @@
+package ranking
+
+import "time"
+
+// TrendingScoreModel は定期的に計算する投稿の人気度です。反応のない投稿の行はない
+type TrendingScoreModel struct {
+	PostID     uint    `gorm:"primaryKey;autoIncrement:false"`
+	Score      float64 `gorm:"not null;index"`
+	ComputedAt time.Time
+}
+
+func (TrendingScoreModel) TableName() string {
+	return "post_trending_scores"
+}
@@ -0,0 +1,77 @@
+package ranking
+
+import (
+	domainAnalytics "backend/domain/analytics"
+	domainEngagement "backend/domain/engagement"
+	domainRanking "backend/domain/ranking"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// rankingRepo は domain/ranking.Repository の具象実装です
+// 反応は閲覧 (view_events)・コメント (comments)・いいね / ブックマーク (post_reactions) から読み出します
+type rankingRepo struct {
+	db *gorm.DB
+}
+
+// NewRankingRepo は GORM を使った人気度のリポジトリを生成します
+func NewRankingRepo(db *gorm.DB) domainRanking.Repository {
+	return &rankingRepo{db: db}
+}
+
+type eventRow struct {
+	PostID     uint
+	UserID     uint
+	VisitorKey string
+	Signal     string
+	At         time.Time
+}
+
+func (r *rankingRepo) GetEvents(since time.Time) ([]domainRanking.Event, error) {
+	queries := []*gorm.DB{
+		r.db.Table("view_events").
+			Select("target_id AS post_id, COALESCE(viewer_id, 0) AS user_id, visitor_key, ? AS signal, created_at AS at", domainRanking.SignalView).
+			Where("target_type = ? AND created_at >= ?", domainAnalytics.TargetPost, since),
+		r.db.Table("comments").
+			Select("post_id, user_id, '' AS visitor_key, ? AS signal, created_at AS at", domainRanking.SignalComment).
+			Where("deleted_at IS NULL AND created_at >= ?", since),
+		r.db.Table("post_reactions").
+			Select("post_id, user_id, '' AS visitor_key, kind AS signal, created_at AS at").
+			Where("kind IN ? AND created_at >= ?", []domainEngagement.Kind{domainEngagement.KindLike, domainEngagement.KindBookmark}, since),
+	}
+
+	var events []domainRanking.Event
+	for _, q := range queries {
+		var rows []eventRow
+		if err := q.Scan(&rows).Error; err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			events = append(events, domainRanking.Event{
+				PostID:     row.PostID,
+				UserID:     row.UserID,
+				VisitorKey: row.VisitorKey,
+				Signal:     domainRanking.Signal(row.Signal),
+				At:         row.At,
+			})
+		}
+	}
+	return events, nil
+}
+
+func (r *rankingRepo) ReplaceScores(scores []domainRanking.Score, computedAt time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&TrendingScoreModel{}).Error; err != nil {
+			return err
+		}
+		if len(scores) == 0 {
+			return nil
+		}
+		sms := make([]TrendingScoreModel, 0, len(scores))
+		for _, s := range scores {
+			sms = append(sms, TrendingScoreModel{PostID: s.PostID, Score: s.Score, ComputedAt: computedAt})
+		}
+		return tx.CreateInBatches(sms, 500).Error
+	})
+}
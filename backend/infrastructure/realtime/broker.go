@@ -0,0 +1,130 @@
+package realtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	domainRealtime "backend/domain/realtime"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// notifyChannel は LISTEN/NOTIFY で使うチャンネル名です
+const notifyChannel = "realtime_events"
+
+// --------------------------------------------------
+// memoryBroker: 単一プロセス用（SQLite モード）
+// --------------------------------------------------
+
+type memoryBroker struct {
+	mu       sync.RWMutex
+	handlers []func(e *domainRealtime.Event)
+}
+
+// NewMemoryBroker はプロセス内だけで完結する Broker を生成します
+func NewMemoryBroker() domainRealtime.Broker {
+	return &memoryBroker{}
+}
+
+func (b *memoryBroker) Publish(e *domainRealtime.Event) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, h := range b.handlers {
+		h(e)
+	}
+	return nil
+}
+
+func (b *memoryBroker) Listen(handler func(e *domainRealtime.Event)) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+	return nil
+}
+
+func (b *memoryBroker) Close() error {
+	return nil
+}
+
+// --------------------------------------------------
+// postgresBroker: LISTEN/NOTIFY による複数レプリカ対応
+// --------------------------------------------------
+
+// notifyPayload は NOTIFY に載せる最小限の情報です
+// ペイロード上限 (8000 bytes) を避けるため、本体はイベントテーブルから読み直します
+type notifyPayload struct {
+	ID     uint `json:"id"`
+	UserID uint `json:"userId"`
+}
+
+type postgresBroker struct {
+	db       *gorm.DB
+	dsn      string
+	repo     domainRealtime.IEventRepository
+	listener *pq.Listener
+}
+
+// NewPostgresBroker は PostgreSQL の LISTEN/NOTIFY を使う Broker を生成します
+func NewPostgresBroker(db *gorm.DB, dsn string, repo domainRealtime.IEventRepository) domainRealtime.Broker {
+	return &postgresBroker{db: db, dsn: dsn, repo: repo}
+}
+
+func (b *postgresBroker) Publish(e *domainRealtime.Event) error {
+	payload, err := json.Marshal(notifyPayload{ID: e.ID, UserID: e.UserID})
+	if err != nil {
+		return err
+	}
+	return b.db.Exec("SELECT pg_notify(?, ?)", notifyChannel, string(payload)).Error
+}
+
+func (b *postgresBroker) Listen(handler func(e *domainRealtime.Event)) error {
+	b.listener = pq.NewListener(b.dsn, 10*time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("realtime listener error: %v", err)
+		}
+	})
+	if err := b.listener.Listen(notifyChannel); err != nil {
+		return fmt.Errorf("failed to listen %s: %v", notifyChannel, err)
+	}
+
+	go func() {
+		for {
+			select {
+			case n, ok := <-b.listener.Notify:
+				if !ok {
+					return
+				}
+				// 再接続直後は nil が届く。取りこぼしはクライアントの Last-Event-ID で補う
+				if n == nil {
+					continue
+				}
+				var p notifyPayload
+				if err := json.Unmarshal([]byte(n.Extra), &p); err != nil {
+					log.Printf("realtime: invalid notify payload: %v", err)
+					continue
+				}
+				e, err := b.repo.FindEventByID(p.ID)
+				if err != nil {
+					log.Printf("realtime: failed to load event %d: %v", p.ID, err)
+					continue
+				}
+				handler(e)
+			case <-time.After(90 * time.Second):
+				// 接続が生きているか定期的に確認する
+				go b.listener.Ping()
+			}
+		}
+	}()
+	return nil
+}
+
+func (b *postgresBroker) Close() error {
+	if b.listener == nil {
+		return nil
+	}
+	return b.listener.Close()
+}
@@ -0,0 +1,18 @@
+package realtime
+
+import "time"
+
+// EventModel は GORM タグ付きの永続化用イベントモデルです
+// 再接続時の再送用に一定期間だけ保持します
+type EventModel struct {
+	ID        uint      `gorm:"primaryKey"`
+	CreatedAt time.Time `gorm:"index"`
+
+	UserID uint   `gorm:"not null;index"`
+	Type   string `gorm:"size:32;not null"`
+	Data   string `gorm:"type:text;not null"`
+}
+
+func (EventModel) TableName() string {
+	return "realtime_events"
+}
@@ -0,0 +1,73 @@
+package realtime
+
+import (
+	"time"
+
+	domainRealtime "backend/domain/realtime"
+
+	"gorm.io/gorm"
+)
+
+// eventRepo は domain/realtime.IEventRepository の具象実装です
+type eventRepo struct {
+	db *gorm.DB
+}
+
+// NewEventRepository は GORM を使ったイベントリポジトリを生成します
+func NewEventRepository(db *gorm.DB) domainRealtime.IEventRepository {
+	return &eventRepo{db: db}
+}
+
+func (r *eventRepo) CreateEvent(e *domainRealtime.Event) error {
+	pm := EventModel{
+		CreatedAt: e.CreatedAt,
+		UserID:    e.UserID,
+		Type:      string(e.Type),
+		Data:      e.Data,
+	}
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	e.ID = pm.ID
+	e.CreatedAt = pm.CreatedAt
+	return nil
+}
+
+func (r *eventRepo) FindEventByID(id uint) (*domainRealtime.Event, error) {
+	var pm EventModel
+	if err := r.db.First(&pm, id).Error; err != nil {
+		return nil, err
+	}
+	return toDomain(&pm), nil
+}
+
+func (r *eventRepo) FindEventsAfter(userID uint, afterID uint, limit int) ([]*domainRealtime.Event, error) {
+	var pms []EventModel
+	if err := r.db.
+		Where("user_id = ? AND id > ?", userID, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	events := make([]*domainRealtime.Event, 0, len(pms))
+	for i := range pms {
+		events = append(events, toDomain(&pms[i]))
+	}
+	return events, nil
+}
+
+func (r *eventRepo) DeleteEventsBefore(cutoff time.Time) error {
+	return r.db.Where("created_at < ?", cutoff).Delete(&EventModel{}).Error
+}
+
+// toDomain は EventModel → domain.Event へのマッピング関数です
+func toDomain(pm *EventModel) *domainRealtime.Event {
+	return &domainRealtime.Event{
+		ID:        pm.ID,
+		UserID:    pm.UserID,
+		Type:      domainRealtime.EventType(pm.Type),
+		Data:      pm.Data,
+		CreatedAt: pm.CreatedAt,
+	}
+}
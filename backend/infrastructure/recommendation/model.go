@@ -0,0 +1,23 @@
+package recommendation
+
+// PostSimilarityModel は定期的に計算する「似ている投稿」です
+type PostSimilarityModel struct {
+	PostID        uint    `gorm:"primaryKey;autoIncrement:false"`
+	SimilarPostID uint    `gorm:"primaryKey;autoIncrement:false"`
+	Score         float64 `gorm:"not null"`
+}
+
+func (PostSimilarityModel) TableName() string {
+	return "post_similarities"
+}
+
+// UserRecommendationModel は定期的に計算する「あなたへのおすすめ」です
+type UserRecommendationModel struct {
+	UserID uint    `gorm:"primaryKey;autoIncrement:false"`
+	PostID uint    `gorm:"primaryKey;autoIncrement:false"`
+	Score  float64 `gorm:"not null"`
+}
+
+func (UserRecommendationModel) TableName() string {
+	return "user_recommendations"
+}
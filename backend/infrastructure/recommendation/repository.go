@@ -0,0 +1,115 @@
+package recommendation
+
+import (
+	domainEngagement "backend/domain/engagement"
+	domainRecommendation "backend/domain/recommendation"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// recommendationRepo は domain/recommendation.Repository の具象実装です
+// いいね・ブックマークは post_reactions から、スキル・希望職種は user_models から読み出します
+type recommendationRepo struct {
+	db *gorm.DB
+}
+
+// NewRecommendationRepo は GORM を使った推薦のリポジトリを生成します
+func NewRecommendationRepo(db *gorm.DB) domainRecommendation.Repository {
+	return &recommendationRepo{db: db}
+}
+
+func (r *recommendationRepo) GetInteractions() ([]domainRecommendation.Interaction, error) {
+	var rows []struct {
+		UserID uint
+		PostID uint
+	}
+	if err := r.db.Table("post_reactions").
+		Distinct("user_id", "post_id").
+		Where("kind IN ?", []domainEngagement.Kind{domainEngagement.KindLike, domainEngagement.KindBookmark}).
+		Order("user_id, post_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	interactions := make([]domainRecommendation.Interaction, 0, len(rows))
+	for _, row := range rows {
+		interactions = append(interactions, domainRecommendation.Interaction{UserID: row.UserID, PostID: row.PostID})
+	}
+	return interactions, nil
+}
+
+func (r *recommendationRepo) GetProfiles() ([]domainRecommendation.Profile, error) {
+	var rows []struct {
+		ID              uint
+		Skills          pq.StringArray `gorm:"type:text[]"`
+		DesiredJobTypes pq.StringArray `gorm:"type:text[]"`
+	}
+	if err := r.db.Table("user_models").
+		Select("id, skills, desired_job_types").
+		Where("deleted_at IS NULL").
+		Order("id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	profiles := make([]domainRecommendation.Profile, 0, len(rows))
+	for _, row := range rows {
+		profiles = append(profiles, domainRecommendation.Profile{
+			UserID:   row.ID,
+			Skills:   row.Skills,
+			JobTypes: row.DesiredJobTypes,
+		})
+	}
+	return profiles, nil
+}
+
+func (r *recommendationRepo) ReplaceSimilarities(similarities []domainRecommendation.Similarity) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&PostSimilarityModel{}).Error; err != nil {
+			return err
+		}
+		if len(similarities) == 0 {
+			return nil
+		}
+		sms := make([]PostSimilarityModel, 0, len(similarities))
+		for _, s := range similarities {
+			sms = append(sms, PostSimilarityModel{PostID: s.PostID, SimilarPostID: s.SimilarPostID, Score: s.Score})
+		}
+		return tx.CreateInBatches(sms, 500).Error
+	})
+}
+
+func (r *recommendationRepo) ReplaceRecommendations(recommendations []domainRecommendation.Recommendation) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&UserRecommendationModel{}).Error; err != nil {
+			return err
+		}
+		if len(recommendations) == 0 {
+			return nil
+		}
+		rms := make([]UserRecommendationModel, 0, len(recommendations))
+		for _, rec := range recommendations {
+			rms = append(rms, UserRecommendationModel{UserID: rec.UserID, PostID: rec.PostID, Score: rec.Score})
+		}
+		return tx.CreateInBatches(rms, 500).Error
+	})
+}
+
+func (r *recommendationRepo) GetSimilarPostIDs(postID uint, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&PostSimilarityModel{}).
+		Where("post_id = ?", postID).
+		Order("score DESC, similar_post_id DESC").
+		Limit(limit).
+		Pluck("similar_post_id", &ids).Error
+	return ids, err
+}
+
+func (r *recommendationRepo) GetRecommendedPostIDs(userID uint, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&UserRecommendationModel{}).
+		Where("user_id = ?", userID).
+		Order("score DESC, post_id DESC").
+		Limit(limit).
+		Pluck("post_id", &ids).Error
+	return ids, err
+}
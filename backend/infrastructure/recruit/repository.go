@@ -0,0 +1,152 @@
+package recruit
+
+import (
+	domainPortfolio "backend/domain/portfolio"
+	domainRecruit "backend/domain/recruit"
+	domainUser "backend/domain/user"
+	"backend/infrastructure/dbutil"
+	portfolioInfra "backend/infrastructure/portfolio"
+	userInfra "backend/infrastructure/user"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// 作品の集計で IN 句に渡すユーザー ID の数。DB のプレースホルダ数の上限を超えないように分割する
+const postQueryBatchSize = 1000
+
+// candidateRepo は domain/recruit.Repository の具象実装です
+type candidateRepo struct {
+	db *gorm.DB
+}
+
+// NewCandidateRepo は GORM を使った候補者検索リポジトリを生成します
+func NewCandidateRepo(db *gorm.DB) domainRecruit.Repository {
+	return &candidateRepo{db: db}
+}
+
+// SearchCandidates は公開設定を満たす学生を条件で絞り込み、作品のジャンル・スキルを集計して返します
+// 関連度は呼び出し側で計算するので、ここでは件数を切り詰めずに条件に合う全員を返す
+func (r *candidateRepo) SearchCandidates(c domainRecruit.SearchCriteria) ([]*domainRecruit.Candidate, error) {
+	q := r.db.Model(&userInfra.UserModel{}).
+		Where("role = ? AND is_verified = ?", string(domainUser.RoleStudent), true).
+		Where("profile_visibility = ? AND hidden_from_recruiters = ?", string(domainUser.VisibilityPublic), false).
+		Where("suspended_at IS NULL")
+
+	for _, jobType := range c.DesiredJobTypes {
+		q = dbutil.ArrayContains(q, "desired_job_types", jobType)
+	}
+	for _, skill := range c.Skills {
+		// プロフィールに書いたスキルか、作品で使ったスキルのどちらかに含まれていればよい
+		arg := dbutil.ArrayContainsArg(r.db, skill)
+		q = q.Where(
+			fmt.Sprintf("(%s OR %s)", dbutil.ArrayContainsExpr(r.db, "user_models.skills"), r.postExistsExpr("skills")),
+			arg, arg,
+		)
+	}
+	for _, genre := range c.PostGenres {
+		q = q.Where(r.postExistsExpr("genres"), dbutil.ArrayContainsArg(r.db, genre))
+	}
+	for _, skill := range c.PostSkills {
+		q = q.Where(r.postExistsExpr("skills"), dbutil.ArrayContainsArg(r.db, skill))
+	}
+	if c.GraduationYear != "" {
+		q = q.Where("graduation_year = ?", c.GraduationYear)
+	}
+	if c.SchoolName != "" {
+		q = dbutil.ContainsFold(q, "school_name", c.SchoolName)
+	}
+	if c.Keyword != "" {
+		q = q.Where(
+			dbutil.ContainsFold(r.db, "self_introduction", c.Keyword).
+				Or(dbutil.ContainsFold(r.db, "department", c.Keyword)).
+				Or(dbutil.ContainsFold(r.db, "laboratory", c.Keyword)),
+		)
+	}
+	if !c.RegisteredAfter.IsZero() {
+		q = q.Where("created_at > ?", c.RegisteredAfter)
+	}
+
+	var users []userInfra.UserModel
+	if err := q.Order("id ASC").Find(&users).Error; err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return []*domainRecruit.Candidate{}, nil
+	}
+
+	candidates := make([]*domainRecruit.Candidate, 0, len(users))
+	byUserID := make(map[uint]*domainRecruit.Candidate, len(users))
+	userIDs := make([]uint, 0, len(users))
+	for i := range users {
+		cand := &domainRecruit.Candidate{User: toPublicProfile(&users[i])}
+		candidates = append(candidates, cand)
+		byUserID[users[i].ID] = cand
+		userIDs = append(userIDs, users[i].ID)
+	}
+
+	// 作品のジャンル・スキルをユーザーごとに重複なく集計する
+	genreSeen := make(map[uint]map[string]bool)
+	skillSeen := make(map[uint]map[string]bool)
+	for start := 0; start < len(userIDs); start += postQueryBatchSize {
+		end := start + postQueryBatchSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+		var posts []portfolioInfra.PostModel
+		if err := r.db.
+			Select("user_id", "genres", "skills").
+			Where("user_id IN ? AND hidden_at IS NULL AND status = ?", userIDs[start:end], domainPortfolio.StatusPublished).
+			Find(&posts).Error; err != nil {
+			return nil, err
+		}
+		for _, p := range posts {
+			cand := byUserID[p.UserID]
+			cand.PostCount++
+			cand.PostGenres = appendUnique(cand.PostGenres, p.Genres, genreSeen, p.UserID)
+			cand.PostSkills = appendUnique(cand.PostSkills, p.Skills, skillSeen, p.UserID)
+		}
+	}
+	return candidates, nil
+}
+
+// postExistsExpr は「そのユーザーの作品のいずれかの column に値が含まれる」条件式です
+func (r *candidateRepo) postExistsExpr(column string) string {
+	return fmt.Sprintf(
+		"EXISTS (SELECT 1 FROM post_models p WHERE p.user_id = user_models.id AND p.deleted_at IS NULL AND p.hidden_at IS NULL AND p.status = 'published' AND %s)",
+		dbutil.ArrayContainsExpr(r.db, "p."+column),
+	)
+}
+
+func appendUnique(dst []string, values []string, seen map[uint]map[string]bool, userID uint) []string {
+	if seen[userID] == nil {
+		seen[userID] = make(map[string]bool)
+	}
+	for _, v := range values {
+		if !seen[userID][v] {
+			seen[userID][v] = true
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// toPublicProfile は企業に見せてよいプロフィール項目だけをドメインモデルに詰めます
+// メールアドレスや認証情報は含めません
+func toPublicProfile(pm *userInfra.UserModel) domainUser.UserModel {
+	return domainUser.UserModel{
+		ID:               pm.ID,
+		FirstName:        pm.FirstName,
+		LastName:         pm.LastName,
+		FirstNameKana:    pm.FirstNameKana,
+		LastNameKana:     pm.LastNameKana,
+		ProfileImageURL:  pm.ProfileImageURL,
+		SelfIntroduction: pm.SelfIntroduction,
+		SchoolName:       pm.SchoolName,
+		Department:       pm.Department,
+		Laboratory:       pm.Laboratory,
+		GraduationYear:   pm.GraduationYear,
+		DesiredJobTypes:  []string(pm.DesiredJobTypes),
+		Skills:           []string(pm.Skills),
+	}
+}
@@ -0,0 +1,519 @@
+package resume
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	domainResume "backend/domain/resume"
+
+	"github.com/signintech/gopdf"
+)
+
+// mplusRegular は日本語を含む文字を表示するために埋め込むフォントです（M+ FONTS。ライセンスは fonts/LICENSE）
+// PDF には使った文字だけを埋め込む
+//
+//go:embed fonts/mplus-1p-regular.ttf
+var mplusRegular []byte
+
+const (
+	fontFamily = "mplus"
+
+	pageWidth  = 595.28 // A4（pt）
+	pageHeight = 841.89
+	margin     = 40.0
+	bodyWidth  = pageWidth - margin*2
+
+	bodySize    = 9.5
+	lineSpacing = 1.5
+
+	maxDescriptionLength = 240 // 作品の説明文は先頭のこの文字数だけ載せる
+	uploadDir            = "uploads"
+)
+
+type rgb struct{ r, g, b uint8 }
+
+var (
+	black = rgb{0x22, 0x22, 0x22}
+	gray  = rgb{0x70, 0x70, 0x70}
+	rule  = rgb{0xc8, 0xc8, 0xc8}
+	white = rgb{0xff, 0xff, 0xff}
+)
+
+// layout は体裁ごとの違いです
+type layout struct {
+	accent     rgb
+	banner     bool    // 氏名を色付きの帯に載せる
+	thumbWidth float64 // 作品のサムネイルの幅。0 なら載せない
+	thumbAbove bool    // サムネイルを説明文の上に大きく載せる（false なら左に小さく）
+}
+
+var layouts = map[domainResume.Template]layout{
+	domainResume.TemplateStandard: {accent: black, thumbWidth: 96},
+	domainResume.TemplateModern:   {accent: rgb{0x1f, 0x5f, 0xa8}, banner: true, thumbWidth: 240, thumbAbove: true},
+}
+
+// pdfRenderer は gopdf で A4 の履歴書を組む Renderer です。外部のサービスやコマンドは使わない
+type pdfRenderer struct {
+	root string
+}
+
+// NewPDFRenderer は Renderer を生成します
+// root は作品の画像（uploads/ 以下の相対パス）を読み込む起点のディレクトリで、空ならカレントディレクトリ
+func NewPDFRenderer(root string) domainResume.Renderer {
+	return &pdfRenderer{root: root}
+}
+
+func (r *pdfRenderer) Render(w io.Writer, resume *domainResume.Resume, t domainResume.Template) error {
+	l, ok := layouts[t]
+	if !ok {
+		return fmt.Errorf("unknown resume template: %s", t)
+	}
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{PageSize: *gopdf.PageSizeA4})
+	pdf.SetInfo(gopdf.PdfInfo{Title: "履歴書 " + resume.FullName(), CreationDate: resume.GeneratedAt})
+	if err := pdf.AddTTFFontData(fontFamily, mplusRegular); err != nil {
+		return err
+	}
+	d := &document{pdf: pdf, layout: l, root: r.root}
+	d.newPage()
+
+	steps := []func(*domainResume.Resume) error{
+		d.header,
+		d.careers,
+		d.skills,
+		d.introduction,
+		d.posts,
+	}
+	for _, step := range steps {
+		if err := step(resume); err != nil {
+			return err
+		}
+	}
+	return pdf.Write(w)
+}
+
+// document はページ送りをしながら上から順に書き込みます
+type document struct {
+	pdf    *gopdf.GoPdf
+	layout layout
+	root   string
+	page   int
+	y      float64
+}
+
+func (d *document) newPage() {
+	d.pdf.AddPage()
+	d.page++
+	d.y = margin
+}
+
+// ensure は高さ h が今のページに収まらなければ改ページします
+func (d *document) ensure(h float64) {
+	if d.y+h > pageHeight-margin {
+		d.newPage()
+	}
+}
+
+func (d *document) setFont(size float64, c rgb) error {
+	d.pdf.SetTextColor(c.r, c.g, c.b)
+	return d.pdf.SetFont(fontFamily, "", size)
+}
+
+// write は x から幅 width に収まるよう折り返して書き、書いた高さを返します
+func (d *document) write(x, width, size float64, c rgb, s string) (float64, error) {
+	if strings.TrimSpace(s) == "" {
+		return 0, nil
+	}
+	if err := d.setFont(size, c); err != nil {
+		return 0, err
+	}
+	lineHeight := size * lineSpacing
+	var total float64
+	for _, paragraph := range strings.Split(s, "\n") {
+		if paragraph == "" {
+			continue
+		}
+		lines, err := d.wrap(paragraph, width)
+		if err != nil {
+			return 0, err
+		}
+		for _, line := range lines {
+			d.ensure(lineHeight)
+			d.pdf.SetXY(x, d.y)
+			if err := d.pdf.Cell(&gopdf.Rect{W: width, H: lineHeight}, line); err != nil {
+				return 0, err
+			}
+			d.y += lineHeight
+			total += lineHeight
+		}
+	}
+	return total, nil
+}
+
+// wrap は s を幅 width に収まる行に分けます
+// 英数字の語は途中で折り返さず、句読点・閉じ括弧は行頭に来ないよう前の文字と一緒に送る（禁則処理）
+// 1 語だけで幅を超える場合は文字単位で折り返す
+func (d *document) wrap(s string, width float64) ([]string, error) {
+	var lines []string
+	var line string
+	for _, unit := range breakUnits(s) {
+		candidate := line + unit
+		if line == "" {
+			candidate = strings.TrimLeft(unit, " ")
+		}
+		w, err := d.pdf.MeasureTextWidth(candidate)
+		if err != nil {
+			return nil, err
+		}
+		if w <= width || line == "" && utf8.RuneCountInString(candidate) <= 1 {
+			line = candidate
+			continue
+		}
+		if line != "" {
+			lines = append(lines, strings.TrimRight(line, " "))
+		}
+		line = strings.TrimLeft(unit, " ")
+		if w, err = d.pdf.MeasureTextWidth(line); err != nil {
+			return nil, err
+		}
+		if w > width {
+			split, err := d.pdf.SplitText(line, width)
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, split[:len(split)-1]...)
+			line = split[len(split)-1]
+		}
+	}
+	if line = strings.TrimRight(line, " "); line != "" {
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// noLineStart は行頭に置かない文字です
+const noLineStart = "、。，．,.）)」』】〕〉》！!？?：:；;ー々ぁぃぅぇぉっゃゅょァィゥェォッャュョ・…"
+
+// breakUnits は s を折り返しの単位（英数字の語・空白・それ以外の 1 文字）に分けます
+// 行頭に置かない文字は前の単位につなげる
+func breakUnits(s string) []string {
+	var units []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			units = append(units, string(word))
+			word = word[:0]
+		}
+	}
+	for _, r := range s {
+		switch {
+		case strings.ContainsRune(noLineStart, r) && (len(word) > 0 || len(units) > 0):
+			if len(word) > 0 {
+				word = append(word, r)
+			} else {
+				units[len(units)-1] += string(r)
+			}
+		case r < utf8.RuneSelf && r != ' ':
+			word = append(word, r)
+		default:
+			flush()
+			units = append(units, string(r))
+		}
+	}
+	flush()
+	return units
+}
+
+func (d *document) line(x1, x2 float64, c rgb, width float64) {
+	d.pdf.SetStrokeColor(c.r, c.g, c.b)
+	d.pdf.SetLineWidth(width)
+	d.pdf.Line(x1, d.y, x2, d.y)
+}
+
+// heading は節の見出しです。見出しだけがページ末に残らないよう、続く 3 行分の余白も確保する
+func (d *document) heading(title string) error {
+	d.y += 14
+	d.ensure(18 + bodySize*lineSpacing*3)
+	if _, err := d.write(margin, bodyWidth, 12, d.layout.accent, title); err != nil {
+		return err
+	}
+	d.y += 2
+	d.line(margin, pageWidth-margin, d.layout.accent, 0.8)
+	d.y += 6
+	return nil
+}
+
+// row は左に項目名（年月など）、右に内容を書く 1 行です
+func (d *document) row(label, content string) error {
+	const labelWidth = 120.0
+	d.ensure(bodySize * lineSpacing)
+	top, page := d.y, d.page
+	if _, err := d.write(margin, labelWidth-8, bodySize, gray, label); err != nil {
+		return err
+	}
+	labelBottom := d.y
+	d.y = top
+	if _, err := d.write(margin+labelWidth, bodyWidth-labelWidth, bodySize, black, content); err != nil {
+		return err
+	}
+	// 内容が次のページに続いた場合は、前のページの項目名の高さは関係ない
+	if d.page == page && labelBottom > d.y {
+		d.y = labelBottom
+	}
+	d.y += 2
+	return nil
+}
+
+func (d *document) header(r *domainResume.Resume) error {
+	u := r.User
+	school := strings.Join(nonEmpty(u.SchoolName, u.Department, u.Laboratory), " ")
+	if u.GraduationYear != "" {
+		school = strings.TrimSpace(school + fmt.Sprintf("（%s年卒業予定）", u.GraduationYear))
+	}
+	generated := fmt.Sprintf("%d年%d月%d日現在", r.GeneratedAt.Year(), int(r.GeneratedAt.Month()), r.GeneratedAt.Day())
+
+	if d.layout.banner {
+		const bannerHeight = 96.0
+		c := d.layout.accent
+		d.pdf.SetFillColor(c.r, c.g, c.b)
+		d.pdf.RectFromUpperLeftWithStyle(0, 0, pageWidth, bannerHeight, "F")
+		d.y = 24
+		if _, err := d.write(margin, bodyWidth, 9, white, r.FullNameKana()); err != nil {
+			return err
+		}
+		if _, err := d.write(margin, bodyWidth, 22, white, r.FullName()); err != nil {
+			return err
+		}
+		if _, err := d.write(margin, bodyWidth, 9.5, white, school); err != nil {
+			return err
+		}
+		d.y = bannerHeight + 10
+		_, err := d.write(margin, bodyWidth, 8.5, gray, strings.Join(nonEmpty(u.Email, generated), "　"))
+		return err
+	}
+
+	if _, err := d.write(margin, bodyWidth, 20, black, "履歴書"); err != nil {
+		return err
+	}
+	if _, err := d.write(margin, bodyWidth, 8.5, gray, generated); err != nil {
+		return err
+	}
+	d.y += 6
+	d.line(margin, pageWidth-margin, black, 1)
+	d.y += 6
+	for _, field := range [][2]string{
+		{"ふりがな", r.FullNameKana()},
+		{"氏名", r.FullName()},
+		{"メールアドレス", u.Email},
+		{"学校", school},
+	} {
+		if field[1] == "" {
+			continue
+		}
+		if err := d.row(field[0], field[1]); err != nil {
+			return err
+		}
+		d.line(margin, pageWidth-margin, rule, 0.5)
+		d.y += 4
+	}
+	return nil
+}
+
+func (d *document) careers(r *domainResume.Resume) error {
+	if len(r.Educations) == 0 && len(r.Experiences) == 0 {
+		return nil
+	}
+	if err := d.heading("学歴・職歴"); err != nil {
+		return err
+	}
+	for _, e := range r.Educations {
+		content := strings.Join(nonEmpty(e.SchoolName, e.Department, e.Laboratory), " ")
+		if label := domainResume.DegreeLabel(e.Degree); label != "" {
+			content += "（" + label + "）"
+		}
+		if err := d.row(period(domainResume.FormatMonth(e.StartDate), domainResume.FormatMonth(e.EndDate), ""), content); err != nil {
+			return err
+		}
+	}
+	for _, e := range r.Experiences {
+		content := strings.Join(nonEmpty(e.CompanyName, e.Role), " ")
+		if label := domainResume.ExperienceTypeLabel(e.Type); label != "" {
+			content += "（" + label + "）"
+		}
+		if e.Description != "" {
+			content += "\n" + truncate(plainText(e.Description), maxDescriptionLength)
+		}
+		if err := d.row(period(domainResume.FormatMonth(&e.StartDate), domainResume.FormatMonth(e.EndDate), "現在"), content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *document) skills(r *domainResume.Resume) error {
+	if len(r.Skills) > 0 {
+		if err := d.heading("スキル"); err != nil {
+			return err
+		}
+		for _, s := range r.Skills {
+			var detail []string
+			if label := domainResume.LevelLabel(s.Level); label != "" {
+				detail = append(detail, label)
+			}
+			if s.YearsOfExperience > 0 {
+				detail = append(detail, fmt.Sprintf("経験%s年", trimFloat(s.YearsOfExperience)))
+			}
+			if err := d.row(s.Name, strings.Join(detail, "・")); err != nil {
+				return err
+			}
+		}
+	}
+	if len(r.User.DesiredJobTypes) > 0 {
+		if err := d.heading("希望職種"); err != nil {
+			return err
+		}
+		if _, err := d.write(margin, bodyWidth, bodySize, black, strings.Join(r.User.DesiredJobTypes, "、")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *document) introduction(r *domainResume.Resume) error {
+	text := plainText(r.User.SelfIntroduction)
+	if text == "" {
+		return nil
+	}
+	if err := d.heading("自己紹介"); err != nil {
+		return err
+	}
+	_, err := d.write(margin, bodyWidth, bodySize, black, text)
+	return err
+}
+
+func (d *document) posts(r *domainResume.Resume) error {
+	if len(r.Posts) == 0 {
+		return nil
+	}
+	if err := d.heading("作品"); err != nil {
+		return err
+	}
+	for i, p := range r.Posts {
+		if i > 0 {
+			d.y += 10
+		}
+		var meta []string
+		if p.Role != "" {
+			meta = append(meta, p.Role)
+		}
+		if p.StartDate != nil {
+			meta = append(meta, period(domainResume.FormatMonth(p.StartDate), domainResume.FormatMonth(p.EndDate), "開発中"))
+		}
+		if len(p.Skills) > 0 {
+			meta = append(meta, strings.Join(p.Skills, ", "))
+		}
+		description := truncate(plainText(p.Description), maxDescriptionLength)
+
+		var thumb gopdf.ImageHolder
+		var thumbWidth, thumbHeight float64
+		if cover, ok := p.CoverImage(); ok && d.layout.thumbWidth > 0 {
+			thumb, thumbWidth, thumbHeight = d.loadImage(cover.URL, d.layout.thumbWidth)
+		}
+
+		x, width := margin, bodyWidth
+		top, page := d.y, d.page
+		if thumb != nil {
+			if d.layout.thumbAbove {
+				d.ensure(thumbHeight + 20)
+				if err := d.pdf.ImageByHolder(thumb, margin, d.y, &gopdf.Rect{W: thumbWidth, H: thumbHeight}); err != nil {
+					return err
+				}
+				d.y += thumbHeight + 6
+			} else {
+				d.ensure(thumbHeight)
+				top, page = d.y, d.page
+				if err := d.pdf.ImageByHolder(thumb, margin, d.y, &gopdf.Rect{W: thumbWidth, H: thumbHeight}); err != nil {
+					return err
+				}
+				x = margin + d.layout.thumbWidth + 12
+				width = bodyWidth - d.layout.thumbWidth - 12
+			}
+		}
+		if _, err := d.write(x, width, 11, black, p.Title); err != nil {
+			return err
+		}
+		if _, err := d.write(x, width, 8.5, gray, strings.Join(meta, "　")); err != nil {
+			return err
+		}
+		if _, err := d.write(x, width, bodySize, black, description); err != nil {
+			return err
+		}
+		if thumb != nil && !d.layout.thumbAbove && d.page == page && d.y < top+thumbHeight {
+			d.y = top + thumbHeight
+		}
+	}
+	return nil
+}
+
+// loadImage は uploads/ 以下の画像を読み込み、幅 maxWidth に収めた大きさとともに返します
+// 読み込めない画像・JPEG と PNG 以外の画像は載せない（nil を返す）
+func (d *document) loadImage(path string, maxWidth float64) (gopdf.ImageHolder, float64, float64) {
+	clean := filepath.Clean(filepath.FromSlash(strings.TrimPrefix(path, "/")))
+	if !strings.HasPrefix(clean, uploadDir+string(filepath.Separator)) {
+		return nil, 0, 0
+	}
+	data, err := os.ReadFile(filepath.Join(d.root, clean))
+	if err != nil {
+		return nil, 0, 0
+	}
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || (format != "jpeg" && format != "png") || cfg.Width == 0 || cfg.Height == 0 {
+		return nil, 0, 0
+	}
+	holder, err := gopdf.ImageHolderByBytes(data)
+	if err != nil {
+		return nil, 0, 0
+	}
+	width := maxWidth
+	height := width * float64(cfg.Height) / float64(cfg.Width)
+	// 縦長の画像でページを占有しないよう、高さは最大幅の 3/4 までに縮める
+	if max := maxWidth * 0.75; height > max {
+		width = width * max / height
+		height = max
+	}
+	return holder, width, height
+}
+
+// period は「開始〜終了」です。終了がなければ ongoing を使う
+func period(start, end, ongoing string) string {
+	if start == "" {
+		return end
+	}
+	if end == "" {
+		end = ongoing
+	}
+	return start + "〜" + end
+}
+
+func nonEmpty(values ...string) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func trimFloat(f float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.1f", f), "0"), ".")
+}
@@ -0,0 +1,80 @@
+package resume
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	domainCareer "backend/domain/career"
+	domainPortfolio "backend/domain/portfolio"
+	domainResume "backend/domain/resume"
+	domainUser "backend/domain/user"
+	domainUserSkill "backend/domain/userskill"
+)
+
+func TestPlainText(t *testing.T) {
+	got := plainText("# 見出し\n\n**Go** と [React](https://react.dev) で作りました。\n\n- API\n- 画面\n\n```go\nfunc main() {}\n```\n")
+	want := "見出し\nGo と React で作りました。\n・API\n・画面\nfunc main() {}"
+	if got != want {
+		t.Errorf("plainText() = %q, want %q", got, want)
+	}
+}
+
+func TestPDFRenderer_Render(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "uploads", "PortfolioImages")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	img := image.NewRGBA(image.Rect(0, 0, 40, 30))
+	img.Set(1, 1, color.RGBA{R: 0xff, A: 0xff})
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cover.png"), buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+	resume := &domainResume.Resume{
+		User: &domainUser.UserModel{
+			FirstName: "太郎", LastName: "山田", FirstNameKana: "たろう", LastNameKana: "やまだ",
+			Email: "taro@example.com", SchoolName: "○○大学", Department: "情報工学科", GraduationYear: "2026",
+			SelfIntroduction: strings.Repeat("Go と TypeScript で Web アプリを作っています。", 80),
+			DesiredJobTypes:  []string{"バックエンドエンジニア"},
+		},
+		Skills:     []*domainUserSkill.UserSkill{{Name: "Go", Level: domainUserSkill.LevelAdvanced, YearsOfExperience: 2.5}},
+		Educations: []*domainCareer.Education{{SchoolName: "○○大学", Degree: domainCareer.DegreeBachelor, StartDate: &start}},
+		Posts: []*domainPortfolio.Post{
+			{ID: 1, Title: "タスク管理アプリ", Description: "チームで開発しました", Images: []domainPortfolio.Image{{URL: "uploads/PortfolioImages/cover.png", IsCover: true}}},
+			{ID: 2, Title: "画像のない作品", Images: []domainPortfolio.Image{{URL: "../secret.png", IsCover: true}}},
+		},
+		GeneratedAt: start,
+	}
+
+	r := NewPDFRenderer(root)
+	for _, tmpl := range domainResume.Templates {
+		var out bytes.Buffer
+		if err := r.Render(&out, resume, tmpl); err != nil {
+			t.Fatalf("Render(%s) failed: %v", tmpl, err)
+		}
+		if !bytes.HasPrefix(out.Bytes(), []byte("%PDF-")) {
+			t.Errorf("Render(%s) did not produce a PDF", tmpl)
+		}
+		// 長い自己紹介は次のページに送られる
+		if n := bytes.Count(out.Bytes(), []byte("/Type /Page\n")); n < 2 {
+			t.Errorf("Render(%s) produced %d pages, want at least 2", tmpl, n)
+		}
+		// フォントは使った文字だけを埋め込む
+		if out.Len() > 1<<20 {
+			t.Errorf("Render(%s) produced %d bytes; the font should be subset", tmpl, out.Len())
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package resume
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+)
+
+var markdownParser = goldmark.New(goldmark.WithExtensions(extension.Table, extension.Strikethrough))
+
+// plainText は Markdown を PDF に載せる平文にします
+// 段落・見出し・コードブロックは改行で区切り、リストの項目には「・」を付ける。リンクは文字だけを残す
+func plainText(source string) string {
+	src := []byte(source)
+	doc := markdownParser.Parser().Parse(text.NewReader(src))
+
+	var b strings.Builder
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		switch node := n.(type) {
+		case *ast.ListItem:
+			if entering {
+				b.WriteString("・")
+			}
+		case *ast.Text:
+			if entering {
+				b.Write(node.Segment.Value(src))
+				if node.SoftLineBreak() || node.HardLineBreak() {
+					b.WriteString("\n")
+				}
+			}
+		case *ast.String:
+			if entering {
+				b.Write(node.Value)
+			}
+		case *ast.CodeSpan:
+			if entering {
+				for c := node.FirstChild(); c != nil; c = c.NextSibling() {
+					if t, ok := c.(*ast.Text); ok {
+						b.Write(t.Segment.Value(src))
+					}
+				}
+				return ast.WalkSkipChildren, nil
+			}
+		case *ast.FencedCodeBlock, *ast.CodeBlock:
+			if entering {
+				lines := n.Lines()
+				for i := 0; i < lines.Len(); i++ {
+					line := lines.At(i)
+					b.Write(line.Value(src))
+				}
+				return ast.WalkSkipChildren, nil
+			}
+		case *ast.Paragraph, *ast.Heading, *ast.TextBlock:
+			if !entering {
+				b.WriteString("\n")
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
+	// 空行が続かないように詰める
+	lines := strings.Split(b.String(), "\n")
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimRight(line, " \t")
+		if line != "" {
+			out = append(out, line)
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// truncate は s を max 文字までに切り詰めます。切り詰めた場合は末尾に「…」を付ける
+func truncate(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	runes := []rune(s)
+	return strings.TrimSpace(string(runes[:max])) + "…"
+}
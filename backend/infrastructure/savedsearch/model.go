@@ -0,0 +1,32 @@
+package savedsearch
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// SavedSearchModel は GORM タグ付きの永続化用モデルです
+type SavedSearchModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	UserID uint   `gorm:"not null;index"`
+	Name   string `gorm:"size:255;not null"`
+	Target string `gorm:"size:32;not null"`
+
+	Keyword        string         `gorm:"size:255"`
+	Genres         pq.StringArray `gorm:"type:text[]"`
+	Skills         pq.StringArray `gorm:"type:text[]"`
+	GraduationYear string         `gorm:"size:4"`
+
+	Frequency        string    `gorm:"size:16;not null"`
+	Active           bool      `gorm:"not null;index"`
+	UnsubscribeToken string    `gorm:"size:64;not null;uniqueIndex"`
+	LastRunAt        time.Time `gorm:"not null"`
+}
+
+func (SavedSearchModel) TableName() string {
+	return "saved_searches"
+}
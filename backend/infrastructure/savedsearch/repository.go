@@ -0,0 +1,124 @@
+package savedsearch
+
+import (
+	domainSavedSearch "backend/domain/savedsearch"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// savedSearchRepo は domain/savedsearch.Repository の具象実装です
+type savedSearchRepo struct {
+	db *gorm.DB
+}
+
+// NewSavedSearchRepo は GORM を使った保存検索リポジトリを生成します
+func NewSavedSearchRepo(db *gorm.DB) domainSavedSearch.Repository {
+	return &savedSearchRepo{db: db}
+}
+
+func (r *savedSearchRepo) CreateSavedSearch(s *domainSavedSearch.SavedSearch) error {
+	pm := toPersistence(s)
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	s.ID = pm.ID
+	s.CreatedAt = pm.CreatedAt
+	s.UpdatedAt = pm.UpdatedAt
+	return nil
+}
+
+func (r *savedSearchRepo) UpdateSavedSearch(s *domainSavedSearch.SavedSearch) error {
+	pm := toPersistence(s)
+	return r.db.Save(&pm).Error
+}
+
+func (r *savedSearchRepo) DeleteSavedSearch(id uint) error {
+	return r.db.Delete(&SavedSearchModel{}, id).Error
+}
+
+func (r *savedSearchRepo) GetSavedSearchByID(id uint) (*domainSavedSearch.SavedSearch, error) {
+	var pm SavedSearchModel
+	if err := r.db.First(&pm, id).Error; err != nil {
+		return nil, err
+	}
+	return toDomain(&pm), nil
+}
+
+func (r *savedSearchRepo) GetSavedSearchesByUserID(userID uint) ([]*domainSavedSearch.SavedSearch, error) {
+	var pms []SavedSearchModel
+	if err := r.db.
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	return toDomains(pms), nil
+}
+
+func (r *savedSearchRepo) FindByUnsubscribeToken(token string) (*domainSavedSearch.SavedSearch, error) {
+	var pm SavedSearchModel
+	if err := r.db.Where("unsubscribe_token = ?", token).First(&pm).Error; err != nil {
+		return nil, err
+	}
+	return toDomain(&pm), nil
+}
+
+func (r *savedSearchRepo) GetActiveSavedSearches() ([]*domainSavedSearch.SavedSearch, error) {
+	var pms []SavedSearchModel
+	if err := r.db.
+		Where("active = ?", true).
+		Order("id ASC").
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	return toDomains(pms), nil
+}
+
+// toDomain は SavedSearchModel → domain.SavedSearch へのマッピング関数です
+func toDomain(pm *SavedSearchModel) *domainSavedSearch.SavedSearch {
+	return &domainSavedSearch.SavedSearch{
+		ID:               pm.ID,
+		UserID:           pm.UserID,
+		Name:             pm.Name,
+		Target:           domainSavedSearch.Target(pm.Target),
+		Keyword:          pm.Keyword,
+		Genres:           []string(pm.Genres),
+		Skills:           []string(pm.Skills),
+		GraduationYear:   pm.GraduationYear,
+		Frequency:        domainSavedSearch.Frequency(pm.Frequency),
+		Active:           pm.Active,
+		UnsubscribeToken: pm.UnsubscribeToken,
+		LastRunAt:        pm.LastRunAt,
+		CreatedAt:        pm.CreatedAt,
+		UpdatedAt:        pm.UpdatedAt,
+	}
+}
+
+func toDomains(pms []SavedSearchModel) []*domainSavedSearch.SavedSearch {
+	searches := make([]*domainSavedSearch.SavedSearch, 0, len(pms))
+	for i := range pms {
+		searches = append(searches, toDomain(&pms[i]))
+	}
+	return searches
+}
+
+// toPersistence は domain.SavedSearch → SavedSearchModel へのマッピング関数です
+func toPersistence(s *domainSavedSearch.SavedSearch) SavedSearchModel {
+	return SavedSearchModel{
+		ID:               s.ID,
+		CreatedAt:        s.CreatedAt,
+		UpdatedAt:        s.UpdatedAt,
+		UserID:           s.UserID,
+		Name:             s.Name,
+		Target:           string(s.Target),
+		Keyword:          s.Keyword,
+		Genres:           pq.StringArray(s.Genres),
+		Skills:           pq.StringArray(s.Skills),
+		GraduationYear:   s.GraduationYear,
+		Frequency:        string(s.Frequency),
+		Active:           s.Active,
+		UnsubscribeToken: s.UnsubscribeToken,
+		LastRunAt:        s.LastRunAt,
+	}
+}
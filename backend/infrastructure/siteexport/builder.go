@@ -0,0 +1,336 @@
+package siteexport
+
+import (
+	"archive/zip"
+	domainExternalLink "backend/domain/externallink"
+	domainPortfolio "backend/domain/portfolio"
+	domainResume "backend/domain/resume"
+	domainSiteExport "backend/domain/siteexport"
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// templates/ は全テーマ共通のページの HTML、themes/ はテーマごとのスタイルシート
+//
+//go:embed templates/*.html themes/*.css
+var assets embed.FS
+
+const (
+	uploadDir = "uploads"
+	// maxImageSize を超える画像は ZIP に入れない（アップロード時の上限より大きいものは想定しない）
+	maxImageSize = 10 << 20
+)
+
+// imageExtensions は ZIP に入れる画像の種類と拡張子です。Content-Type は中身から判定する
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// linkLabels はプロフィールのリンクに表示するサービス名です
+var linkLabels = map[domainExternalLink.Type]string{
+	domainExternalLink.TypeGitHub:   "GitHub",
+	domainExternalLink.TypeX:        "X",
+	domainExternalLink.TypeZenn:     "Zenn",
+	domainExternalLink.TypeQiita:    "Qiita",
+	domainExternalLink.TypeBlog:     "Blog",
+	domainExternalLink.TypeLinkedIn: "LinkedIn",
+}
+
+// zipBuilder は domain/siteexport.Builder の具象実装です
+// html/template でページを作り、作品の画像と一緒に 1 つの ZIP にまとめます
+type zipBuilder struct {
+	root  string
+	pages map[string]*template.Template // ページ名 → layout.html と組み合わせたテンプレート
+}
+
+// NewZipBuilder は静的サイトの ZIP を作る Builder を生成します
+// root は作品の画像（uploads/ 以下の相対パス）を読み込む起点のディレクトリで、空ならカレントディレクトリ
+func NewZipBuilder(root string) domainSiteExport.Builder {
+	layout := template.Must(template.ParseFS(assets, "templates/layout.html"))
+	pages := make(map[string]*template.Template, 2)
+	for _, name := range []string{"index.html", "post.html"} {
+		pages[name] = template.Must(template.Must(layout.Clone()).ParseFS(assets, "templates/"+name))
+	}
+	return &zipBuilder{root: root, pages: pages}
+}
+
+// siteData はページの描画と data.json に使うサイトの中身です
+// 画像のパスは ZIP 内のサイトのルートからの相対パスで、ページからは pageData.Root を前に付けて参照する
+type siteData struct {
+	Profile     profileData `json:"profile"`
+	Posts       []postData  `json:"posts"`
+	GeneratedAt string      `json:"generatedAt"`
+	GeneratedOn string      `json:"-"` // ページのフッターに出す日付
+}
+
+type profileData struct {
+	Name             string        `json:"name"`
+	NameKana         string        `json:"nameKana,omitempty"`
+	Image            string        `json:"image,omitempty"`
+	SchoolName       string        `json:"schoolName,omitempty"`
+	Department       string        `json:"department,omitempty"`
+	Laboratory       string        `json:"laboratory,omitempty"`
+	GraduationYear   string        `json:"graduationYear,omitempty"`
+	DesiredJobTypes  []string      `json:"desiredJobTypes"`
+	Introduction     string        `json:"introduction,omitempty"` // Markdown の原文
+	IntroductionHTML template.HTML `json:"introductionHtml,omitempty"`
+	Skills           []skillData   `json:"skills"`
+	Links            []linkData    `json:"links"`
+}
+
+type skillData struct {
+	Name  string `json:"name"`
+	Level string `json:"level,omitempty"`
+}
+
+type linkData struct {
+	Type  string `json:"type"`
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
+
+type postData struct {
+	ID              uint          `json:"id"`
+	Page            string        `json:"page"`
+	Title           string        `json:"title"`
+	Description     string        `json:"description"` // Markdown の原文
+	DescriptionHTML template.HTML `json:"descriptionHtml"`
+	Genres          []string      `json:"genres"`
+	Skills          []string      `json:"skills"`
+	Images          []imageData   `json:"images"`
+	Cover           string        `json:"cover,omitempty"`
+	RepositoryURL   string        `json:"repositoryUrl,omitempty"`
+	DemoURL         string        `json:"demoUrl,omitempty"`
+	Role            string        `json:"role,omitempty"`
+	TeamSize        int           `json:"teamSize,omitempty"`
+	Period          string        `json:"period,omitempty"`
+	TechStack       []string      `json:"techStack"`
+	PublishedAt     string        `json:"publishedAt,omitempty"`
+	OriginalURL     string        `json:"originalUrl,omitempty"`
+}
+
+type imageData struct {
+	Path    string `json:"path"`
+	Alt     string `json:"alt"`
+	Caption string `json:"caption,omitempty"`
+}
+
+// pageData は 1 ページの描画に渡す値です
+type pageData struct {
+	Root  string // サイトのルートへの相対パス（"" か "../"）
+	Title string
+	Site  *siteData
+	Post  *postData
+}
+
+// file は ZIP に入れる 1 ファイルです
+type file struct {
+	name     string
+	data     []byte
+	compress bool
+}
+
+func (b *zipBuilder) Build(w io.Writer, site *domainSiteExport.Site, theme domainSiteExport.Theme) error {
+	style, err := assets.ReadFile("themes/" + string(theme) + ".css")
+	if err != nil {
+		return fmt.Errorf("サイトのテーマが見つかりません: %s", theme)
+	}
+
+	var files []file
+	addImage := func(src, name string) string {
+		data, ext, ok := b.readImage(src)
+		if !ok {
+			return ""
+		}
+		path := "images/" + name + ext
+		files = append(files, file{name: path, data: data})
+		return path
+	}
+
+	data := b.siteData(site, addImage)
+
+	index, err := b.render("index.html", pageData{Title: data.Profile.Name, Site: data})
+	if err != nil {
+		return err
+	}
+	files = append(files, file{name: "index.html", data: index, compress: true})
+	for i := range data.Posts {
+		post := &data.Posts[i]
+		page, err := b.render("post.html", pageData{Root: "../", Title: post.Title, Site: data, Post: post})
+		if err != nil {
+			return err
+		}
+		files = append(files, file{name: post.Page, data: page, compress: true})
+	}
+
+	// data.json は人が読んだり他のツールで使ったりするので、HTML の記号をエスケープしない
+	var dataJSON bytes.Buffer
+	enc := json.NewEncoder(&dataJSON)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(data); err != nil {
+		return err
+	}
+	files = append(files,
+		file{name: "assets/style.css", data: style, compress: true},
+		file{name: "data.json", data: dataJSON.Bytes(), compress: true},
+		// GitHub Pages で Jekyll の変換をさせず、そのまま公開させる
+		file{name: ".nojekyll", data: nil},
+	)
+
+	zw := zip.NewWriter(w)
+	for _, f := range files {
+		header := &zip.FileHeader{Name: f.name, Modified: site.GeneratedAt}
+		// 画像はすでに圧縮されているのでそのまま入れる
+		if f.compress {
+			header.Method = zip.Deflate
+		}
+		fw, err := zw.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write(f.data); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func (b *zipBuilder) render(name string, data pageData) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := b.pages[name].ExecuteTemplate(&buf, "layout", data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// siteData はドメインの値をページ用の値に変換します。addImage は画像を ZIP に追加し、ZIP 内のパスを返す（読めなければ空文字）
+func (b *zipBuilder) siteData(site *domainSiteExport.Site, addImage func(src, name string) string) *siteData {
+	u := site.User
+	profile := profileData{
+		Name:             strings.TrimSpace(u.LastName + " " + u.FirstName),
+		NameKana:         strings.TrimSpace(u.LastNameKana + " " + u.FirstNameKana),
+		SchoolName:       u.SchoolName,
+		Department:       u.Department,
+		Laboratory:       u.Laboratory,
+		GraduationYear:   u.GraduationYear,
+		DesiredJobTypes:  nonNil(u.DesiredJobTypes),
+		Introduction:     u.SelfIntroduction,
+		IntroductionHTML: template.HTML(u.SelfIntroductionHTML), // 保存時と同じくサニタイズ済み
+		Skills:           make([]skillData, 0, len(site.Skills)),
+		Links:            make([]linkData, 0, len(site.Links)),
+	}
+	if u.ProfileImageURL != "" {
+		profile.Image = addImage(u.ProfileImageURL, "profile")
+	}
+	for _, s := range site.Skills {
+		profile.Skills = append(profile.Skills, skillData{Name: s.Name, Level: domainResume.LevelLabel(s.Level)})
+	}
+	for _, l := range site.Links {
+		label := linkLabels[l.Type]
+		if label == "" {
+			label = string(l.Type)
+		}
+		profile.Links = append(profile.Links, linkData{Type: string(l.Type), Label: label, URL: l.URL})
+	}
+
+	data := &siteData{
+		Profile:     profile,
+		Posts:       make([]postData, 0, len(site.Posts)),
+		GeneratedAt: site.GeneratedAt.Format(time.RFC3339),
+		GeneratedOn: site.GeneratedAt.Format("2006年1月2日"),
+	}
+	for _, p := range site.Posts {
+		data.Posts = append(data.Posts, postFromDomain(p, site.SiteURL, addImage))
+	}
+	return data
+}
+
+func postFromDomain(p *domainPortfolio.Post, siteURL string, addImage func(src, name string) string) postData {
+	post := postData{
+		ID:              p.ID,
+		Page:            fmt.Sprintf("posts/%d.html", p.ID),
+		Title:           p.Title,
+		Description:     p.Description,
+		DescriptionHTML: template.HTML(p.DescriptionHTML),
+		Genres:          nonNil(p.Genres),
+		Skills:          nonNil(p.Skills),
+		Images:          make([]imageData, 0, len(p.Images)),
+		RepositoryURL:   p.RepositoryURL,
+		DemoURL:         p.DemoURL,
+		Role:            p.Role,
+		TeamSize:        p.TeamSize,
+		Period:          period(p.StartDate, p.EndDate),
+		TechStack:       make([]string, 0, len(p.TechStack)),
+	}
+	if siteURL != "" {
+		post.OriginalURL = fmt.Sprintf("%s/Portfolio/%d", siteURL, p.ID)
+	}
+	if p.PublishedAt != nil {
+		post.PublishedAt = p.PublishedAt.Format("2006-01-02")
+	}
+	for _, t := range p.TechStack {
+		post.TechStack = append(post.TechStack, strings.TrimSpace(t.Name+" "+t.Version))
+	}
+	for i, img := range p.Images {
+		path := addImage(img.URL, fmt.Sprintf("posts/%d/%d", p.ID, i+1))
+		if path == "" {
+			continue
+		}
+		post.Images = append(post.Images, imageData{Path: path, Alt: img.AltText, Caption: img.Caption})
+		if img.IsCover || post.Cover == "" {
+			post.Cover = path
+		}
+	}
+	return post
+}
+
+// readImage は uploads/ 以下の画像を読み込み、中身から判定した拡張子と一緒に返します
+// uploads/ の外を指すパス・画像でないファイル・大きすぎるファイルは読まない
+func (b *zipBuilder) readImage(path string) ([]byte, string, bool) {
+	clean := filepath.Clean(filepath.FromSlash(strings.TrimPrefix(path, "/")))
+	if !strings.HasPrefix(clean, uploadDir+string(filepath.Separator)) {
+		return nil, "", false
+	}
+	full := filepath.Join(b.root, clean)
+	info, err := os.Stat(full)
+	if err != nil || info.IsDir() || info.Size() > maxImageSize {
+		return nil, "", false
+	}
+	data, err := os.ReadFile(full)
+	if err != nil {
+		return nil, "", false
+	}
+	ext, ok := imageExtensions[http.DetectContentType(data)]
+	if !ok {
+		return nil, "", false
+	}
+	return data, ext, true
+}
+
+// period は制作期間を「2024年4月〜2024年9月」の形式にします。終了がなければ「〜」で終える
+func period(start, end *time.Time) string {
+	if start == nil {
+		return ""
+	}
+	return domainResume.FormatMonth(start) + "〜" + domainResume.FormatMonth(end)
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
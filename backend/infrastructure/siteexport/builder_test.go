@@ -0,0 +1,120 @@
+package siteexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	domainExternalLink "backend/domain/externallink"
+	domainPortfolio "backend/domain/portfolio"
+	domainSiteExport "backend/domain/siteexport"
+	domainUser "backend/domain/user"
+	domainUserSkill "backend/domain/userskill"
+)
+
+func TestZipBuilder_Build(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "uploads", "PortfolioImages")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	img := image.NewRGBA(image.Rect(0, 0, 4, 3))
+	img.Set(1, 1, color.RGBA{R: 0xff, A: 0xff})
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cover.png"), buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "note.txt"), []byte("not an image"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	published := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	site := &domainSiteExport.Site{
+		User: &domainUser.UserModel{
+			FirstName: "太郎", LastName: "山田", SchoolName: "○○大学",
+			SelfIntroduction: "**Go** が好きです", SelfIntroductionHTML: "<p><strong>Go</strong> が好きです</p>",
+		},
+		Skills: []*domainUserSkill.UserSkill{{Name: "Go", Level: domainUserSkill.LevelAdvanced}},
+		Links:  []*domainExternalLink.Link{{Type: domainExternalLink.TypeGitHub, URL: "https://github.com/taro"}},
+		Posts: []*domainPortfolio.Post{
+			{
+				ID: 7, Title: "<script>alert(1)</script>", DescriptionHTML: "<p>説明</p>", PublishedAt: &published,
+				Images: []domainPortfolio.Image{
+					{URL: "uploads/PortfolioImages/cover.png", AltText: "画面", IsCover: true},
+					{URL: "uploads/PortfolioImages/missing.png"},
+					{URL: "uploads/PortfolioImages/note.txt"},
+					{URL: "../secret.png"},
+				},
+			},
+		},
+		SiteURL:     "https://example.com",
+		GeneratedAt: published,
+	}
+
+	var out bytes.Buffer
+	if err := NewZipBuilder(root).Build(&out, site, domainSiteExport.ThemeDark); err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	if err != nil {
+		t.Fatalf("not a zip: %v", err)
+	}
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(data)
+	}
+
+	for _, name := range []string{"index.html", "posts/7.html", "assets/style.css", "data.json", ".nojekyll", "images/posts/7/1.png"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("zip should contain %s", name)
+		}
+	}
+	if len(files) != 6 {
+		t.Errorf("zip has %d files, want only the readable image to be copied", len(files))
+	}
+
+	index := files["index.html"]
+	if !strings.Contains(index, `href="posts/7.html"`) || !strings.Contains(index, `src="images/posts/7/1.png"`) {
+		t.Error("index should link posts and images relative to the site root")
+	}
+	if !strings.Contains(index, "<strong>Go</strong>") {
+		t.Error("self introduction HTML should be embedded as is")
+	}
+	if strings.Contains(index, "<script>") {
+		t.Error("post titles should be escaped")
+	}
+	post := files["posts/7.html"]
+	if !strings.Contains(post, `href="../assets/style.css"`) || !strings.Contains(post, `src="../images/posts/7/1.png"`) {
+		t.Error("post pages should reference assets relative to posts/")
+	}
+	if !strings.Contains(files["assets/style.css"], "dark") {
+		t.Error("the dark theme stylesheet should be used")
+	}
+
+	var data siteData
+	if err := json.Unmarshal([]byte(files["data.json"]), &data); err != nil {
+		t.Fatalf("data.json: %v", err)
+	}
+	if data.Profile.Name != "山田 太郎" || len(data.Posts) != 1 || data.Posts[0].Cover != "images/posts/7/1.png" ||
+		data.Posts[0].OriginalURL != "https://example.com/Portfolio/7" {
+		t.Errorf("data.json = %+v", data)
+	}
+}
@@ -0,0 +1,57 @@
+package siteexport
+
+import (
+	domainSiteExport "backend/domain/siteexport"
+	"time"
+)
+
+// ExportModel は静的サイトの書き出し依頼の永続化用モデルです
+type ExportModel struct {
+	ID          uint `gorm:"primaryKey"`
+	CreatedAt   time.Time
+	UserID      uint   `gorm:"not null;index"`
+	Theme       string `gorm:"size:32;not null"`
+	Status      string `gorm:"size:16;not null;index"`
+	FilePath    string `gorm:"size:255"`
+	Size        int64  `gorm:"not null;default:0"`
+	Error       string `gorm:"type:text"`
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time `gorm:"index"`
+}
+
+func (ExportModel) TableName() string {
+	return "site_exports"
+}
+
+func toDomain(m *ExportModel) *domainSiteExport.Export {
+	return &domainSiteExport.Export{
+		ID:          m.ID,
+		UserID:      m.UserID,
+		Theme:       domainSiteExport.Theme(m.Theme),
+		Status:      domainSiteExport.Status(m.Status),
+		FilePath:    m.FilePath,
+		Size:        m.Size,
+		Error:       m.Error,
+		CreatedAt:   m.CreatedAt,
+		StartedAt:   m.StartedAt,
+		CompletedAt: m.CompletedAt,
+		ExpiresAt:   m.ExpiresAt,
+	}
+}
+
+func toPersistence(e *domainSiteExport.Export) *ExportModel {
+	return &ExportModel{
+		ID:          e.ID,
+		CreatedAt:   e.CreatedAt,
+		UserID:      e.UserID,
+		Theme:       string(e.Theme),
+		Status:      string(e.Status),
+		FilePath:    e.FilePath,
+		Size:        e.Size,
+		Error:       e.Error,
+		StartedAt:   e.StartedAt,
+		CompletedAt: e.CompletedAt,
+		ExpiresAt:   e.ExpiresAt,
+	}
+}
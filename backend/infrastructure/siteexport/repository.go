@@ -0,0 +1,89 @@
+package siteexport
+
+import (
+	domainSiteExport "backend/domain/siteexport"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// exportRepo は domain/siteexport.Repository の具象実装です
+type exportRepo struct {
+	db *gorm.DB
+}
+
+// NewExportRepo は GORM を使った静的サイトの書き出し依頼のリポジトリを生成します
+func NewExportRepo(db *gorm.DB) domainSiteExport.Repository {
+	return &exportRepo{db: db}
+}
+
+func (r *exportRepo) Create(e *domainSiteExport.Export) error {
+	m := toPersistence(e)
+	if err := r.db.Create(m).Error; err != nil {
+		return err
+	}
+	e.ID = m.ID
+	return nil
+}
+
+func (r *exportRepo) GetByID(id uint) (*domainSiteExport.Export, error) {
+	var m ExportModel
+	if err := r.db.First(&m, id).Error; err != nil {
+		return nil, err
+	}
+	return toDomain(&m), nil
+}
+
+func (r *exportRepo) GetByUserID(userID uint) ([]*domainSiteExport.Export, error) {
+	var ms []ExportModel
+	if err := r.db.Where("user_id = ?", userID).Order("created_at DESC, id DESC").Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	return toDomainList(ms), nil
+}
+
+func (r *exportRepo) GetByStatus(status domainSiteExport.Status, limit int) ([]*domainSiteExport.Export, error) {
+	var ms []ExportModel
+	if err := r.db.Where("status = ?", status).Order("created_at ASC, id ASC").Limit(limit).Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	return toDomainList(ms), nil
+}
+
+func (r *exportRepo) UpdateStatus(e *domainSiteExport.Export, from domainSiteExport.Status) (bool, error) {
+	result := r.db.Model(&ExportModel{}).
+		Where("id = ? AND status = ?", e.ID, from).
+		Updates(map[string]interface{}{
+			"status":       e.Status,
+			"file_path":    e.FilePath,
+			"size":         e.Size,
+			"error":        e.Error,
+			"started_at":   e.StartedAt,
+			"completed_at": e.CompletedAt,
+			"expires_at":   e.ExpiresAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *exportRepo) GetExpired(now time.Time) ([]*domainSiteExport.Export, error) {
+	var ms []ExportModel
+	if err := r.db.Where("expires_at < ?", now).Order("id ASC").Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	return toDomainList(ms), nil
+}
+
+func (r *exportRepo) Delete(id uint) error {
+	return r.db.Delete(&ExportModel{}, id).Error
+}
+
+func toDomainList(ms []ExportModel) []*domainSiteExport.Export {
+	exports := make([]*domainSiteExport.Export, 0, len(ms))
+	for i := range ms {
+		exports = append(exports, toDomain(&ms[i]))
+	}
+	return exports
+}
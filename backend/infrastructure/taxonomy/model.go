@@ -0,0 +1,105 @@
+package taxonomy
+
+import (
+	"time"
+
+	domainTaxonomy "backend/domain/taxonomy"
+
+	"gorm.io/gorm"
+)
+
+// OptionModel は GORM タグ付きの永続化用選択肢モデルです
+// 職種・スキル・ジャンルは同じ形のテーブルなので、種類ごとに Table を切り替えて使います
+type OptionModel struct {
+	gorm.Model
+	Name         string `gorm:"unique;not null"`
+	DisplayOrder int    `gorm:"not null;default:0;index"`
+	RetiredAt    *time.Time
+	Category     string `gorm:"size:32;not null;default:''"` // スキルのみ使う
+}
+
+// AliasModel は選択肢の別名です。Key は正規化した別名で、種類ごとに一意です
+type AliasModel struct {
+	ID       uint   `gorm:"primaryKey"`
+	Kind     string `gorm:"size:32;not null;uniqueIndex:idx_taxonomy_aliases_key"`
+	Key      string `gorm:"size:255;not null;uniqueIndex:idx_taxonomy_aliases_key"`
+	OptionID uint   `gorm:"not null;index"`
+	Alias    string `gorm:"size:255;not null"`
+}
+
+func (AliasModel) TableName() string {
+	return "taxonomy_aliases"
+}
+
+// tableNames は種類ごとのテーブル名です
+var tableNames = map[domainTaxonomy.Kind]string{
+	domainTaxonomy.KindJobType: "job_types",
+	domainTaxonomy.KindSkill:   "skills",
+	domainTaxonomy.KindGenre:   "genres",
+}
+
+// valueColumn は選択肢の名前を値として保存している text[] カラムです
+type valueColumn struct {
+	table  string
+	column string
+}
+
+// valueColumns は統合・名前変更のときに書き換えるカラムです
+var valueColumns = map[domainTaxonomy.Kind][]valueColumn{
+	domainTaxonomy.KindJobType: {
+		{table: "user_models", column: "desired_job_types"},
+		{table: "job_postings", column: "job_types"},
+	},
+	domainTaxonomy.KindSkill: {
+		{table: "user_models", column: "skills"},
+		{table: "post_models", column: "skills"},
+		{table: "job_postings", column: "skills"},
+		{table: "saved_searches", column: "skills"},
+		{table: "experiences", column: "skills"},
+	},
+	domainTaxonomy.KindGenre: {
+		{table: "post_models", column: "genres"},
+		{table: "saved_searches", column: "genres"},
+	},
+}
+
+func toDomain(kind domainTaxonomy.Kind, m *OptionModel, aliases []AliasModel) *domainTaxonomy.Option {
+	o := &domainTaxonomy.Option{
+		ID:           m.ID,
+		Kind:         kind,
+		Name:         m.Name,
+		DisplayOrder: m.DisplayOrder,
+		RetiredAt:    m.RetiredAt,
+		Category:     domainTaxonomy.SkillCategory(m.Category),
+		Aliases:      []string{},
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+	for _, a := range aliases {
+		o.Aliases = append(o.Aliases, a.Alias)
+	}
+	return o
+}
+
+func toPersistence(o *domainTaxonomy.Option) OptionModel {
+	return OptionModel{
+		Model:        gorm.Model{ID: o.ID, CreatedAt: o.CreatedAt, UpdatedAt: o.UpdatedAt},
+		Name:         o.Name,
+		DisplayOrder: o.DisplayOrder,
+		RetiredAt:    o.RetiredAt,
+		Category:     string(o.Category),
+	}
+}
+
+func toAliasPersistence(o *domainTaxonomy.Option) []AliasModel {
+	aliases := make([]AliasModel, 0, len(o.Aliases))
+	for _, a := range o.Aliases {
+		aliases = append(aliases, AliasModel{
+			Kind:     string(o.Kind),
+			Key:      domainTaxonomy.Key(a),
+			OptionID: o.ID,
+			Alias:    a,
+		})
+	}
+	return aliases
+}
@@ -0,0 +1,278 @@
+package taxonomy
+
+import (
+	domainTaxonomy "backend/domain/taxonomy"
+	"backend/infrastructure/dbutil"
+	"errors"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// taxonomyRepo は domain/taxonomy.Repository の具象実装です
+type taxonomyRepo struct {
+	db *gorm.DB
+}
+
+// NewTaxonomyRepo は GORM を使った選択肢のリポジトリを生成します
+func NewTaxonomyRepo(db *gorm.DB) domainTaxonomy.Repository {
+	return &taxonomyRepo{db: db}
+}
+
+func (r *taxonomyRepo) table(db *gorm.DB, kind domainTaxonomy.Kind) *gorm.DB {
+	return db.Table(tableNames[kind])
+}
+
+func (r *taxonomyRepo) List(kind domainTaxonomy.Kind, includeRetired bool) ([]*domainTaxonomy.Option, error) {
+	q := r.table(r.db, kind).Where("deleted_at IS NULL")
+	if !includeRetired {
+		q = q.Where("retired_at IS NULL")
+	}
+	var pms []OptionModel
+	if err := q.Order("display_order ASC, name ASC").Find(&pms).Error; err != nil {
+		return nil, err
+	}
+
+	var aliases []AliasModel
+	if err := r.db.Where("kind = ?", string(kind)).Order("id ASC").Find(&aliases).Error; err != nil {
+		return nil, err
+	}
+	byOption := make(map[uint][]AliasModel)
+	for _, a := range aliases {
+		byOption[a.OptionID] = append(byOption[a.OptionID], a)
+	}
+
+	options := make([]*domainTaxonomy.Option, 0, len(pms))
+	for i := range pms {
+		options = append(options, toDomain(kind, &pms[i], byOption[pms[i].ID]))
+	}
+	return options, nil
+}
+
+func (r *taxonomyRepo) GetByID(kind domainTaxonomy.Kind, id uint) (*domainTaxonomy.Option, error) {
+	var pm OptionModel
+	if err := r.table(r.db, kind).Where("id = ? AND deleted_at IS NULL", id).First(&pm).Error; err != nil {
+		return nil, err
+	}
+	var aliases []AliasModel
+	if err := r.db.Where("kind = ? AND option_id = ?", string(kind), id).Order("id ASC").Find(&aliases).Error; err != nil {
+		return nil, err
+	}
+	return toDomain(kind, &pm, aliases), nil
+}
+
+func (r *taxonomyRepo) Create(o *domainTaxonomy.Option) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		pm := toPersistence(o)
+		if err := r.table(tx, o.Kind).Create(&pm).Error; err != nil {
+			return err
+		}
+		o.ID = pm.ID
+		o.CreatedAt = pm.CreatedAt
+		o.UpdatedAt = pm.UpdatedAt
+		return saveAliases(tx, o)
+	})
+}
+
+func (r *taxonomyRepo) Update(o *domainTaxonomy.Option, previousName string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		pm := toPersistence(o)
+		if err := r.table(tx, o.Kind).Save(&pm).Error; err != nil {
+			return err
+		}
+		o.UpdatedAt = pm.UpdatedAt
+		if err := saveAliases(tx, o); err != nil {
+			return err
+		}
+		if previousName == "" || previousName == o.Name {
+			return nil
+		}
+		_, err := rewriteValues(tx, o.Kind, previousName, o.Name)
+		return err
+	})
+}
+
+// Delete は行を物理削除します（名前に一意制約があるため、同じ名前で作り直せるようにする）
+func (r *taxonomyRepo) Delete(o *domainTaxonomy.Option) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("kind = ? AND option_id = ?", string(o.Kind), o.ID).Delete(&AliasModel{}).Error; err != nil {
+			return err
+		}
+		return r.table(tx, o.Kind).Unscoped().Where("id = ?", o.ID).Delete(&OptionModel{}).Error
+	})
+}
+
+func (r *taxonomyRepo) Merge(source, target *domainTaxonomy.Option) (int64, error) {
+	var rewritten int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		n, err := rewriteValues(tx, source.Kind, source.Name, target.Name)
+		if err != nil {
+			return err
+		}
+		rewritten = n
+		for _, alias := range source.Aliases {
+			if n, err = rewriteValues(tx, source.Kind, alias, target.Name); err != nil {
+				return err
+			}
+			rewritten += n
+		}
+
+		// source の別名を先に消してから target の別名として保存し直す
+		if err := tx.Where("kind = ? AND option_id = ?", string(source.Kind), source.ID).Delete(&AliasModel{}).Error; err != nil {
+			return err
+		}
+		if err := r.table(tx, source.Kind).Unscoped().Where("id = ?", source.ID).Delete(&OptionModel{}).Error; err != nil {
+			return err
+		}
+		return saveAliases(tx, target)
+	})
+	return rewritten, err
+}
+
+func (r *taxonomyRepo) Reorder(kind domainTaxonomy.Kind, ids []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			if err := r.table(tx, kind).Where("id = ?", id).Update("display_order", (i+1)*10).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *taxonomyRepo) NormalizeStoredValues(kind domainTaxonomy.Kind, normalize func([]string) []string) (int64, error) {
+	var rewritten int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, col := range valueColumns[kind] {
+			var rows []arrayRow
+			if err := tx.Table(col.table).Select("id, " + col.column + " AS vals").
+				Where(col.column + " IS NOT NULL").Scan(&rows).Error; err != nil {
+				return err
+			}
+			for _, row := range rows {
+				normalized := normalize(row.Vals)
+				if equalStrings(normalized, row.Vals) {
+					continue
+				}
+				if err := tx.Table(col.table).Where("id = ?", row.ID).Update(col.column, pq.StringArray(normalized)).Error; err != nil {
+					return err
+				}
+				rewritten++
+			}
+		}
+		if kind != domainTaxonomy.KindSkill {
+			return nil
+		}
+		var skills []userSkillRow
+		if err := tx.Table("user_skills").Select("id, user_id, name").Scan(&skills).Error; err != nil {
+			return err
+		}
+		for _, s := range skills {
+			normalized := normalize([]string{s.Name})
+			if len(normalized) != 1 || normalized[0] == s.Name {
+				continue
+			}
+			if err := renameUserSkill(tx, s, normalized[0]); err != nil {
+				return err
+			}
+			rewritten++
+		}
+		return nil
+	})
+	return rewritten, err
+}
+
+// saveAliases は選択肢の別名を o.Aliases の内容に置き換えます
+func saveAliases(tx *gorm.DB, o *domainTaxonomy.Option) error {
+	if err := tx.Where("kind = ? AND option_id = ?", string(o.Kind), o.ID).Delete(&AliasModel{}).Error; err != nil {
+		return err
+	}
+	aliases := toAliasPersistence(o)
+	if len(aliases) == 0 {
+		return nil
+	}
+	return tx.Create(&aliases).Error
+}
+
+type arrayRow struct {
+	ID   uint
+	Vals pq.StringArray `gorm:"type:text[]"`
+}
+
+// rewriteValues はユーザー・投稿などの text[] カラムに登録された from を to に置き換え、書き換えた行数を返します
+// 論理削除された行も対象にします（復元したときに古い名前が残らないようにする）
+func rewriteValues(tx *gorm.DB, kind domainTaxonomy.Kind, from, to string) (int64, error) {
+	var rewritten int64
+	for _, col := range valueColumns[kind] {
+		var rows []arrayRow
+		q := tx.Table(col.table).Select("id, " + col.column + " AS vals")
+		if err := dbutil.ArrayContains(q, col.column, from).Scan(&rows).Error; err != nil {
+			return 0, err
+		}
+		for _, row := range rows {
+			replaced, changed := domainTaxonomy.ReplaceValue(row.Vals, from, to)
+			if !changed {
+				continue
+			}
+			if err := tx.Table(col.table).Where("id = ?", row.ID).Update(col.column, pq.StringArray(replaced)).Error; err != nil {
+				return 0, err
+			}
+			rewritten++
+		}
+	}
+	if kind == domainTaxonomy.KindSkill {
+		var skills []userSkillRow
+		if err := tx.Table("user_skills").Select("id, user_id, name").Where("name = ?", from).Scan(&skills).Error; err != nil {
+			return 0, err
+		}
+		for _, s := range skills {
+			if err := renameUserSkill(tx, s, to); err != nil {
+				return 0, err
+			}
+			rewritten++
+		}
+	}
+	return rewritten, nil
+}
+
+type userSkillRow struct {
+	ID     uint
+	UserID uint
+	Name   string
+}
+
+// renameUserSkill はプロフィールのスキル (user_skills) の名前を to に変更します
+// すでに to を登録しているユーザーは、重複しない推薦を to 側に移してから元のスキルを削除します
+func renameUserSkill(tx *gorm.DB, s userSkillRow, to string) error {
+	var target userSkillRow
+	err := tx.Table("user_skills").Select("id, user_id, name").
+		Where("user_id = ? AND name = ?", s.UserID, to).Take(&target).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return tx.Table("user_skills").Where("id = ?", s.ID).Update("name", to).Error
+	}
+	if err != nil {
+		return err
+	}
+	if err := tx.Exec(
+		"UPDATE skill_endorsements SET user_skill_id = ? WHERE user_skill_id = ? AND endorser_id NOT IN (SELECT endorser_id FROM skill_endorsements WHERE user_skill_id = ?)",
+		target.ID, s.ID, target.ID,
+	).Error; err != nil {
+		return err
+	}
+	if err := tx.Exec("DELETE FROM skill_endorsements WHERE user_skill_id = ?", s.ID).Error; err != nil {
+		return err
+	}
+	return tx.Exec("DELETE FROM user_skills WHERE id = ?", s.ID).Error
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package unitofwork
+
+import (
+	domainCareer "backend/domain/career"
+	domainPortfolio "backend/domain/portfolio"
+	domainUnitOfWork "backend/domain/unitofwork"
+	domainUser "backend/domain/user"
+	domainUserSkill "backend/domain/userskill"
+	careerInfra "backend/infrastructure/career"
+	portfolioInfra "backend/infrastructure/portfolio"
+	userInfra "backend/infrastructure/user"
+	userSkillInfra "backend/infrastructure/userskill"
+
+	"gorm.io/gorm"
+)
+
+// unitOfWork は domain/unitofwork.UnitOfWork の具象実装です
+type unitOfWork struct {
+	db *gorm.DB
+}
+
+// NewUnitOfWork は GORM のトランザクションを使った UnitOfWork を生成します
+func NewUnitOfWork(db *gorm.DB) domainUnitOfWork.UnitOfWork {
+	return &unitOfWork{db: db}
+}
+
+// tx はトランザクションの *gorm.DB を共有するリポジトリと、取り消したときの後始末です
+type tx struct {
+	users      domainUser.IUserRepository
+	posts      domainPortfolio.Repository
+	userSkills domainUserSkill.Repository
+	careers    domainCareer.Repository
+	onRollback []func()
+}
+
+func (t *tx) Users() domainUser.IUserRepository      { return t.users }
+func (t *tx) Posts() domainPortfolio.Repository      { return t.posts }
+func (t *tx) UserSkills() domainUserSkill.Repository { return t.userSkills }
+func (t *tx) Careers() domainCareer.Repository       { return t.careers }
+
+func (t *tx) OnRollback(fn func()) {
+	t.onRollback = append(t.onRollback, fn)
+}
+
+// rollback は登録された後始末を登録と逆の順に実行します
+func (t *tx) rollback() {
+	for i := len(t.onRollback) - 1; i >= 0; i-- {
+		t.onRollback[i]()
+	}
+	t.onRollback = nil
+}
+
+func (u *unitOfWork) Do(fn func(tx domainUnitOfWork.Tx) error) error {
+	t := &tx{}
+	// fn が panic した場合も、GORM が取り消したあとに後始末をしてから panic を伝える
+	defer func() {
+		if r := recover(); r != nil {
+			t.rollback()
+			panic(r)
+		}
+	}()
+	err := u.db.Transaction(func(db *gorm.DB) error {
+		t.users = userInfra.NewUserRepository(db)
+		t.posts = portfolioInfra.NewPostRepo(db)
+		t.userSkills = userSkillInfra.NewUserSkillRepo(db)
+		t.careers = careerInfra.NewCareerRepo(db)
+		return fn(t)
+	})
+	if err != nil {
+		t.rollback()
+	}
+	return err
+}
@@ -0,0 +1,175 @@
+package unitofwork
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	domainPortfolio "backend/domain/portfolio"
+	domainUnitOfWork "backend/domain/unitofwork"
+	domainUser "backend/domain/user"
+	domainUserSkill "backend/domain/userskill"
+	portfolioInfra "backend/infrastructure/portfolio"
+	userInfra "backend/infrastructure/user"
+	userSkillInfra "backend/infrastructure/userskill"
+	"backend/migrations"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	m, err := migrations.New(db)
+	if err != nil {
+		t.Fatalf("migrator: %v", err)
+	}
+	if _, err := m.Up(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+// createUserAndPost はユーザーとその下書きの投稿を tx で作ります
+func createUserAndPost(t *testing.T, tx domainUnitOfWork.Tx, email string) error {
+	t.Helper()
+	if err := tx.Users().CreateUser(&domainUser.UserModel{Email: email}); err != nil {
+		return err
+	}
+	user, err := tx.Users().FindUserByEmail(email)
+	if err != nil {
+		return err
+	}
+	post, err := domainPortfolio.NewDraftPost("作品", "説明", nil, nil, domainPortfolio.ProjectDetails{}, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx.Posts().CreatePost(post)
+}
+
+func countRows(t *testing.T, db *gorm.DB) (users, posts int64) {
+	t.Helper()
+	db.Model(&userInfra.UserModel{}).Count(&users)
+	db.Model(&portfolioInfra.PostModel{}).Count(&posts)
+	return users, posts
+}
+
+func touch(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "upload.png")
+	if err := os.WriteFile(path, []byte("image"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestUnitOfWork_Commit(t *testing.T) {
+	db := openTestDB(t)
+	path := touch(t)
+
+	err := NewUnitOfWork(db).Do(func(tx domainUnitOfWork.Tx) error {
+		tx.OnRollback(func() { os.Remove(path) })
+		return createUserAndPost(t, tx, "a@example.com")
+	})
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if users, posts := countRows(t, db); users != 1 || posts != 1 {
+		t.Errorf("users=%d posts=%d, want both saved", users, posts)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("committed upload should be kept: %v", err)
+	}
+}
+
+func TestUnitOfWork_Rollback(t *testing.T) {
+	db := openTestDB(t)
+	path := touch(t)
+	failed := errors.New("failed")
+
+	var order []int
+	err := NewUnitOfWork(db).Do(func(tx domainUnitOfWork.Tx) error {
+		tx.OnRollback(func() { order = append(order, 1); os.Remove(path) })
+		tx.OnRollback(func() { order = append(order, 2) })
+		if err := createUserAndPost(t, tx, "a@example.com"); err != nil {
+			return err
+		}
+		return failed
+	})
+	if !errors.Is(err, failed) {
+		t.Fatalf("Do = %v, want the error from fn", err)
+	}
+	if users, posts := countRows(t, db); users != 0 || posts != 0 {
+		t.Errorf("users=%d posts=%d, want nothing saved", users, posts)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("upload of the rolled back transaction should be removed")
+	}
+	if len(order) != 2 || order[0] != 2 || order[1] != 1 {
+		t.Errorf("compensations ran in %v, want reverse order", order)
+	}
+}
+
+func TestUnitOfWork_RollbackUserSkills(t *testing.T) {
+	db := openTestDB(t)
+	failed := errors.New("failed")
+
+	err := NewUnitOfWork(db).Do(func(tx domainUnitOfWork.Tx) error {
+		if err := tx.Users().CreateUser(&domainUser.UserModel{Email: "a@example.com"}); err != nil {
+			return err
+		}
+		user, err := tx.Users().FindUserByEmail("a@example.com")
+		if err != nil {
+			return err
+		}
+		skills, removed, err := domainUserSkill.Reconcile(user.ID, nil, []string{"Go", "SQL"})
+		if err != nil {
+			return err
+		}
+		if err := tx.UserSkills().Save(skills, removed); err != nil {
+			return err
+		}
+		return failed
+	})
+	if !errors.Is(err, failed) {
+		t.Fatalf("Do = %v, want the error from fn", err)
+	}
+	var skills int64
+	db.Model(&userSkillInfra.UserSkillModel{}).Count(&skills)
+	if users, _ := countRows(t, db); users != 0 || skills != 0 {
+		t.Errorf("users=%d skills=%d, want nothing saved", users, skills)
+	}
+}
+
+func TestUnitOfWork_Panic(t *testing.T) {
+	db := openTestDB(t)
+	path := touch(t)
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("panic should be propagated")
+			}
+		}()
+		NewUnitOfWork(db).Do(func(tx domainUnitOfWork.Tx) error {
+			tx.OnRollback(func() { os.Remove(path) })
+			if err := createUserAndPost(t, tx, "a@example.com"); err != nil {
+				return err
+			}
+			panic("boom")
+		})
+	}()
+	if users, posts := countRows(t, db); users != 0 || posts != 0 {
+		t.Errorf("users=%d posts=%d, want nothing saved", users, posts)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("upload should be removed when fn panics")
+	}
+}
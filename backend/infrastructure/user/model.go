@@ -29,6 +29,7 @@ type UserModel struct {
 	VerificationExpiresAt time.Time
 	PasswordResetToken    string `gorm:"size:255"`
 	PasswordResetExpires  time.Time
+	Role                  string `gorm:"size:32;not null;default:student"`
 
 	SchoolName     string `gorm:"size:255"`
 	Department     string `gorm:"size:255"`
@@ -40,4 +41,12 @@ type UserModel struct {
 
 	SelfIntroduction string `gorm:"type:text"`
 	ProfileImageURL  string `gorm:"size:512"`
+
+	ProfileVisibility    string `gorm:"size:32;not null;default:public"`
+	HiddenFromRecruiters bool   `gorm:"not null;default:false"`
+
+	SuspendedAt      *time.Time
+	SuspensionReason string `gorm:"type:text"`
+
+	Version int `gorm:"not null;default:1"`
 }
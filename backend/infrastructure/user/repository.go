@@ -20,7 +20,12 @@ func NewUserRepository(db *gorm.DB) domainUser.IUserRepository {
 
 func (r *UserRepository) CreateUser(u *domainUser.UserModel) error {
 	pm := toPersistence(u)
-	return r.db.Create(&pm).Error
+	pm.Version = 1
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	u.Version = pm.Version
+	return nil
 }
 
 func (r *UserRepository) FindUserByEmail(email string) (*domainUser.UserModel, error) {
@@ -59,9 +64,25 @@ func (r *UserRepository) FindByID(id uint) (*domainUser.UserModel, error) {
 	return &d, nil
 }
 
+// UpdateUser は全項目を保存します。版番号が u.Version のままのときだけ保存する（UPDATE ... WHERE version = ?）
 func (r *UserRepository) UpdateUser(u *domainUser.UserModel) error {
 	pm := toPersistence(u)
-	return r.db.Save(&pm).Error
+	pm.Version = u.Version + 1
+	pm.UpdatedAt = time.Now()
+	result := r.db.Model(&pm).
+		Where("version = ?", u.Version).
+		Select("*").
+		Omit("id", "created_at").
+		Updates(&pm)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainUser.ErrVersionConflict
+	}
+	u.Version = pm.Version
+	u.UpdatedAt = pm.UpdatedAt
+	return nil
 }
 
 func (r *UserRepository) SoftDeleteUnverifiedUsersBefore(cutoff time.Time) error {
@@ -97,6 +118,7 @@ func toDomain(pm *UserModel) domainUser.UserModel {
 		VerificationExpiresAt: pm.VerificationExpiresAt,
 		PasswordResetToken:    pm.PasswordResetToken,
 		PasswordResetExpires:  pm.PasswordResetExpires,
+		Role:                  domainUser.Role(pm.Role),
 		SchoolName:            pm.SchoolName,
 		Department:            pm.Department,
 		Laboratory:            pm.Laboratory,
@@ -105,9 +127,14 @@ func toDomain(pm *UserModel) domainUser.UserModel {
 		Skills:                pm.Skills,
 		SelfIntroduction:      pm.SelfIntroduction,
 		ProfileImageURL:       pm.ProfileImageURL,
+		ProfileVisibility:     domainUser.ProfileVisibility(pm.ProfileVisibility),
+		HiddenFromRecruiters:  pm.HiddenFromRecruiters,
+		SuspendedAt:           pm.SuspendedAt,
+		SuspensionReason:      pm.SuspensionReason,
 		CreatedAt:             pm.CreatedAt,
 		UpdatedAt:             pm.UpdatedAt,
 		DeletedAt:             pm.DeletedAt.Time,
+		Version:               pm.Version,
 	}
 }
 
@@ -133,6 +160,7 @@ func toPersistence(d *domainUser.UserModel) UserModel {
 		VerificationExpiresAt: d.VerificationExpiresAt,
 		PasswordResetToken:    d.PasswordResetToken,
 		PasswordResetExpires:  d.PasswordResetExpires,
+		Role:                  string(d.Role),
 		SchoolName:            d.SchoolName,
 		Department:            d.Department,
 		Laboratory:            d.Laboratory,
@@ -141,5 +169,10 @@ func toPersistence(d *domainUser.UserModel) UserModel {
 		Skills:                d.Skills,
 		SelfIntroduction:      d.SelfIntroduction,
 		ProfileImageURL:       d.ProfileImageURL,
+		ProfileVisibility:     string(d.ProfileVisibility),
+		HiddenFromRecruiters:  d.HiddenFromRecruiters,
+		SuspendedAt:           d.SuspendedAt,
+		SuspensionReason:      d.SuspensionReason,
+		Version:               d.Version,
 	}
 }
@@ -0,0 +1,69 @@
+package user
+
+import (
+	"errors"
+	"testing"
+
+	domainUser "backend/domain/user"
+	"backend/migrations"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	m, err := migrations.New(db)
+	if err != nil {
+		t.Fatalf("migrator: %v", err)
+	}
+	if _, err := m.Up(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+func TestUserRepository_UpdateUserVersion(t *testing.T) {
+	repo := NewUserRepository(openTestDB(t))
+	if err := repo.CreateUser(&domainUser.UserModel{Email: "a@example.com"}); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	user, err := repo.FindUserByEmail("a@example.com")
+	if err != nil {
+		t.Fatalf("FindUserByEmail: %v", err)
+	}
+	if user.Version != 1 {
+		t.Fatalf("new user version = %d, want 1", user.Version)
+	}
+	stale := *user
+
+	user.FirstName = "太郎"
+	if err := repo.UpdateUser(user); err != nil {
+		t.Fatalf("UpdateUser: %v", err)
+	}
+	if user.Version != 2 {
+		t.Errorf("version after update = %d, want 2", user.Version)
+	}
+
+	stale.FirstName = "次郎"
+	if err := repo.UpdateUser(&stale); !errors.Is(err, domainUser.ErrVersionConflict) {
+		t.Fatalf("UpdateUser with a stale version = %v, want ErrVersionConflict", err)
+	}
+	if stale.Version != 1 {
+		t.Errorf("stale version should be kept on conflict, got %d", stale.Version)
+	}
+
+	saved, err := repo.FindByID(user.ID)
+	if err != nil {
+		t.Fatalf("FindByID: %v", err)
+	}
+	if saved.FirstName != "太郎" || saved.Version != 2 {
+		t.Errorf("saved user = %q (version %d), want the first update only", saved.FirstName, saved.Version)
+	}
+}
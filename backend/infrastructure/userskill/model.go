@@ -0,0 +1,38 @@
+package userskill
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// UserSkillModel はプロフィールのスキル 1 件の永続化用モデルです
+type UserSkillModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	UserID            uint          `gorm:"not null;uniqueIndex:idx_user_skills_user_name"`
+	Name              string        `gorm:"size:255;not null;uniqueIndex:idx_user_skills_user_name"`
+	Level             string        `gorm:"size:16;not null;default:''"`
+	YearsOfExperience float64       `gorm:"not null;default:0"`
+	PostIDs           pq.Int64Array `gorm:"type:bigint[]"`
+	DisplayOrder      int           `gorm:"not null;default:0"`
+}
+
+func (UserSkillModel) TableName() string {
+	return "user_skills"
+}
+
+// EndorsementModel はスキルの推薦の永続化用モデルです
+type EndorsementModel struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	UserSkillID uint `gorm:"not null;uniqueIndex:idx_skill_endorsements_endorser"`
+	EndorserID  uint `gorm:"not null;uniqueIndex:idx_skill_endorsements_endorser;index"`
+}
+
+func (EndorsementModel) TableName() string {
+	return "skill_endorsements"
+}
@@ -0,0 +1,175 @@
+package userskill
+
+import (
+	domainUserSkill "backend/domain/userskill"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// userSkillRepo は domain/userskill.Repository の具象実装です
+type userSkillRepo struct {
+	db *gorm.DB
+}
+
+// NewUserSkillRepo は GORM を使ったプロフィールスキルのリポジトリを生成します
+func NewUserSkillRepo(db *gorm.DB) domainUserSkill.Repository {
+	return &userSkillRepo{db: db}
+}
+
+func (r *userSkillRepo) GetByUserID(userID uint) ([]*domainUserSkill.UserSkill, error) {
+	var pms []UserSkillModel
+	if err := r.db.
+		Where("user_id = ?", userID).
+		Order("display_order ASC, id ASC").
+		Find(&pms).Error; err != nil {
+		return nil, err
+	}
+	skills := make([]*domainUserSkill.UserSkill, 0, len(pms))
+	ids := make([]uint, 0, len(pms))
+	for i := range pms {
+		skills = append(skills, toDomain(&pms[i]))
+		ids = append(ids, pms[i].ID)
+	}
+	if len(ids) == 0 {
+		return skills, nil
+	}
+
+	var counts []struct {
+		UserSkillID uint
+		Count       int
+	}
+	if err := r.db.Model(&EndorsementModel{}).
+		Select("user_skill_id, COUNT(*) AS count").
+		Where("user_skill_id IN ?", ids).
+		Group("user_skill_id").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]int, len(counts))
+	for _, c := range counts {
+		byID[c.UserSkillID] = c.Count
+	}
+	for _, s := range skills {
+		s.EndorsementCount = byID[s.ID]
+	}
+	return skills, nil
+}
+
+func (r *userSkillRepo) GetByID(id uint) (*domainUserSkill.UserSkill, error) {
+	var pm UserSkillModel
+	if err := r.db.First(&pm, id).Error; err != nil {
+		return nil, err
+	}
+	s := toDomain(&pm)
+	var count int64
+	if err := r.db.Model(&EndorsementModel{}).Where("user_skill_id = ?", id).Count(&count).Error; err != nil {
+		return nil, err
+	}
+	s.EndorsementCount = int(count)
+	return s, nil
+}
+
+func (r *userSkillRepo) Save(skills []*domainUserSkill.UserSkill, removed []*domainUserSkill.UserSkill) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// 先に削除しておくと、同じ名前を削除して追加し直した場合も一意制約に当たらない
+		for _, s := range removed {
+			if err := tx.Where("user_skill_id = ?", s.ID).Delete(&EndorsementModel{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(&UserSkillModel{}, s.ID).Error; err != nil {
+				return err
+			}
+		}
+		for _, s := range skills {
+			pm := toPersistence(s)
+			if err := tx.Save(&pm).Error; err != nil {
+				return err
+			}
+			s.ID = pm.ID
+			s.CreatedAt = pm.CreatedAt
+			s.UpdatedAt = pm.UpdatedAt
+		}
+		return nil
+	})
+}
+
+func (r *userSkillRepo) CreateEndorsement(e *domainUserSkill.Endorsement) error {
+	pm := EndorsementModel{
+		CreatedAt:   e.CreatedAt,
+		UserSkillID: e.UserSkillID,
+		EndorserID:  e.EndorserID,
+	}
+	if err := r.db.Create(&pm).Error; err != nil {
+		return err
+	}
+	e.ID = pm.ID
+	return nil
+}
+
+func (r *userSkillRepo) DeleteEndorsement(userSkillID, endorserID uint) error {
+	res := r.db.Where("user_skill_id = ? AND endorser_id = ?", userSkillID, endorserID).Delete(&EndorsementModel{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *userSkillRepo) HasEndorsed(userSkillID, endorserID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&EndorsementModel{}).
+		Where("user_skill_id = ? AND endorser_id = ?", userSkillID, endorserID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *userSkillRepo) EndorsedSkillIDs(endorserID uint, skillIDs []uint) ([]uint, error) {
+	ids := []uint{}
+	if len(skillIDs) == 0 {
+		return ids, nil
+	}
+	err := r.db.Model(&EndorsementModel{}).
+		Where("endorser_id = ? AND user_skill_id IN ?", endorserID, skillIDs).
+		Pluck("user_skill_id", &ids).Error
+	return ids, err
+}
+
+// toDomain は UserSkillModel → domain.UserSkill へのマッピング関数です
+func toDomain(pm *UserSkillModel) *domainUserSkill.UserSkill {
+	postIDs := make([]uint, len(pm.PostIDs))
+	for i, id := range pm.PostIDs {
+		postIDs[i] = uint(id)
+	}
+	return &domainUserSkill.UserSkill{
+		ID:                pm.ID,
+		UserID:            pm.UserID,
+		Name:              pm.Name,
+		Level:             domainUserSkill.Level(pm.Level),
+		YearsOfExperience: pm.YearsOfExperience,
+		PostIDs:           postIDs,
+		DisplayOrder:      pm.DisplayOrder,
+		CreatedAt:         pm.CreatedAt,
+		UpdatedAt:         pm.UpdatedAt,
+	}
+}
+
+func toPersistence(s *domainUserSkill.UserSkill) UserSkillModel {
+	postIDs := make(pq.Int64Array, len(s.PostIDs))
+	for i, id := range s.PostIDs {
+		postIDs[i] = int64(id)
+	}
+	return UserSkillModel{
+		ID:                s.ID,
+		CreatedAt:         s.CreatedAt,
+		UpdatedAt:         s.UpdatedAt,
+		UserID:            s.UserID,
+		Name:              s.Name,
+		Level:             string(s.Level),
+		YearsOfExperience: s.YearsOfExperience,
+		PostIDs:           postIDs,
+		DisplayOrder:      s.DisplayOrder,
+	}
+}
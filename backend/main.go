@@ -5,10 +5,37 @@ package main
 import (
 	"backend/config"
 	"backend/controllers"
+	domainRealtime "backend/domain/realtime"
+	domainTaxonomy "backend/domain/taxonomy"
+	domainUser "backend/domain/user"
+	analyticsInfra "backend/infrastructure/analytics"
+	auditInfra "backend/infrastructure/audit"
+	careerInfra "backend/infrastructure/career"
+	collectionInfra "backend/infrastructure/collection"
+	commentInfra "backend/infrastructure/comment"
+	engagementInfra "backend/infrastructure/engagement"
+	externalLinkInfra "backend/infrastructure/externallink"
+	gitHubInfra "backend/infrastructure/github"
+	jobInfra "backend/infrastructure/job"
+	markdownInfra "backend/infrastructure/markdown"
+	moderationInfra "backend/infrastructure/moderation"
+	notificationInfra "backend/infrastructure/notification"
+	organizationInfra "backend/infrastructure/organization"
 	portfolioInfra "backend/infrastructure/portfolio"
+	rankingInfra "backend/infrastructure/ranking"
+	realtimeInfra "backend/infrastructure/realtime"
+	recommendationInfra "backend/infrastructure/recommendation"
+	recruitInfra "backend/infrastructure/recruit"
+	resumeInfra "backend/infrastructure/resume"
+	savedSearchInfra "backend/infrastructure/savedsearch"
+	siteExportInfra "backend/infrastructure/siteexport"
+	taxonomyInfra "backend/infrastructure/taxonomy"
+	unitOfWorkInfra "backend/infrastructure/unitofwork"
 	userInfra "backend/infrastructure/user"
+	userSkillInfra "backend/infrastructure/userskill"
 	"backend/middlewares"
-	"backend/repositories"
+	"backend/migrations"
+	"backend/seeds"
 	"backend/services"
 	"log"
 	"os"
@@ -19,38 +46,151 @@ import (
 	"gorm.io/gorm"
 )
 
-func setupRouter(db *gorm.DB, authService services.IAuthService) *gin.Engine {
+// siteExportDir は書き出した静的サイトの ZIP の置き場所です。uploads/ と違って公開せず、本人だけがダウンロードできる
+const siteExportDir = "exports"
+
+func setupRouter(
+	db *gorm.DB,
+	authService services.IAuthService,
+	realtimeService services.IRealtimeService,
+	savedSearchService services.ISavedSearchService,
+) *gin.Engine {
 	frontendURL := os.Getenv("FRONTEND_URL")
 
+	userRepository := userInfra.NewUserRepository(db)
+	// 複数のリポジトリへの変更（と保存したアップロードファイル）をまとめて確定・取り消しする
+	unitOfWork := unitOfWorkInfra.NewUnitOfWork(db)
+
+	// 監査ログは各サービスから使うので最初に初期化する
+	auditRepository := auditInfra.NewAuditRepo(db)
+	auditService := services.NewAuditService(auditRepository, userRepository)
+	auditController := controllers.NewAuditController(auditService)
+
 	emailService := services.NewEmailService()
-	authController := controllers.NewAuthController(authService, emailService)
+	authController := controllers.NewAuthController(authService, emailService, auditService)
 
-	userRepository := userInfra.NewUserRepository(db)
-	userService := services.NewUserService(userRepository)
-	userController := controllers.NewUserController(userService)
+	// スキルなどの表記ゆれの読み替えに使うので、ユーザー・投稿より先に初期化する
+	taxonomyService := services.NewTaxonomyService(taxonomyInfra.NewTaxonomyRepo(db), auditService)
+	optionsController := controllers.NewOptionsController(taxonomyService)
+	taxonomyController := controllers.NewTaxonomyController(taxonomyService)
+
+	notificationRepository := notificationInfra.NewNotificationRepo(db)
+	notificationService := services.NewNotificationService(notificationRepository, realtimeService)
+	notificationController := controllers.NewNotificationController(notificationService)
 
-	jobTypeRepository := repositories.NewJobTypeRepository(db)
-	skillRepository := repositories.NewSkillRepository(db)
-	genreRepository := repositories.NewGenreRepository(db)
-	jobTypeService := services.NewJobTypeService(jobTypeRepository)
-	skillService := services.NewSkillService(skillRepository)
-	genreService := services.NewGenreService(genreRepository)
-	optionsController := controllers.NewOptionsController(jobTypeService, skillService, genreService)
+	// 説明文・自己紹介の Markdown の変換。変換結果はプロセス内に 1000 件までキャッシュする
+	markdownService := services.NewMarkdownService(markdownInfra.NewRenderer(), markdownInfra.NewMemoryCache(1000))
+	markdownController := controllers.NewMarkdownController(markdownService)
 
 	// ** 追加部分: 投稿関連のリポジトリ、サービス、コントローラの初期化 **
 	// portfolioRepository := repositories.NewPortfolioRepository(db)
 	portfolioRepository := portfolioInfra.NewPostRepo(db)
-	portfolioService := services.NewPortfolioService(portfolioRepository)
+	portfolioService := services.NewPortfolioService(portfolioRepository, unitOfWork, auditService, taxonomyService, markdownService)
 	portfolioController := controllers.NewPortfolioController(portfolioService)
+	postImportController := controllers.NewPostImportController(services.NewPostImportService(unitOfWork, auditService, taxonomyService))
+	postCollaboratorService := services.NewPostCollaboratorService(portfolioRepository, userRepository, notificationService, auditService)
+	postCollaboratorController := controllers.NewPostCollaboratorController(postCollaboratorService)
+	collectionService := services.NewCollectionService(collectionInfra.NewCollectionRepo(db), portfolioRepository, userRepository, auditService)
+	collectionController := controllers.NewCollectionController(collectionService)
+	// いいね・ブックマーク（人気順の並びは startTrendingScoreJob で更新する）
+	engagementService := services.NewEngagementService(engagementInfra.NewReactionRepo(db), portfolioRepository)
+	engagementController := controllers.NewEngagementController(engagementService)
+	// 似ている投稿・あなたへのおすすめ（startRecommendationJob で計算したものを返す）
+	recommendationService := services.NewRecommendationService(recommendationInfra.NewRecommendationRepo(db), portfolioRepository)
+	recommendationController := controllers.NewRecommendationController(recommendationService)
+
+	// プロフィールのスキルは作品を紐づけるので、投稿のリポジトリの後に初期化する
+	userSkillService := services.NewUserSkillService(userSkillInfra.NewUserSkillRepo(db), userRepository, portfolioRepository, unitOfWork, notificationService, auditService, taxonomyService)
+
+	careerService := services.NewCareerService(careerInfra.NewCareerRepo(db), unitOfWork, auditService, taxonomyService)
+	careerController := controllers.NewCareerController(careerService)
+
+	externalLinkRepository := externalLinkInfra.NewLinkRepo(db)
+	externalLinkService := services.NewExternalLinkService(externalLinkRepository, auditService)
+	externalLinkController := controllers.NewExternalLinkController(externalLinkService)
+
+	// GitHub の取り込みはテストでローカルのスタブに向けられるよう、接続先を環境変数で差し替えられる
+	gitHubClient := gitHubInfra.NewClient(os.Getenv("GITHUB_API_BASE_URL"), os.Getenv("GITHUB_TOKEN"))
+	gitHubImportService := services.NewGitHubImportService(gitHubClient, externalLinkRepository, userRepository, unitOfWork, auditService, taxonomyService)
+	gitHubImportController := controllers.NewGitHubImportController(gitHubImportService)
+
+	// 履歴書の PDF・JSON Resume の書き出し（作品の画像は uploads/ から読み込む）
+	resumeService := services.NewResumeService(
+		userRepository,
+		userSkillInfra.NewUserSkillRepo(db),
+		careerService,
+		externalLinkService,
+		portfolioRepository,
+		resumeInfra.NewPDFRenderer(""),
+		frontendURL,
+	)
+	resumeController := controllers.NewResumeController(resumeService)
+
+	// 静的サイトの ZIP の書き出し（ZIP は startSiteExportJob で作り、公開しない exports/ に置く）
+	siteExportService := services.NewSiteExportService(
+		siteExportInfra.NewExportRepo(db),
+		userRepository,
+		userSkillInfra.NewUserSkillRepo(db),
+		externalLinkService,
+		portfolioRepository,
+		markdownService,
+		notificationService,
+		siteExportInfra.NewZipBuilder(""),
+		siteExportDir,
+		frontendURL,
+		nil,
+	)
+	siteExportController := controllers.NewSiteExportController(siteExportService)
+
+	userService := services.NewUserService(userRepository, unitOfWork, auditService, taxonomyService, careerService, markdownService)
+	userSkillController := controllers.NewUserSkillController(userSkillService, userService)
+	userController := controllers.NewUserController(userService, userSkillService, careerService, externalLinkService, portfolioService)
+
+	commentRepository := commentInfra.NewCommentRepo(db)
+	commentService := services.NewCommentService(commentRepository, portfolioRepository, realtimeService)
+	commentController := controllers.NewCommentController(commentService)
+
+	realtimeController := controllers.NewRealtimeController(realtimeService)
+
+	// 企業アカウント・求人関連の初期化
+	organizationRepository := organizationInfra.NewOrganizationRepo(db)
+	organizationService := services.NewOrganizationService(organizationRepository, userRepository, notificationService, auditService)
+	organizationController := controllers.NewOrganizationController(organizationService)
+
+	// 投稿・プロフィールの閲覧の記録と本人向けの集計（集計は startAnalyticsRollupJob で更新する）
+	analyticsService := services.NewAnalyticsService(
+		analyticsInfra.NewAnalyticsRepo(db),
+		portfolioRepository,
+		userRepository,
+		organizationRepository,
+		os.Getenv("ANALYTICS_HASH_SALT"),
+		frontendURL,
+	)
+	analyticsController := controllers.NewAnalyticsController(analyticsService)
+
+	jobRepository := jobInfra.NewJobRepo(db)
+	jobService := services.NewJobService(jobRepository, organizationRepository, portfolioRepository, notificationService, taxonomyService)
+	jobController := controllers.NewJobController(jobService)
+
+	candidateRepository := recruitInfra.NewCandidateRepo(db)
+	recruitService := services.NewRecruitService(candidateRepository, jobRepository, organizationRepository, taxonomyService)
+	recruitController := controllers.NewRecruitController(recruitService)
+
+	savedSearchController := controllers.NewSavedSearchController(savedSearchService)
+
+	// 通報・運営向け機能の初期化
+	moderationRepository := moderationInfra.NewModerationRepo(db)
+	moderationService := services.NewModerationService(moderationRepository, portfolioRepository, commentRepository, userRepository, auditService)
+	moderationController := controllers.NewModerationController(moderationService)
 
 	r := gin.Default()
 	r.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{frontendURL},                               // フロントエンドのドメインを許可
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}, // 許可するHTTPメソッド
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"}, // 許可するリクエストヘッダー
-		ExposeHeaders:    []string{"Content-Length"},                          // クライアントに公開するレスポンスヘッダー
-		AllowCredentials: true,                                                // 認証情報（クッキーなど）の送信を許可
-		MaxAge:           48 * time.Hour,                                      // プリフライトリクエストのキャッシュ時間
+		AllowOrigins:     []string{frontendURL},                                                            // フロントエンドのドメインを許可
+		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},                              // 許可するHTTPメソッド
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", "Last-Event-ID", "If-Match"}, // 許可するリクエストヘッダー
+		ExposeHeaders:    []string{"Content-Length", "ETag", "X-Total-Count", "X-Truncated"},               // クライアントに公開するレスポンスヘッダー
+		AllowCredentials: true,                                                                             // 認証情報（クッキーなど）の送信を許可
+		MaxAge:           48 * time.Hour,                                                                   // プリフライトリクエストのキャッシュ時間
 	}))
 	r.Static("/uploads", "./uploads")
 
@@ -80,19 +220,181 @@ func setupRouter(db *gorm.DB, authService services.IAuthService) *gin.Engine {
 	userRouterWithAuth := r.Group("/user", middlewares.AuthMiddleware(authService))
 	userRouterWithAuth.GET("/GetInfo", userController.GetUserInfo)
 	userRouterWithAuth.PUT("/UpdateMinimumUserInfo", userController.UpdateMinimumUserInfo)
+	userRouterWithAuth.PUT("/privacy", userController.UpdatePrivacySettings)
+	userRouterWithAuth.GET("/security-events", auditController.GetMySecurityEvents)
+	userRouterWithAuth.GET("/insights", analyticsController.GetInsights)
+	userRouterWithAuth.GET("/resume.pdf", resumeController.GetResumePDF)
+	userRouterWithAuth.GET("/resume.json", resumeController.GetJSONResume)
+	userRouterWithAuth.POST("/export-site", siteExportController.RequestExport)
+	userRouterWithAuth.GET("/export-site", siteExportController.GetExports)
+	userRouterWithAuth.GET("/export-site/:id", siteExportController.GetExport)
+	userRouterWithAuth.GET("/export-site/:id/download", siteExportController.Download)
+	userRouterWithAuth.PUT("/skills", userSkillController.UpdateSkills)
+	userRouterWithAuth.GET("/educations", careerController.GetEducations)
+	userRouterWithAuth.POST("/educations", careerController.CreateEducation)
+	userRouterWithAuth.PUT("/educations/order", careerController.ReorderEducations)
+	userRouterWithAuth.PUT("/educations/:id", careerController.UpdateEducation)
+	userRouterWithAuth.DELETE("/educations/:id", careerController.DeleteEducation)
+	userRouterWithAuth.GET("/experiences", careerController.GetExperiences)
+	userRouterWithAuth.POST("/experiences", careerController.CreateExperience)
+	userRouterWithAuth.PUT("/experiences/:id", careerController.UpdateExperience)
+	userRouterWithAuth.DELETE("/experiences/:id", careerController.DeleteExperience)
+	userRouterWithAuth.PUT("/links", externalLinkController.UpdateLinks)
+	userRouterWithAuth.GET("/github/repositories", gitHubImportController.GetRepositories)
+	userRouterWithAuth.POST("/github/import", gitHubImportController.ImportRepositories)
+
+	// 他のユーザーのプロフィールとスキルの推薦
+	usersRouterWithAuth := r.Group("/users", middlewares.AuthMiddleware(authService))
+	usersRouterWithAuth.GET("/:id", userController.GetProfile)
+	usersRouterWithAuth.GET("/:id/collections", collectionController.GetUserCollections)
+	usersRouterWithAuth.POST("/:id/skills/:skillId/endorsement", userSkillController.Endorse)
+	usersRouterWithAuth.DELETE("/:id/skills/:skillId/endorsement", userSkillController.WithdrawEndorsement)
 
 	// オプション情報取得のエンドポイント
 	optionRouterWithAuth := r.Group("/options", middlewares.AuthMiddleware(authService))
 	optionRouterWithAuth.GET("/job-types", optionsController.GetJobTypes)
 	optionRouterWithAuth.GET("/skills", optionsController.GetSkills)
+	optionRouterWithAuth.GET("/skills/suggest", optionsController.SuggestSkills)
 	optionRouterWithAuth.GET("/genre", optionsController.GetGenre)
 
 	// ** 追加部分: 投稿関連のエンドポイント **
 	portfolioRouterWithAuth := r.Group("/Portfolio", middlewares.AuthMiddleware(authService))
 	portfolioRouterWithAuth.POST("/posts", portfolioController.CreatePost)
+	portfolioRouterWithAuth.POST("/import", postImportController.Import)
 	portfolioRouterWithAuth.GET("/:id", portfolioController.GetPostByID)
 	portfolioRouterWithAuth.GET("/getUserPosts", portfolioController.GetPostsByUserID)
 	portfolioRouterWithAuth.GET("/getAllPosts", portfolioController.GetAllPosts)
+	portfolioRouterWithAuth.GET("/drafts", portfolioController.GetDrafts)
+	portfolioRouterWithAuth.POST("/drafts", portfolioController.CreateDraft)
+	portfolioRouterWithAuth.GET("/archived", portfolioController.GetArchived)
+	portfolioRouterWithAuth.PUT("/:id", portfolioController.UpdatePost)
+	portfolioRouterWithAuth.DELETE("/:id", portfolioController.DeletePost)
+	portfolioRouterWithAuth.PUT("/:id/autosave", portfolioController.AutosaveDraft)
+	portfolioRouterWithAuth.POST("/:id/images", portfolioController.AddImages)
+	portfolioRouterWithAuth.POST("/:id/publish", portfolioController.Publish)
+	portfolioRouterWithAuth.POST("/:id/schedule", portfolioController.Schedule)
+	portfolioRouterWithAuth.POST("/:id/unschedule", portfolioController.Unschedule)
+	portfolioRouterWithAuth.POST("/:id/archive", portfolioController.Archive)
+	portfolioRouterWithAuth.POST("/:id/unarchive", portfolioController.Unarchive)
+	portfolioRouterWithAuth.GET("/:id/revisions", portfolioController.GetRevisions)
+	portfolioRouterWithAuth.GET("/:id/revisions/:number", portfolioController.GetRevision)
+	portfolioRouterWithAuth.GET("/:id/revisions/:number/diff", portfolioController.DiffRevisions)
+	portfolioRouterWithAuth.POST("/:id/revisions/:number/restore", portfolioController.RestoreRevision)
+	portfolioRouterWithAuth.GET("/invitations", postCollaboratorController.GetInvitations)
+	portfolioRouterWithAuth.GET("/:id/collaborators", postCollaboratorController.GetCollaborators)
+	portfolioRouterWithAuth.POST("/:id/collaborators", postCollaboratorController.Invite)
+	portfolioRouterWithAuth.POST("/:id/collaborators/accept", postCollaboratorController.Accept)
+	portfolioRouterWithAuth.POST("/:id/collaborators/decline", postCollaboratorController.Decline)
+	portfolioRouterWithAuth.PUT("/:id/collaborators/me", postCollaboratorController.UpdateContribution)
+	portfolioRouterWithAuth.DELETE("/:id/collaborators/:userId", postCollaboratorController.Remove)
+	portfolioRouterWithAuth.GET("/bookmarks", engagementController.GetBookmarks)
+	portfolioRouterWithAuth.GET("/recommended", recommendationController.GetRecommended)
+	portfolioRouterWithAuth.GET("/:id/similar", recommendationController.GetSimilar)
+	portfolioRouterWithAuth.GET("/:id/engagement", engagementController.GetSummary)
+	portfolioRouterWithAuth.POST("/:id/like", engagementController.Like)
+	portfolioRouterWithAuth.DELETE("/:id/like", engagementController.Unlike)
+	portfolioRouterWithAuth.POST("/:id/bookmark", engagementController.Bookmark)
+	portfolioRouterWithAuth.DELETE("/:id/bookmark", engagementController.Unbookmark)
+	portfolioRouterWithAuth.GET("/:id/comments", commentController.GetComments)
+	portfolioRouterWithAuth.POST("/:id/comments", commentController.CreateComment)
+
+	// 投稿のコレクション（特集はトップページ用）
+	collectionRouterWithAuth := r.Group("/collections", middlewares.AuthMiddleware(authService))
+	collectionRouterWithAuth.GET("/mine", collectionController.GetMyCollections)
+	collectionRouterWithAuth.GET("/featured", collectionController.GetFeatured)
+	collectionRouterWithAuth.POST("", collectionController.CreateCollection)
+	collectionRouterWithAuth.PUT("/order", collectionController.ReorderCollections)
+	collectionRouterWithAuth.GET("/:id", collectionController.GetCollection)
+	collectionRouterWithAuth.PUT("/:id", collectionController.UpdateCollection)
+	collectionRouterWithAuth.DELETE("/:id", collectionController.DeleteCollection)
+	collectionRouterWithAuth.PUT("/:id/posts", collectionController.SetPosts)
+	collectionRouterWithAuth.POST("/:id/posts", collectionController.AddPost)
+	collectionRouterWithAuth.DELETE("/:id/posts/:postId", collectionController.RemovePost)
+
+	// 閲覧の記録（公開ページからも送るので未ログインでも受け付ける）
+	analyticsRouter := r.Group("/analytics", middlewares.OptionalAuthMiddleware(authService))
+	analyticsRouter.POST("/views", analyticsController.RecordView)
+
+	// エディタのプレビュー用の Markdown 変換
+	renderRouterWithAuth := r.Group("/render", middlewares.AuthMiddleware(authService))
+	renderRouterWithAuth.POST("/markdown", markdownController.Render)
+
+	// リアルタイム配信 (Server-Sent Events) のエンドポイント
+	realtimeRouterWithAuth := r.Group("/realtime", middlewares.AuthMiddleware(authService))
+	realtimeRouterWithAuth.GET("/stream", realtimeController.Stream)
+
+	// 通知のエンドポイント
+	notificationRouterWithAuth := r.Group("/notifications", middlewares.AuthMiddleware(authService))
+	notificationRouterWithAuth.GET("", notificationController.GetNotifications)
+	notificationRouterWithAuth.PUT("/:id/read", notificationController.MarkAsRead)
+	notificationRouterWithAuth.PUT("/read-all", notificationController.MarkAllAsRead)
+
+	// 企業アカウントのエンドポイント
+	organizationRouterWithAuth := r.Group("/organizations", middlewares.AuthMiddleware(authService))
+	organizationRouterWithAuth.POST("", organizationController.CreateOrganization)
+	organizationRouterWithAuth.GET("/mine", organizationController.GetMyOrganizations)
+	organizationRouterWithAuth.POST("/:id/members", organizationController.AddMember)
+	organizationRouterWithAuth.PUT("/:id/view-disclosure", organizationController.SetViewDisclosure)
+	organizationRouterWithAuth.GET("/:id/jobs", jobController.GetOrganizationJobPostings)
+
+	// 求人・応募のエンドポイント
+	jobRouterWithAuth := r.Group("/jobs", middlewares.AuthMiddleware(authService))
+	jobRouterWithAuth.GET("", jobController.SearchJobPostings)
+	jobRouterWithAuth.POST("", jobController.CreateJobPosting)
+	jobRouterWithAuth.GET("/:id", jobController.GetJobPosting)
+	jobRouterWithAuth.PUT("/:id", jobController.UpdateJobPosting)
+	jobRouterWithAuth.DELETE("/:id", jobController.DeleteJobPosting)
+	jobRouterWithAuth.POST("/:id/publish", jobController.PublishJobPosting)
+	jobRouterWithAuth.POST("/:id/close", jobController.CloseJobPosting)
+	jobRouterWithAuth.POST("/:id/applications", jobController.Apply)
+	jobRouterWithAuth.GET("/:id/applications", jobController.GetJobApplications)
+
+	applicationRouterWithAuth := r.Group("/applications", middlewares.AuthMiddleware(authService))
+	applicationRouterWithAuth.GET("/mine", jobController.GetMyApplications)
+	applicationRouterWithAuth.PUT("/:id/status", jobController.UpdateApplicationStatus)
+
+	// 企業の採用担当向けエンドポイント
+	recruitRouterWithAuth := r.Group("/recruit", middlewares.AuthMiddleware(authService), middlewares.RequireRole(domainUser.RoleRecruiter))
+	recruitRouterWithAuth.GET("/candidates", recruitController.SearchCandidates)
+
+	// 保存した検索条件のエンドポイント（配信停止はメールのリンクから開くため認証なし）
+	// GET は確認ページを返すだけで、配信停止は POST（ワンクリック配信停止を含む）で行う
+	r.GET("/saved-searches/unsubscribe", savedSearchController.ConfirmUnsubscribe)
+	r.POST("/saved-searches/unsubscribe", savedSearchController.Unsubscribe)
+	savedSearchRouterWithAuth := r.Group("/saved-searches", middlewares.AuthMiddleware(authService))
+	savedSearchRouterWithAuth.GET("", savedSearchController.GetSavedSearches)
+	savedSearchRouterWithAuth.POST("", savedSearchController.CreateSavedSearch)
+	savedSearchRouterWithAuth.PUT("/:id", savedSearchController.UpdateSavedSearch)
+	savedSearchRouterWithAuth.DELETE("/:id", savedSearchController.DeleteSavedSearch)
+
+	// 投稿・コメント・プロフィールの通報
+	reportRouterWithAuth := r.Group("/reports", middlewares.AuthMiddleware(authService))
+	reportRouterWithAuth.POST("", moderationController.CreateReport)
+
+	// 運営向けエンドポイント
+	adminRouterWithAuth := r.Group("/admin", middlewares.AuthMiddleware(authService), middlewares.RequireRole(domainUser.RoleAdmin))
+	adminRouterWithAuth.GET("/reports", moderationController.GetReports)
+	adminRouterWithAuth.PUT("/reports/:id", moderationController.CloseReport)
+	adminRouterWithAuth.POST("/posts/:id/hide", moderationController.HidePost)
+	adminRouterWithAuth.POST("/posts/:id/unhide", moderationController.UnhidePost)
+	adminRouterWithAuth.POST("/users/:id/suspend", moderationController.SuspendUser)
+	adminRouterWithAuth.POST("/users/:id/unsuspend", moderationController.UnsuspendUser)
+	adminRouterWithAuth.GET("/notes", moderationController.GetNotes)
+	adminRouterWithAuth.POST("/notes", moderationController.AddNote)
+	adminRouterWithAuth.GET("/audit-logs", auditController.SearchAuditLogs)
+	adminRouterWithAuth.GET("/organizations/pending", organizationController.GetPendingOrganizations)
+	adminRouterWithAuth.POST("/organizations/:id/approve", organizationController.ApproveOrganization)
+	adminRouterWithAuth.PUT("/collections/featured/order", collectionController.ReorderFeatured)
+	adminRouterWithAuth.POST("/collections/:id/feature", collectionController.Feature)
+	adminRouterWithAuth.POST("/collections/:id/unfeature", collectionController.Unfeature)
+	adminRouterWithAuth.GET("/taxonomies/:kind", taxonomyController.ListOptions)
+	adminRouterWithAuth.POST("/taxonomies/:kind", taxonomyController.CreateOption)
+	adminRouterWithAuth.PUT("/taxonomies/:kind/order", taxonomyController.ReorderOptions)
+	adminRouterWithAuth.PUT("/taxonomies/:kind/:id", taxonomyController.UpdateOption)
+	adminRouterWithAuth.DELETE("/taxonomies/:kind/:id", taxonomyController.DeleteOption)
+	adminRouterWithAuth.POST("/taxonomies/:kind/:id/merge", taxonomyController.MergeOption)
+	adminRouterWithAuth.POST("/taxonomies/:kind/:id/retire", taxonomyController.RetireOption)
+	adminRouterWithAuth.POST("/taxonomies/:kind/:id/restore", taxonomyController.RestoreOption)
 
 	return r
 }
@@ -125,10 +427,172 @@ func startPermanentDeletionJob(authService services.IAuthService) {
 	}()
 }
 
+func startRealtimeEventPurgeJob(realtimeService services.IRealtimeService) {
+	ticker := time.NewTicker(24 * time.Hour)
+	go func() {
+		for range ticker.C {
+			err := realtimeService.PurgeOldEvents()
+			if err != nil {
+				log.Printf("Error purging realtime events: %v", err)
+			} else {
+				log.Println("Realtime event purge job executed successfully")
+			}
+		}
+	}()
+}
+
+func startAuditLogRetentionJob(auditService services.IAuditService) {
+	ticker := time.NewTicker(24 * time.Hour)
+	go func() {
+		for range ticker.C {
+			err := auditService.PurgeOldEntries()
+			if err != nil {
+				log.Printf("Error purging audit logs: %v", err)
+			} else {
+				log.Println("Audit log retention job executed successfully")
+			}
+		}
+	}()
+}
+
+// 保存した検索は日次・週次だが、登録時刻からずれすぎないよう 1 時間ごとに期限を確認する
+func startSavedSearchAlertJob(savedSearchService services.ISavedSearchService) {
+	ticker := time.NewTicker(time.Hour)
+	go func() {
+		for range ticker.C {
+			err := savedSearchService.RunDigests(time.Now())
+			if err != nil {
+				log.Printf("Error running saved search alerts: %v", err)
+			} else {
+				log.Println("Saved search alert job executed successfully")
+			}
+		}
+	}()
+}
+
+// 予約投稿は分単位で指定されるので 1 分ごとに公開日時を過ぎたものを公開する
+func startScheduledPostPublishJob(portfolioService services.IPortfolioService) {
+	ticker := time.NewTicker(time.Minute)
+	go func() {
+		for range ticker.C {
+			n, err := portfolioService.PublishDue(time.Now())
+			if err != nil {
+				log.Printf("Error publishing scheduled posts: %v", err)
+			} else if n > 0 {
+				log.Printf("Published %d scheduled posts", n)
+			}
+		}
+	}()
+}
+
+// 閲覧の生データを 1 時間ごとに日ごとの集計にまとめる（当日分は次の集計まで反映されない）
+func startAnalyticsRollupJob(analyticsService services.IAnalyticsService) {
+	ticker := time.NewTicker(time.Hour)
+	go func() {
+		for range ticker.C {
+			n, err := analyticsService.Rollup(time.Now())
+			if err != nil {
+				log.Printf("Error rolling up view analytics: %v", err)
+			} else {
+				log.Printf("Rolled up view analytics for %d days", n)
+			}
+		}
+	}()
+}
+
+// 人気順の並びを 10 分ごとに計算し直す（直近の反応ほど重く数える）
+func startTrendingScoreJob(rankingService services.IRankingService) {
+	ticker := time.NewTicker(10 * time.Minute)
+	go func() {
+		for range ticker.C {
+			n, err := rankingService.Recompute()
+			if err != nil {
+				log.Printf("Error recomputing trending scores: %v", err)
+			} else {
+				log.Printf("Recomputed trending scores for %d posts", n)
+			}
+		}
+	}()
+}
+
+// 似ている投稿とおすすめを 1 時間ごとに計算し直す（リクエスト時は計算済みの結果を読むだけ）
+func startRecommendationJob(recommendationService services.IRecommendationService) {
+	ticker := time.NewTicker(time.Hour)
+	go func() {
+		for range ticker.C {
+			n, err := recommendationService.Recompute()
+			if err != nil {
+				log.Printf("Error recomputing recommendations: %v", err)
+			} else {
+				log.Printf("Recomputed recommendations for %d posts", n)
+			}
+		}
+	}()
+}
+
+// 受け付けた静的サイトの書き出しを 30 秒ごとに作り、期限を過ぎた ZIP を削除する
+func startSiteExportJob(siteExportService services.ISiteExportService) {
+	ticker := time.NewTicker(30 * time.Second)
+	go func() {
+		for range ticker.C {
+			n, err := siteExportService.ProcessPending(5)
+			if err != nil {
+				log.Printf("Error exporting sites: %v", err)
+			} else if n > 0 {
+				log.Printf("Exported %d sites", n)
+			}
+			if _, err := siteExportService.Purge(); err != nil {
+				log.Printf("Error purging site exports: %v", err)
+			}
+		}
+	}()
+}
+
+// runMigrations は未適用のマイグレーションを適用します
+// 複数のレプリカが同時に起動しても advisory lock で順番に実行されます
+func runMigrations(db *gorm.DB) {
+	migrator, err := migrations.New(db)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+	applied, err := migrator.Up()
+	if err != nil {
+		log.Fatalf("Failed to migrate db: %v", err)
+	}
+	for _, m := range applied {
+		log.Printf("Applied migration %04d_%s", m.Version, m.Name)
+	}
+}
+
+// runSeeds は組み込みの初期データのうち未登録のものを追加します
+func runSeeds(db *gorm.DB) {
+	taxonomy, err := seeds.LoadTaxonomy("")
+	if err != nil {
+		log.Fatalf("Failed to load seeds: %v", err)
+	}
+	// 初期データの投入は運営の操作ではないので監査ログには残さない
+	taxonomyService := services.NewTaxonomyService(taxonomyInfra.NewTaxonomyRepo(db), nil)
+	byKind := taxonomy.ByKind()
+	for _, kind := range domainTaxonomy.Kinds {
+		if _, err := taxonomyService.Seed(kind, byKind[kind]); err != nil {
+			log.Fatalf("Failed to seed %s: %v", kind, err)
+		}
+	}
+}
+
 func main() {
 	config.Initialize()
 	db := config.SetupDB()
 
+	// SQLite モードはインメモリ DB なので起動のたびにスキーマと初期データを作る
+	// PostgreSQL では go run ./cmd/migrate up と go run ./cmd/seed で適用する（MIGRATE_ON_START=true なら起動時に適用）
+	if !config.UsePostgres() || os.Getenv("MIGRATE_ON_START") == "true" {
+		runMigrations(db)
+	}
+	if !config.UsePostgres() {
+		runSeeds(db)
+	}
+
 	userRepository := userInfra.NewUserRepository(db)
 	authService := services.NewAuthService(userRepository)
 
@@ -136,6 +600,83 @@ func main() {
 	startSoftDeleteJob(authService)
 	startPermanentDeletionJob(authService)
 
-	r := setupRouter(db, authService)
+	auditService := services.NewAuditService(auditInfra.NewAuditRepo(db), userRepository)
+
+	// 保存した検索の新着通知メール
+	savedSearchService := services.NewSavedSearchService(
+		savedSearchInfra.NewSavedSearchRepo(db),
+		portfolioInfra.NewPostRepo(db),
+		recruitInfra.NewCandidateRepo(db),
+		userRepository,
+		services.NewEmailService(),
+		services.NewTaxonomyService(taxonomyInfra.NewTaxonomyRepo(db), auditService),
+	)
+	startSavedSearchAlertJob(savedSearchService)
+
+	// 予約投稿の公開
+	startScheduledPostPublishJob(services.NewPortfolioService(
+		portfolioInfra.NewPostRepo(db),
+		unitOfWorkInfra.NewUnitOfWork(db),
+		auditService,
+		services.NewTaxonomyService(taxonomyInfra.NewTaxonomyRepo(db), auditService),
+		services.NewMarkdownService(markdownInfra.NewRenderer(), markdownInfra.NewMemoryCache(1000)),
+	))
+
+	// 閲覧の集計
+	startAnalyticsRollupJob(services.NewAnalyticsService(
+		analyticsInfra.NewAnalyticsRepo(db),
+		portfolioInfra.NewPostRepo(db),
+		userRepository,
+		organizationInfra.NewOrganizationRepo(db),
+		os.Getenv("ANALYTICS_HASH_SALT"),
+		os.Getenv("FRONTEND_URL"),
+	))
+
+	// 人気順の計算
+	startTrendingScoreJob(services.NewRankingService(
+		rankingInfra.NewRankingRepo(db),
+		portfolioInfra.NewPostRepo(db),
+		time.Now,
+	))
+
+	// 似ている投稿・おすすめの計算
+	startRecommendationJob(services.NewRecommendationService(
+		recommendationInfra.NewRecommendationRepo(db),
+		portfolioInfra.NewPostRepo(db),
+	))
+
+	// 保存期間を過ぎた監査ログの削除
+	startAuditLogRetentionJob(auditService)
+
+	// リアルタイム配信の開始（PostgreSQL では LISTEN/NOTIFY でレプリカ間を中継する）
+	eventRepository := realtimeInfra.NewEventRepository(db)
+	var broker domainRealtime.Broker
+	if config.UsePostgres() {
+		broker = realtimeInfra.NewPostgresBroker(db, config.PostgresDSN(), eventRepository)
+	} else {
+		broker = realtimeInfra.NewMemoryBroker()
+	}
+	realtimeService := services.NewRealtimeService(eventRepository, broker)
+	if err := realtimeService.Start(); err != nil {
+		log.Fatalf("Failed to start realtime service: %v", err)
+	}
+	startRealtimeEventPurgeJob(realtimeService)
+
+	// 静的サイトの書き出し（完了をリアルタイム配信の通知で知らせるので、配信の開始後に始める）
+	startSiteExportJob(services.NewSiteExportService(
+		siteExportInfra.NewExportRepo(db),
+		userRepository,
+		userSkillInfra.NewUserSkillRepo(db),
+		services.NewExternalLinkService(externalLinkInfra.NewLinkRepo(db), auditService),
+		portfolioInfra.NewPostRepo(db),
+		services.NewMarkdownService(markdownInfra.NewRenderer(), markdownInfra.NewMemoryCache(1000)),
+		services.NewNotificationService(notificationInfra.NewNotificationRepo(db), realtimeService),
+		siteExportInfra.NewZipBuilder(""),
+		siteExportDir,
+		os.Getenv("FRONTEND_URL"),
+		nil,
+	))
+
+	r := setupRouter(db, authService, realtimeService, savedSearchService)
 	r.Run("0.0.0.0:8080") // 0.0.0.0:8080 でサーバーを立てます。
 }
@@ -22,8 +22,29 @@ func AuthMiddleware(authService services.IAuthService) gin.HandlerFunc {
 			return
 		}
 
+		// 運営により利用停止されたユーザーはログイン中でも拒否する
+		if user.IsSuspended() {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Account suspended"})
+			return
+		}
+
 		ctx.Set("user", user)
 
 		ctx.Next()
 	}
 }
+
+// OptionalAuthMiddleware はログインしていれば AuthMiddleware と同じくユーザーを設定し、
+// 未ログイン・トークンが無効な場合もそのまま通します（未ログインでも使えるエンドポイント用）
+func OptionalAuthMiddleware(authService services.IAuthService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		tokenString, err := ctx.Cookie("jwt-token")
+		if err == nil {
+			if user, err := authService.GetUserFromToken(tokenString); err == nil && !user.IsSuspended() {
+				ctx.Set("user", user)
+			}
+		}
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,29 @@
+package middlewares
+
+import (
+	domainUser "backend/domain/user"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireRole は AuthMiddleware の後に置き、指定したいずれかの種別のユーザーだけを通します
+func RequireRole(roles ...domainUser.Role) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, exists := ctx.Get("user")
+		if !exists {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+			return
+		}
+
+		currentUser := user.(*domainUser.UserModel)
+		for _, role := range roles {
+			if currentUser.Role == role {
+				ctx.Next()
+				return
+			}
+		}
+
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	}
+}
@@ -0,0 +1,297 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// 0001_baseline は AutoMigrate で管理していた時点のスキーマです
+// 以降のモデル変更で内容が変わらないよう、当時のモデル定義をここに固定しています
+// （既存の DB に対して実行しても、AutoMigrate と同じく不足分を作るだけです）
+func init() {
+	register(Migration{
+		Version: 1,
+		Name:    "baseline",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(baselineTables()...)
+		},
+		Down: func(tx *gorm.DB) error {
+			tables := baselineTables()
+			for i := len(tables) - 1; i >= 0; i-- {
+				if err := tx.Migrator().DropTable(tables[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
+
+func baselineTables() []interface{} {
+	return []interface{}{
+		&baselineUser{}, &baselineJobType{}, &baselineSkill{}, &baselineGenre{},
+		&baselinePost{}, &baselineImage{}, &baselineComment{}, &baselineRealtimeEvent{},
+		&baselineNotification{}, &baselineOrganization{}, &baselineOrganizationMember{},
+		&baselineJobPosting{}, &baselineJobApplication{}, &baselineSavedSearch{},
+		&baselineReport{}, &baselineModerationNote{}, &baselineAuditLog{},
+	}
+}
+
+type baselineUser struct {
+	gorm.Model
+
+	FirstName             string  `gorm:"not null"`
+	LastName              string  `gorm:"not null"`
+	FirstNameKana         string  `gorm:"not null"`
+	LastNameKana          string  `gorm:"not null"`
+	Email                 string  `gorm:"not null;unique"`
+	Password              *string `gorm:"size:255"`
+	IsVerified            bool    `gorm:"default:false"`
+	VerificationToken     *string `gorm:"size:255"`
+	VerificationExpiresAt time.Time
+	PasswordResetToken    string `gorm:"size:255"`
+	PasswordResetExpires  time.Time
+	Role                  string `gorm:"size:32;not null;default:student"`
+
+	SchoolName     string `gorm:"size:255"`
+	Department     string `gorm:"size:255"`
+	Laboratory     string `gorm:"size:255"`
+	GraduationYear string `gorm:"size:4"`
+
+	DesiredJobTypes pq.StringArray `gorm:"type:text[]"`
+	Skills          pq.StringArray `gorm:"type:text[]"`
+
+	SelfIntroduction string `gorm:"type:text"`
+	ProfileImageURL  string `gorm:"size:512"`
+
+	ProfileVisibility    string `gorm:"size:32;not null;default:public"`
+	HiddenFromRecruiters bool   `gorm:"not null;default:false"`
+
+	SuspendedAt      *time.Time
+	SuspensionReason string `gorm:"type:text"`
+}
+
+func (baselineUser) TableName() string { return "user_models" }
+
+type baselineJobType struct {
+	gorm.Model
+	Name string `gorm:"unique;not null"`
+}
+
+func (baselineJobType) TableName() string { return "job_types" }
+
+type baselineSkill struct {
+	gorm.Model
+	Name string `gorm:"unique;not null"`
+}
+
+func (baselineSkill) TableName() string { return "skills" }
+
+type baselineGenre struct {
+	gorm.Model
+	Name string `gorm:"unique;not null"`
+}
+
+func (baselineGenre) TableName() string { return "genres" }
+
+type baselinePost struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	Title       string          `gorm:"not null"`
+	Description string          `gorm:"type:text"`
+	Genres      pq.StringArray  `gorm:"type:text[]"`
+	Skills      pq.StringArray  `gorm:"type:text[]"`
+	Images      []baselineImage `gorm:"foreignKey:PostID"`
+	UserID      uint            `gorm:"not null;index"`
+	User        baselineUser    `gorm:"foreignKey:UserID;references:ID"`
+
+	HiddenAt     *time.Time `gorm:"index"`
+	HiddenReason string     `gorm:"type:text"`
+}
+
+func (baselinePost) TableName() string { return "post_models" }
+
+type baselineImage struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	URL    string `gorm:"not null"`
+	PostID uint   `gorm:"not null;index"`
+}
+
+func (baselineImage) TableName() string { return "image_models" }
+
+type baselineComment struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	PostID uint         `gorm:"not null;index"`
+	UserID uint         `gorm:"not null;index"`
+	User   baselineUser `gorm:"foreignKey:UserID;references:ID"`
+	Body   string       `gorm:"type:text;not null"`
+}
+
+func (baselineComment) TableName() string { return "comments" }
+
+type baselineRealtimeEvent struct {
+	ID        uint      `gorm:"primaryKey"`
+	CreatedAt time.Time `gorm:"index"`
+
+	UserID uint   `gorm:"not null;index"`
+	Type   string `gorm:"size:32;not null"`
+	Data   string `gorm:"type:text;not null"`
+}
+
+func (baselineRealtimeEvent) TableName() string { return "realtime_events" }
+
+type baselineNotification struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	UserID uint   `gorm:"not null;index"`
+	Type   string `gorm:"size:64;not null"`
+	Title  string `gorm:"size:255;not null"`
+	Body   string `gorm:"type:text"`
+	Link   string `gorm:"size:512"`
+	ReadAt *time.Time
+}
+
+func (baselineNotification) TableName() string { return "notifications" }
+
+type baselineOrganization struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	Name        string `gorm:"size:255;not null"`
+	Description string `gorm:"type:text"`
+	WebsiteURL  string `gorm:"size:512"`
+}
+
+func (baselineOrganization) TableName() string { return "organizations" }
+
+type baselineOrganizationMember struct {
+	OrganizationID uint   `gorm:"primaryKey"`
+	UserID         uint   `gorm:"primaryKey;index"`
+	Role           string `gorm:"size:32;not null"`
+	CreatedAt      time.Time
+}
+
+func (baselineOrganizationMember) TableName() string { return "organization_members" }
+
+type baselineJobPosting struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	OrganizationID  uint                 `gorm:"not null;index"`
+	Organization    baselineOrganization `gorm:"foreignKey:OrganizationID;references:ID"`
+	CreatedByUserID uint                 `gorm:"not null"`
+
+	Title          string         `gorm:"size:255;not null"`
+	Description    string         `gorm:"type:text"`
+	EmploymentType string         `gorm:"size:32;not null"`
+	JobTypes       pq.StringArray `gorm:"type:text[]"`
+	Skills         pq.StringArray `gorm:"type:text[]"`
+	Location       string         `gorm:"size:255"`
+
+	Status      string `gorm:"size:32;not null;index"`
+	Deadline    *time.Time
+	PublishedAt *time.Time
+	ClosedAt    *time.Time
+}
+
+func (baselineJobPosting) TableName() string { return "job_postings" }
+
+type baselineJobApplication struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	JobPostingID uint               `gorm:"not null;uniqueIndex:idx_applications_job_user"`
+	JobPosting   baselineJobPosting `gorm:"foreignKey:JobPostingID;references:ID"`
+	UserID       uint               `gorm:"not null;uniqueIndex:idx_applications_job_user;index"`
+	User         baselineUser       `gorm:"foreignKey:UserID;references:ID"`
+	Message      string             `gorm:"type:text"`
+	PostIDs      pq.Int64Array      `gorm:"type:bigint[]"`
+	Status       string             `gorm:"size:32;not null"`
+}
+
+func (baselineJobApplication) TableName() string { return "job_applications" }
+
+type baselineSavedSearch struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	UserID uint   `gorm:"not null;index"`
+	Name   string `gorm:"size:255;not null"`
+	Target string `gorm:"size:32;not null"`
+
+	Keyword        string         `gorm:"size:255"`
+	Genres         pq.StringArray `gorm:"type:text[]"`
+	Skills         pq.StringArray `gorm:"type:text[]"`
+	GraduationYear string         `gorm:"size:4"`
+
+	Frequency        string    `gorm:"size:16;not null"`
+	Active           bool      `gorm:"not null;index"`
+	UnsubscribeToken string    `gorm:"size:64;not null;uniqueIndex"`
+	LastRunAt        time.Time `gorm:"not null"`
+}
+
+func (baselineSavedSearch) TableName() string { return "saved_searches" }
+
+type baselineReport struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	ReporterID uint   `gorm:"not null;index"`
+	TargetType string `gorm:"size:32;not null;index:idx_reports_target"`
+	TargetID   uint   `gorm:"not null;index:idx_reports_target"`
+	Category   string `gorm:"size:32;not null"`
+	Detail     string `gorm:"type:text"`
+
+	Status       string `gorm:"size:32;not null;index"`
+	ResolvedByID *uint
+	ResolvedAt   *time.Time
+}
+
+func (baselineReport) TableName() string { return "reports" }
+
+type baselineModerationNote struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	TargetType string `gorm:"size:32;not null;index:idx_moderation_notes_target"`
+	TargetID   uint   `gorm:"not null;index:idx_moderation_notes_target"`
+	AuthorID   uint   `gorm:"not null"`
+	Body       string `gorm:"type:text;not null"`
+}
+
+func (baselineModerationNote) TableName() string { return "moderation_notes" }
+
+type baselineAuditLog struct {
+	ID        uint      `gorm:"primaryKey"`
+	CreatedAt time.Time `gorm:"index"`
+
+	ActorID    uint   `gorm:"index"`
+	Action     string `gorm:"size:64;not null;index"`
+	TargetType string `gorm:"size:32"`
+	TargetID   uint
+	Detail     string `gorm:"type:text"`
+	IP         string `gorm:"size:64"`
+	UserAgent  string `gorm:"size:512"`
+}
+
+func (baselineAuditLog) TableName() string { return "audit_logs" }
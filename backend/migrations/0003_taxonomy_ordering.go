@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 0003_taxonomy_ordering は選択肢に表示順と廃止日時を追加します
+func init() {
+	register(Migration{
+		Version: 3,
+		Name:    "taxonomy_ordering",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&taxonomyJobTypeV3{}, &taxonomySkillV3{}, &taxonomyGenreV3{})
+		},
+		Down: func(tx *gorm.DB) error {
+			for _, table := range []interface{}{&taxonomyJobTypeV3{}, &taxonomySkillV3{}, &taxonomyGenreV3{}} {
+				for _, column := range []string{"DisplayOrder", "RetiredAt"} {
+					if err := tx.Migrator().DropColumn(table, column); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+	})
+}
+
+type taxonomyJobTypeV3 struct {
+	gorm.Model
+	Name         string `gorm:"unique;not null"`
+	DisplayOrder int    `gorm:"not null;default:0;index"`
+	RetiredAt    *time.Time
+}
+
+func (taxonomyJobTypeV3) TableName() string { return "job_types" }
+
+type taxonomySkillV3 struct {
+	gorm.Model
+	Name         string `gorm:"unique;not null"`
+	DisplayOrder int    `gorm:"not null;default:0;index"`
+	RetiredAt    *time.Time
+}
+
+func (taxonomySkillV3) TableName() string { return "skills" }
+
+type taxonomyGenreV3 struct {
+	gorm.Model
+	Name         string `gorm:"unique;not null"`
+	DisplayOrder int    `gorm:"not null;default:0;index"`
+	RetiredAt    *time.Time
+}
+
+func (taxonomyGenreV3) TableName() string { return "genres" }
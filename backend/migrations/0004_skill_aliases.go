@@ -0,0 +1,168 @@
+package migrations
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// 0004_skill_aliases は選択肢に分類と別名を追加し、
+// ユーザー・投稿などに自由入力で保存されていたスキルを登録済みのスキル名に揃えます
+// （"react", "ＲＥＡＣＴ" などを "React" に。別名による読み替えは go run ./cmd/seed の実行時に行う）
+func init() {
+	register(Migration{
+		Version: 4,
+		Name:    "skill_aliases",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&taxonomyJobTypeV4{}, &taxonomySkillV4{}, &taxonomyGenreV4{}, &taxonomyAliasV4{}); err != nil {
+				return err
+			}
+			return normalizeSkillValuesV4(tx)
+		},
+		Down: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&taxonomyAliasV4{}); err != nil {
+				return err
+			}
+			for _, table := range []interface{}{&taxonomyJobTypeV4{}, &taxonomySkillV4{}, &taxonomyGenreV4{}} {
+				if err := tx.Migrator().DropColumn(table, "Category"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
+
+type taxonomyJobTypeV4 struct {
+	gorm.Model
+	Name         string `gorm:"unique;not null"`
+	DisplayOrder int    `gorm:"not null;default:0;index"`
+	RetiredAt    *time.Time
+	Category     string `gorm:"size:32;not null;default:''"`
+}
+
+func (taxonomyJobTypeV4) TableName() string { return "job_types" }
+
+type taxonomySkillV4 struct {
+	gorm.Model
+	Name         string `gorm:"unique;not null"`
+	DisplayOrder int    `gorm:"not null;default:0;index"`
+	RetiredAt    *time.Time
+	Category     string `gorm:"size:32;not null;default:''"`
+}
+
+func (taxonomySkillV4) TableName() string { return "skills" }
+
+type taxonomyGenreV4 struct {
+	gorm.Model
+	Name         string `gorm:"unique;not null"`
+	DisplayOrder int    `gorm:"not null;default:0;index"`
+	RetiredAt    *time.Time
+	Category     string `gorm:"size:32;not null;default:''"`
+}
+
+func (taxonomyGenreV4) TableName() string { return "genres" }
+
+type taxonomyAliasV4 struct {
+	ID       uint   `gorm:"primaryKey"`
+	Kind     string `gorm:"size:32;not null;uniqueIndex:idx_taxonomy_aliases_key"`
+	Key      string `gorm:"size:255;not null;uniqueIndex:idx_taxonomy_aliases_key"`
+	OptionID uint   `gorm:"not null;index"`
+	Alias    string `gorm:"size:255;not null"`
+}
+
+func (taxonomyAliasV4) TableName() string { return "taxonomy_aliases" }
+
+func normalizeSkillValuesV4(tx *gorm.DB) error {
+	var skills []taxonomySkillV4
+	if err := tx.Find(&skills).Error; err != nil {
+		return err
+	}
+	// この時点ではまだ別名は無いので、スキル名の正規化キーだけで読み替える
+	nameByKey := make(map[string]string, len(skills))
+	for _, s := range skills {
+		k := keyV4(s.Name)
+		if _, exists := nameByKey[k]; !exists {
+			nameByKey[k] = s.Name
+		}
+	}
+
+	for _, col := range []struct{ table, column string }{
+		{"user_models", "skills"},
+		{"post_models", "skills"},
+		{"job_postings", "skills"},
+		{"saved_searches", "skills"},
+	} {
+		var rows []struct {
+			ID   uint
+			Vals pq.StringArray `gorm:"type:text[]"`
+		}
+		if err := tx.Table(col.table).Select("id, " + col.column + " AS vals").
+			Where(col.column + " IS NOT NULL").Scan(&rows).Error; err != nil {
+			return err
+		}
+		for _, row := range rows {
+			normalized := normalizeV4(nameByKey, row.Vals)
+			if equalV4(normalized, row.Vals) {
+				continue
+			}
+			if err := tx.Table(col.table).Where("id = ?", row.ID).Update(col.column, pq.StringArray(normalized)).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// keyV4 と normalizeV4 は、このマイグレーションを書いた時点の domain/taxonomy の Key と
+// Resolver.Normalize を固定したコピーです。後から正規化の規則が変わっても結果が変わらないように、
+// ドメインのコードは参照しない
+func keyV4(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(s)) {
+		if r >= '！' && r <= '～' {
+			r = r - '！' + '!'
+			if r >= 'A' && r <= 'Z' {
+				r += 'a' - 'A'
+			}
+		}
+		switch r {
+		case ' ', '\u3000', '\t', '-', '_', '.':
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func normalizeV4(nameByKey map[string]string, values []string) []string {
+	seen := make(map[string]bool, len(values))
+	normalized := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if name, ok := nameByKey[keyV4(v)]; ok {
+			v = name
+		}
+		k := keyV4(v)
+		if k == "" || seen[k] {
+			continue
+		}
+		seen[k] = true
+		normalized = append(normalized, v)
+	}
+	return normalized
+}
+
+func equalV4(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// 0005_user_skills はプロフィールのスキルごとに習熟度・経験年数・作品を持たせるテーブルと、
+// スキルの推薦テーブルを追加します。既存ユーザーの user_models.skills は未設定のスキルとして移します
+func init() {
+	register(Migration{
+		Version: 5,
+		Name:    "user_skills",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&userSkillV5{}, &skillEndorsementV5{}); err != nil {
+				return err
+			}
+			return backfillUserSkillsV5(tx)
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&skillEndorsementV5{}, &userSkillV5{})
+		},
+	})
+}
+
+type userSkillV5 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	UserID            uint          `gorm:"not null;uniqueIndex:idx_user_skills_user_name"`
+	Name              string        `gorm:"size:255;not null;uniqueIndex:idx_user_skills_user_name"`
+	Level             string        `gorm:"size:16;not null;default:''"`
+	YearsOfExperience float64       `gorm:"not null;default:0"`
+	PostIDs           pq.Int64Array `gorm:"type:bigint[]"`
+	DisplayOrder      int           `gorm:"not null;default:0"`
+}
+
+func (userSkillV5) TableName() string { return "user_skills" }
+
+type skillEndorsementV5 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	UserSkillID uint `gorm:"not null;uniqueIndex:idx_skill_endorsements_endorser"`
+	EndorserID  uint `gorm:"not null;uniqueIndex:idx_skill_endorsements_endorser;index"`
+}
+
+func (skillEndorsementV5) TableName() string { return "skill_endorsements" }
+
+func backfillUserSkillsV5(tx *gorm.DB) error {
+	var rows []struct {
+		ID   uint
+		Vals pq.StringArray `gorm:"type:text[]"`
+	}
+	if err := tx.Table("user_models").Select("id, skills AS vals").
+		Where("skills IS NOT NULL").Scan(&rows).Error; err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, row := range rows {
+		seen := make(map[string]bool, len(row.Vals))
+		skills := make([]userSkillV5, 0, len(row.Vals))
+		for _, name := range row.Vals {
+			if name == "" || seen[name] {
+				continue
+			}
+			seen[name] = true
+			skills = append(skills, userSkillV5{
+				CreatedAt:    now,
+				UpdatedAt:    now,
+				UserID:       row.ID,
+				Name:         name,
+				PostIDs:      pq.Int64Array{},
+				DisplayOrder: len(skills),
+			})
+		}
+		if len(skills) == 0 {
+			continue
+		}
+		if err := tx.Create(&skills).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package migrations
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// 0006_career_history は学歴・職歴のテーブルを追加し、
+// user_models の学校名・学部・研究室・卒業年を 1 件目の学歴として移します
+// 卒業年は西暦 4 桁として正しいものだけを卒業年月（3 月）に変換し、
+// user_models.graduation_year も移した学歴から求めた値に揃えます（不正な値は空にする）
+func init() {
+	register(Migration{
+		Version: 6,
+		Name:    "career_history",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&educationV6{}, &experienceV6{}); err != nil {
+				return err
+			}
+			return backfillEducationsV6(tx)
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&experienceV6{}, &educationV6{})
+		},
+	})
+}
+
+type educationV6 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	UserID       uint   `gorm:"not null;index"`
+	Degree       string `gorm:"size:32;not null;default:''"`
+	SchoolName   string `gorm:"size:255;not null"`
+	Department   string `gorm:"size:255"`
+	Laboratory   string `gorm:"size:255"`
+	StartDate    *time.Time
+	EndDate      *time.Time
+	DisplayOrder int `gorm:"not null;default:0"`
+}
+
+func (educationV6) TableName() string { return "educations" }
+
+type experienceV6 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	UserID      uint      `gorm:"not null;index"`
+	Type        string    `gorm:"size:32;not null"`
+	CompanyName string    `gorm:"size:255;not null"`
+	Role        string    `gorm:"size:255;not null"`
+	StartDate   time.Time `gorm:"not null"`
+	EndDate     *time.Time
+	Description string         `gorm:"type:text"`
+	Skills      pq.StringArray `gorm:"type:text[]"`
+}
+
+func (experienceV6) TableName() string { return "experiences" }
+
+func backfillEducationsV6(tx *gorm.DB) error {
+	var users []struct {
+		ID             uint
+		SchoolName     string
+		Department     string
+		Laboratory     string
+		GraduationYear string
+	}
+	if err := tx.Table("user_models").
+		Select("id, school_name, department, laboratory, graduation_year").
+		Scan(&users).Error; err != nil {
+		return err
+	}
+
+	now := time.Now()
+	maxYear := now.Year() + 10
+	for _, u := range users {
+		var end *time.Time
+		graduationYear := ""
+		if year, err := strconv.Atoi(u.GraduationYear); err == nil && len(u.GraduationYear) == 4 && year >= 1950 && year <= maxYear {
+			t := time.Date(year, time.March, 1, 0, 0, 0, 0, time.UTC)
+			end = &t
+			graduationYear = u.GraduationYear
+		}
+		if graduationYear != u.GraduationYear {
+			if err := tx.Table("user_models").Where("id = ?", u.ID).Update("graduation_year", graduationYear).Error; err != nil {
+				return err
+			}
+		}
+		if u.SchoolName == "" && u.Department == "" && u.Laboratory == "" && end == nil {
+			continue
+		}
+		// 学校名が空のまま卒業年だけ登録していたユーザーも移す（次に編集するときに学校名の入力を求める）
+		if err := tx.Create(&educationV6{
+			CreatedAt:  now,
+			UpdatedAt:  now,
+			UserID:     u.ID,
+			SchoolName: u.SchoolName,
+			Department: u.Department,
+			Laboratory: u.Laboratory,
+			EndDate:    end,
+		}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// 0007_external_links はプロフィールの外部リンクのテーブルと、投稿の公開状態（下書き / 公開）を追加します
+// 既存の投稿はすべて公開中として扱います
+func init() {
+	register(Migration{
+		Version: 7,
+		Name:    "external_links",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&externalLinkV7{}, &postV7{})
+		},
+		Down: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&postV7{}, "Status"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&externalLinkV7{})
+		},
+	})
+}
+
+type externalLinkV7 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	UserID       uint   `gorm:"not null;index"`
+	Type         string `gorm:"size:32;not null"`
+	URL          string `gorm:"size:2048;not null"`
+	DisplayOrder int    `gorm:"not null;default:0"`
+}
+
+func (externalLinkV7) TableName() string { return "external_links" }
+
+type postV7 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	Title       string         `gorm:"not null"`
+	Description string         `gorm:"type:text"`
+	Genres      pq.StringArray `gorm:"type:text[]"`
+	Skills      pq.StringArray `gorm:"type:text[]"`
+	Status      string         `gorm:"size:16;not null;default:published;index"`
+	UserID      uint           `gorm:"not null;index"`
+
+	HiddenAt     *time.Time `gorm:"index"`
+	HiddenReason string     `gorm:"type:text"`
+}
+
+func (postV7) TableName() string { return "post_models" }
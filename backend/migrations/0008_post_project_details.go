@@ -0,0 +1,108 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// 0008_post_project_details は投稿に制作情報（リポジトリ・デモの URL、担当、人数、期間、技術スタック）と、
+// 画像の代替テキスト・キャプション・表示順・カバー画像を追加します
+// 既存の画像は代替テキストに投稿のタイトルを入れ、登録順に並べて先頭をカバーにします
+func init() {
+	register(Migration{
+		Version: 8,
+		Name:    "post_project_details",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&postV8{}, &imageV8{}, &techStackV8{}); err != nil {
+				return err
+			}
+			return backfillImagesV8(tx)
+		},
+		Down: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&techStackV8{}); err != nil {
+				return err
+			}
+			for _, column := range []string{"AltText", "Caption", "DisplayOrder", "IsCover"} {
+				if err := tx.Migrator().DropColumn(&imageV8{}, column); err != nil {
+					return err
+				}
+			}
+			for _, column := range []string{"RepositoryURL", "DemoURL", "Role", "TeamSize", "StartDate", "EndDate"} {
+				if err := tx.Migrator().DropColumn(&postV8{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
+
+type postV8 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	Title       string         `gorm:"not null"`
+	Description string         `gorm:"type:text"`
+	Genres      pq.StringArray `gorm:"type:text[]"`
+	Skills      pq.StringArray `gorm:"type:text[]"`
+	Status      string         `gorm:"size:16;not null;default:published;index"`
+	UserID      uint           `gorm:"not null;index"`
+
+	RepositoryURL string `gorm:"size:2048"`
+	DemoURL       string `gorm:"size:2048"`
+	Role          string `gorm:"size:255"`
+	TeamSize      int    `gorm:"not null;default:0"`
+	StartDate     *time.Time
+	EndDate       *time.Time
+
+	HiddenAt     *time.Time `gorm:"index"`
+	HiddenReason string     `gorm:"type:text"`
+}
+
+func (postV8) TableName() string { return "post_models" }
+
+type imageV8 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	URL          string `gorm:"not null"`
+	AltText      string `gorm:"size:255;not null;default:''"`
+	Caption      string `gorm:"size:255;not null;default:''"`
+	DisplayOrder int    `gorm:"not null;default:0"`
+	IsCover      bool   `gorm:"not null;default:false"`
+	PostID       uint   `gorm:"not null;index"`
+}
+
+func (imageV8) TableName() string { return "image_models" }
+
+type techStackV8 struct {
+	ID uint `gorm:"primaryKey"`
+
+	PostID       uint   `gorm:"not null;index"`
+	Name         string `gorm:"size:64;not null"`
+	Version      string `gorm:"size:32;not null;default:''"`
+	DisplayOrder int    `gorm:"not null;default:0"`
+}
+
+func (techStackV8) TableName() string { return "post_tech_stacks" }
+
+func backfillImagesV8(tx *gorm.DB) error {
+	if err := tx.Exec(`UPDATE image_models SET alt_text = COALESCE((
+		SELECT SUBSTR(p.title, 1, 200) FROM post_models p WHERE p.id = image_models.post_id
+	), '') WHERE alt_text = ''`).Error; err != nil {
+		return err
+	}
+	if err := tx.Exec(`UPDATE image_models SET display_order = (
+		SELECT COUNT(*) FROM image_models i WHERE i.post_id = image_models.post_id AND i.id < image_models.id
+	)`).Error; err != nil {
+		return err
+	}
+	return tx.Table("image_models").
+		Where("id IN (SELECT MIN(id) FROM image_models GROUP BY post_id)").
+		Update("is_cover", true).Error
+}
@@ -0,0 +1,86 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// 0009_post_lifecycle は投稿の予約投稿・公開終了の日時と、内容の版の履歴（post_revisions）を追加します
+// 既存の公開中の投稿は作成日時を公開日時とします。版は最初に編集したときに編集前の内容から残す
+func init() {
+	register(Migration{
+		Version: 9,
+		Name:    "post_lifecycle",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&postV9{}, &postRevisionV9{}); err != nil {
+				return err
+			}
+			return tx.Exec(`UPDATE post_models SET published_at = created_at
+				WHERE status = 'published' AND published_at IS NULL`).Error
+		},
+		Down: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&postRevisionV9{}); err != nil {
+				return err
+			}
+			// 予約投稿・公開終了の状態は 0008 以前にはないので、公開状態を下書きか公開中に戻す
+			if err := tx.Exec(`UPDATE post_models SET status = 'draft' WHERE status = 'scheduled'`).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec(`UPDATE post_models SET status = 'published' WHERE status = 'archived'`).Error; err != nil {
+				return err
+			}
+			for _, column := range []string{"PublishAt", "PublishedAt", "ArchivedAt"} {
+				if err := tx.Migrator().DropColumn(&postV9{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
+
+type postV9 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	Title       string         `gorm:"not null"`
+	Description string         `gorm:"type:text"`
+	Genres      pq.StringArray `gorm:"type:text[]"`
+	Skills      pq.StringArray `gorm:"type:text[]"`
+	Status      string         `gorm:"size:16;not null;default:published;index"`
+	UserID      uint           `gorm:"not null;index"`
+
+	RepositoryURL string `gorm:"size:2048"`
+	DemoURL       string `gorm:"size:2048"`
+	Role          string `gorm:"size:255"`
+	TeamSize      int    `gorm:"not null;default:0"`
+	StartDate     *time.Time
+	EndDate       *time.Time
+
+	PublishAt   *time.Time `gorm:"index"`
+	PublishedAt *time.Time `gorm:"index"`
+	ArchivedAt  *time.Time
+
+	HiddenAt     *time.Time `gorm:"index"`
+	HiddenReason string     `gorm:"type:text"`
+}
+
+func (postV9) TableName() string { return "post_models" }
+
+type postRevisionV9 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	PostID       uint   `gorm:"not null;uniqueIndex:idx_post_revisions_post_number"`
+	Number       int    `gorm:"not null;uniqueIndex:idx_post_revisions_post_number"`
+	EditorID     uint   `gorm:"not null"`
+	Reason       string `gorm:"size:16;not null"`
+	RestoredFrom int    `gorm:"not null;default:0"`
+	Content      string `gorm:"type:text;not null"`
+}
+
+func (postRevisionV9) TableName() string { return "post_revisions" }
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 0010_post_collaborators はチームで制作した作品の共同制作者（招待・承諾と本人の担当・貢献内容）のテーブルを追加します
+func init() {
+	register(Migration{
+		Version: 10,
+		Name:    "post_collaborators",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&postCollaboratorV10{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&postCollaboratorV10{})
+		},
+	})
+}
+
+type postCollaboratorV10 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	PostID       uint   `gorm:"not null;uniqueIndex:idx_post_collaborators_post_user"`
+	UserID       uint   `gorm:"not null;uniqueIndex:idx_post_collaborators_post_user;index"`
+	Status       string `gorm:"size:16;not null"`
+	Role         string `gorm:"size:255;not null;default:''"`
+	Contribution string `gorm:"type:text"`
+	InvitedBy    uint   `gorm:"not null"`
+	AcceptedAt   *time.Time
+}
+
+func (postCollaboratorV10) TableName() string { return "post_collaborators" }
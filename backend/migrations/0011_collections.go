@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 0011_collections は投稿をまとめるコレクションと、コレクション内の投稿の並びのテーブルを追加します
+func init() {
+	register(Migration{
+		Version: 11,
+		Name:    "collections",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&collectionV11{}, &collectionPostV11{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&collectionPostV11{}, &collectionV11{})
+		},
+	})
+}
+
+type collectionV11 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	UserID        uint   `gorm:"not null;index"`
+	Title         string `gorm:"size:255;not null"`
+	Description   string `gorm:"type:text"`
+	CoverPostID   *uint
+	DisplayOrder  int        `gorm:"not null;default:0"`
+	FeaturedAt    *time.Time `gorm:"index"`
+	FeaturedOrder int        `gorm:"not null;default:0"`
+}
+
+func (collectionV11) TableName() string { return "collections" }
+
+type collectionPostV11 struct {
+	ID           uint `gorm:"primaryKey"`
+	CollectionID uint `gorm:"not null;uniqueIndex:idx_collection_posts_collection_post"`
+	PostID       uint `gorm:"not null;uniqueIndex:idx_collection_posts_collection_post;index"`
+	DisplayOrder int  `gorm:"not null;default:0"`
+}
+
+func (collectionPostV11) TableName() string { return "collection_posts" }
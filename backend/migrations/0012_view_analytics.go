@@ -0,0 +1,102 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 0012_view_analytics は投稿・プロフィールの閲覧の生データと日ごとの集計のテーブル、
+// 採用担当が閲覧時に企業名を知らせるかの設定を追加します
+func init() {
+	register(Migration{
+		Version: 12,
+		Name:    "view_analytics",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(
+				&viewEventV12{},
+				&dailyViewStatV12{},
+				&dailyReferrerStatV12{},
+				&dailyOrganizationViewStatV12{},
+				&analyticsRollupV12{},
+				&organizationMemberV12{},
+			)
+		},
+		Down: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&organizationMemberV12{}, "DiscloseViews"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(
+				&analyticsRollupV12{},
+				&dailyOrganizationViewStatV12{},
+				&dailyReferrerStatV12{},
+				&dailyViewStatV12{},
+				&viewEventV12{},
+			)
+		},
+	})
+}
+
+type viewEventV12 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	TargetType     string `gorm:"size:16;not null;uniqueIndex:idx_view_events_visit"`
+	TargetID       uint   `gorm:"not null;uniqueIndex:idx_view_events_visit"`
+	VisitorKey     string `gorm:"size:64;not null;uniqueIndex:idx_view_events_visit"`
+	Day            string `gorm:"size:10;not null;uniqueIndex:idx_view_events_visit;index"`
+	OwnerID        uint   `gorm:"not null"`
+	ViewerID       uint   `gorm:"not null;default:0"`
+	OrganizationID *uint
+	ReferrerHost   string `gorm:"size:255;not null;default:''"`
+}
+
+func (viewEventV12) TableName() string { return "view_events" }
+
+type dailyViewStatV12 struct {
+	ID         uint   `gorm:"primaryKey"`
+	OwnerID    uint   `gorm:"not null;index:idx_daily_view_stats_owner_day"`
+	Day        string `gorm:"size:10;not null;index:idx_daily_view_stats_owner_day;uniqueIndex:idx_daily_view_stats_target_day"`
+	TargetType string `gorm:"size:16;not null;uniqueIndex:idx_daily_view_stats_target_day"`
+	TargetID   uint   `gorm:"not null;uniqueIndex:idx_daily_view_stats_target_day"`
+	Views      int    `gorm:"not null"`
+}
+
+func (dailyViewStatV12) TableName() string { return "daily_view_stats" }
+
+type dailyReferrerStatV12 struct {
+	ID           uint   `gorm:"primaryKey"`
+	OwnerID      uint   `gorm:"not null;uniqueIndex:idx_daily_referrer_stats_owner_day_host"`
+	Day          string `gorm:"size:10;not null;uniqueIndex:idx_daily_referrer_stats_owner_day_host;index"`
+	ReferrerHost string `gorm:"size:255;not null;uniqueIndex:idx_daily_referrer_stats_owner_day_host"`
+	Views        int    `gorm:"not null"`
+}
+
+func (dailyReferrerStatV12) TableName() string { return "daily_referrer_stats" }
+
+type dailyOrganizationViewStatV12 struct {
+	ID             uint   `gorm:"primaryKey"`
+	OwnerID        uint   `gorm:"not null;uniqueIndex:idx_daily_organization_view_stats_owner_day_org"`
+	Day            string `gorm:"size:10;not null;uniqueIndex:idx_daily_organization_view_stats_owner_day_org;index"`
+	OrganizationID uint   `gorm:"not null;uniqueIndex:idx_daily_organization_view_stats_owner_day_org"`
+	Views          int    `gorm:"not null"`
+}
+
+func (dailyOrganizationViewStatV12) TableName() string { return "daily_organization_view_stats" }
+
+type analyticsRollupV12 struct {
+	Day        string `gorm:"primaryKey;size:10"`
+	RolledUpAt time.Time
+}
+
+func (analyticsRollupV12) TableName() string { return "analytics_rollups" }
+
+type organizationMemberV12 struct {
+	OrganizationID uint   `gorm:"primaryKey"`
+	UserID         uint   `gorm:"primaryKey;index"`
+	Role           string `gorm:"size:32;not null"`
+	DiscloseViews  bool   `gorm:"not null;default:false"`
+	CreatedAt      time.Time
+}
+
+func (organizationMemberV12) TableName() string { return "organization_members" }
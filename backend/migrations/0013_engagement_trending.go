@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 0013_engagement_trending は投稿へのいいね・ブックマークと、ホームフィードのトレンドに使う人気度のテーブルを追加します
+func init() {
+	register(Migration{
+		Version: 13,
+		Name:    "engagement_trending",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&postReactionV13{}, &postTrendingScoreV13{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&postTrendingScoreV13{}, &postReactionV13{})
+		},
+	})
+}
+
+type postReactionV13 struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	PostID uint   `gorm:"not null;uniqueIndex:idx_post_reactions_post_user_kind"`
+	UserID uint   `gorm:"not null;uniqueIndex:idx_post_reactions_post_user_kind;index"`
+	Kind   string `gorm:"size:16;not null;uniqueIndex:idx_post_reactions_post_user_kind"`
+}
+
+func (postReactionV13) TableName() string { return "post_reactions" }
+
+type postTrendingScoreV13 struct {
+	PostID     uint    `gorm:"primaryKey;autoIncrement:false"`
+	Score      float64 `gorm:"not null;index"`
+	ComputedAt time.Time
+}
+
+func (postTrendingScoreV13) TableName() string { return "post_trending_scores" }
@@ -0,0 +1,33 @@
+package migrations
+
+import "gorm.io/gorm"
+
+// 0014_recommendations は定期的に計算する「似ている投稿」と「あなたへのおすすめ」のテーブルを追加します
+func init() {
+	register(Migration{
+		Version: 14,
+		Name:    "recommendations",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&postSimilarityV14{}, &userRecommendationV14{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&userRecommendationV14{}, &postSimilarityV14{})
+		},
+	})
+}
+
+type postSimilarityV14 struct {
+	PostID        uint    `gorm:"primaryKey;autoIncrement:false"`
+	SimilarPostID uint    `gorm:"primaryKey;autoIncrement:false"`
+	Score         float64 `gorm:"not null"`
+}
+
+func (postSimilarityV14) TableName() string { return "post_similarities" }
+
+type userRecommendationV14 struct {
+	UserID uint    `gorm:"primaryKey;autoIncrement:false"`
+	PostID uint    `gorm:"primaryKey;autoIncrement:false"`
+	Score  float64 `gorm:"not null"`
+}
+
+func (userRecommendationV14) TableName() string { return "user_recommendations" }
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 0015_site_exports はポートフォリオを静的サイトの ZIP に書き出す依頼のテーブルを追加します
+func init() {
+	register(Migration{
+		Version: 15,
+		Name:    "site_exports",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&siteExportV15{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&siteExportV15{})
+		},
+	})
+}
+
+type siteExportV15 struct {
+	ID          uint `gorm:"primaryKey"`
+	CreatedAt   time.Time
+	UserID      uint   `gorm:"not null;index"`
+	Theme       string `gorm:"size:32;not null"`
+	Status      string `gorm:"size:16;not null;index"`
+	FilePath    string `gorm:"size:255"`
+	Size        int64  `gorm:"not null;default:0"`
+	Error       string `gorm:"type:text"`
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time `gorm:"index"`
+}
+
+func (siteExportV15) TableName() string { return "site_exports" }
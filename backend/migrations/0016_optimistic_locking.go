@@ -0,0 +1,39 @@
+package migrations
+
+import "gorm.io/gorm"
+
+// 0016_optimistic_locking はユーザーと投稿に楽観的排他制御の版番号を追加します
+// 既存の行は版番号 1 から始める
+func init() {
+	register(Migration{
+		Version: 16,
+		Name:    "optimistic_locking",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&userVersionV16{}, "Version"); err != nil {
+				return err
+			}
+			return tx.Migrator().AddColumn(&postVersionV16{}, "Version")
+		},
+		Down: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&postVersionV16{}, "Version"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn(&userVersionV16{}, "Version")
+		},
+	})
+}
+
+// 版番号の列だけを追加するので、スナップショットには主キーと版番号だけを持たせる
+type userVersionV16 struct {
+	ID      uint `gorm:"primaryKey"`
+	Version int  `gorm:"not null;default:1"`
+}
+
+func (userVersionV16) TableName() string { return "user_models" }
+
+type postVersionV16 struct {
+	ID      uint `gorm:"primaryKey"`
+	Version int  `gorm:"not null;default:1"`
+}
+
+func (postVersionV16) TableName() string { return "post_models" }
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 0017_organization_approval は企業アカウントに運営の審査状況を追加します
+// 作成時点で採用担当に昇格済みだった既存の企業は承認済みとして扱う
+func init() {
+	register(Migration{
+		Version: 17,
+		Name:    "organization_approval",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&organizationApprovalV17{}, "Status"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().AddColumn(&organizationApprovalV17{}, "ApprovedAt"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().CreateIndex(&organizationApprovalV17{}, "Status"); err != nil {
+				return err
+			}
+			return tx.Exec("UPDATE organizations SET status = ?, approved_at = created_at", "approved").Error
+		},
+		Down: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropIndex(&organizationApprovalV17{}, "Status"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropColumn(&organizationApprovalV17{}, "ApprovedAt"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn(&organizationApprovalV17{}, "Status")
+		},
+	})
+}
+
+type organizationApprovalV17 struct {
+	ID         uint `gorm:"primaryKey"`
+	CreatedAt  time.Time
+	Status     string `gorm:"size:16;not null;default:pending;index"`
+	ApprovedAt *time.Time
+}
+
+func (organizationApprovalV17) TableName() string { return "organizations" }
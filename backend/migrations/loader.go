@@ -0,0 +1,167 @@
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+//go:embed sql/*.sql
+var sqlFiles embed.FS
+
+// registry は init で登録された Go のマイグレーションです
+var registry []Migration
+
+// register は Go で書いたマイグレーションを登録します（各ファイルの init から呼びます）
+func register(m Migration) {
+	registry = append(registry, m)
+}
+
+// sqlFilePattern は 0002_add_index.up.sql / 0002_add_index.postgres.down.sql のようなファイル名に一致します
+var sqlFilePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+?)(?:\.(postgres|sqlite))?\.(up|down)\.sql$`)
+
+var namePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+type sqlSource struct {
+	name string
+	up   map[string]string // dialect ("" は共通) -> SQL
+	down map[string]string
+}
+
+// loadMigrations は Go と SQL のマイグレーションをまとめ、バージョン順に並べて返します
+func loadMigrations(dialect string) ([]Migration, error) {
+	sources := map[int64]*sqlSource{}
+	entries, err := fs.ReadDir(sqlFiles, "sql")
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		matches := sqlFilePattern.FindStringSubmatch(e.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", e.Name())
+		}
+		version, _ := strconv.ParseInt(matches[1], 10, 64)
+		body, err := fs.ReadFile(sqlFiles, "sql/"+e.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		src, ok := sources[version]
+		if !ok {
+			src = &sqlSource{name: matches[2], up: map[string]string{}, down: map[string]string{}}
+			sources[version] = src
+		} else if src.name != matches[2] {
+			return nil, fmt.Errorf("migration %04d has conflicting names: %s, %s", version, src.name, matches[2])
+		}
+		if matches[4] == "up" {
+			src.up[matches[3]] = string(body)
+		} else {
+			src.down[matches[3]] = string(body)
+		}
+	}
+
+	all := make([]Migration, 0, len(registry)+len(sources))
+	seen := map[int64]string{}
+	for _, m := range registry {
+		if prev, ok := seen[m.Version]; ok {
+			return nil, fmt.Errorf("migration %04d is defined twice: %s, %s", m.Version, prev, m.Name)
+		}
+		seen[m.Version] = m.Name
+		all = append(all, m)
+	}
+	for version, src := range sources {
+		if prev, ok := seen[version]; ok {
+			return nil, fmt.Errorf("migration %04d is defined twice: %s, %s", version, prev, src.name)
+		}
+		seen[version] = src.name
+		all = append(all, Migration{
+			Version: version,
+			Name:    src.name,
+			Up:      execSQL(pickDialect(src.up, dialect)),
+			Down:    execSQL(pickDialect(src.down, dialect)),
+		})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Version < all[j].Version })
+	return all, nil
+}
+
+// pickDialect は接続先の方言のファイルがあればそれを、なければ共通のファイルを選びます
+func pickDialect(files map[string]string, dialect string) string {
+	if sql, ok := files[dialect]; ok {
+		return sql
+	}
+	return files[""]
+}
+
+func execSQL(sql string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		if strings.TrimSpace(sql) == "" {
+			return nil
+		}
+		return tx.Exec(sql).Error
+	}
+}
+
+// Create は次のバージョン番号で空のマイグレーションを dir に作成し、作成したファイルのパスを返します
+// asGo が true の場合は SQL の代わりに Go のマイグレーションの雛形を作ります
+func Create(dir, name string, asGo bool) ([]string, error) {
+	if !namePattern.MatchString(name) {
+		return nil, fmt.Errorf("migration name must match %s", namePattern)
+	}
+	all, err := loadMigrations("")
+	if err != nil {
+		return nil, err
+	}
+	var next int64 = 1
+	if len(all) > 0 {
+		next = all[len(all)-1].Version + 1
+	}
+
+	prefix := fmt.Sprintf("%04d_%s", next, name)
+	files := map[string]string{}
+	if asGo {
+		files[filepath.Join(dir, prefix+".go")] = fmt.Sprintf(goTemplate, next, name)
+	} else {
+		files[filepath.Join(dir, "sql", prefix+".up.sql")] = "-- " + prefix + " up\n"
+		files[filepath.Join(dir, "sql", prefix+".down.sql")] = "-- " + prefix + " down\n"
+	}
+
+	var created []string
+	for path, body := range files {
+		if _, err := os.Stat(path); err == nil {
+			return created, fmt.Errorf("%s already exists", path)
+		}
+		if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+			return created, err
+		}
+		created = append(created, path)
+	}
+	sort.Strings(created)
+	return created, nil
+}
+
+const goTemplate = `package migrations
+
+import "gorm.io/gorm"
+
+func init() {
+	register(Migration{
+		Version: %d,
+		Name:    %q,
+		Up: func(tx *gorm.DB) error {
+			return nil
+		},
+		Down: func(tx *gorm.DB) error {
+			return nil
+		},
+	})
+}
+`
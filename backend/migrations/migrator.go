@@ -0,0 +1,196 @@
+// Package migrations はバージョン番号付きのスキーママイグレーションを管理します
+//
+// マイグレーションは次の 2 種類で定義できます
+//   - sql/ 配下の SQL ファイル: 0002_add_index.up.sql / 0002_add_index.down.sql
+//     方言ごとに分けたい場合は 0002_add_index.postgres.up.sql のように dialect を挟みます
+//     （該当する方言のファイルが無いマイグレーションは、その DB では何もせず適用済みにします）
+//   - Go のコード: register(Migration{...}) を init で呼ぶ（GORM の Migrator を使いたい場合）
+//
+// 適用済みのバージョンは schema_migrations テーブルで管理し、
+// 1 つのマイグレーションとその記録は同じトランザクションで実行します
+package migrations
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Migration は 1 つのバージョンのスキーマ変更です
+type Migration struct {
+	Version int64
+	Name    string
+	Up      func(tx *gorm.DB) error
+	Down    func(tx *gorm.DB) error
+}
+
+// Status は status コマンドで表示する 1 行分です
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+	Missing   bool // DB には記録があるが、コード上に定義が見つからない
+}
+
+// schemaMigration は適用済みバージョンの記録です
+type schemaMigration struct {
+	Version   int64     `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"size:255;not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Migrator はマイグレーションの実行役です
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// New は組み込みのマイグレーション (Go / SQL) をすべて読み込んだ Migrator を返します
+func New(db *gorm.DB) (*Migrator, error) {
+	all, err := loadMigrations(db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: all}, nil
+}
+
+// Up は未適用のマイグレーションを古い順にすべて適用し、適用したものを返します
+func (m *Migrator) Up() ([]Migration, error) {
+	var applied []Migration
+	err := m.withLock(func(conn *gorm.DB) error {
+		done, err := appliedVersions(conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if _, ok := done[mig.Version]; ok {
+				continue
+			}
+			if err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := mig.Up(tx); err != nil {
+					return err
+				}
+				return tx.Create(&schemaMigration{Version: mig.Version, Name: mig.Name, AppliedAt: time.Now().UTC()}).Error
+			}); err != nil {
+				return fmt.Errorf("migration %04d_%s up failed: %w", mig.Version, mig.Name, err)
+			}
+			applied = append(applied, mig)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down は適用済みのマイグレーションを新しい順に steps 件だけ取り消し、取り消したものを返します
+func (m *Migrator) Down(steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.withLock(func(conn *gorm.DB) error {
+		done, err := appliedVersions(conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := done[mig.Version]; !ok {
+				continue
+			}
+			if mig.Down == nil {
+				return fmt.Errorf("migration %04d_%s cannot be reverted", mig.Version, mig.Name)
+			}
+			if err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := mig.Down(tx); err != nil {
+					return err
+				}
+				return tx.Delete(&schemaMigration{}, mig.Version).Error
+			}); err != nil {
+				return fmt.Errorf("migration %04d_%s down failed: %w", mig.Version, mig.Name, err)
+			}
+			reverted = append(reverted, mig)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status は既知のマイグレーションと適用状況を返します
+func (m *Migrator) Status() ([]Status, error) {
+	if err := m.db.AutoMigrate(&schemaMigration{}); err != nil {
+		return nil, err
+	}
+	done, err := appliedVersions(m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	known := make(map[int64]bool, len(m.migrations))
+	for _, mig := range m.migrations {
+		known[mig.Version] = true
+		st := Status{Version: mig.Version, Name: mig.Name}
+		if rec, ok := done[mig.Version]; ok {
+			st.Applied = true
+			st.AppliedAt = &rec.AppliedAt
+		}
+		statuses = append(statuses, st)
+	}
+	for version, rec := range done {
+		if !known[version] {
+			appliedAt := rec.AppliedAt
+			statuses = append(statuses, Status{Version: version, Name: rec.Name, Applied: true, AppliedAt: &appliedAt, Missing: true})
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+	return statuses, nil
+}
+
+// postgresLockKey は pg_advisory_lock に渡すアプリ固有のキーです
+const postgresLockKey int64 = 7_305_221_901
+
+// sqliteLock は SQLite モードで同じプロセス内の同時実行を防ぎます
+// （SQLite モードはインメモリ DB のため、別プロセスと競合することはありません）
+var sqliteLock sync.Mutex
+
+// withLock は他のレプリカと同時にマイグレーションを実行しないようロックを取ってから fn を呼びます
+// PostgreSQL ではセッション単位の advisory lock を使うため、1 本のコネクションに固定して実行します
+func (m *Migrator) withLock(fn func(conn *gorm.DB) error) error {
+	if m.db.Dialector.Name() != "postgres" {
+		sqliteLock.Lock()
+		defer sqliteLock.Unlock()
+		if err := m.db.AutoMigrate(&schemaMigration{}); err != nil {
+			return err
+		}
+		return fn(m.db)
+	}
+
+	return m.db.Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", postgresLockKey).Error; err != nil {
+			return err
+		}
+		defer conn.Exec("SELECT pg_advisory_unlock(?)", postgresLockKey)
+
+		if err := conn.AutoMigrate(&schemaMigration{}); err != nil {
+			return err
+		}
+		return fn(conn)
+	})
+}
+
+func appliedVersions(db *gorm.DB) (map[int64]schemaMigration, error) {
+	var records []schemaMigration
+	if err := db.Order("version").Find(&records).Error; err != nil {
+		return nil, err
+	}
+	done := make(map[int64]schemaMigration, len(records))
+	for _, r := range records {
+		done[r.Version] = r
+	}
+	return done, nil
+}
@@ -0,0 +1,89 @@
+// backend/migrations/migrator_test.go
+package migrations
+
+import (
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	return db
+}
+
+func TestMigrator_UpDownStatus(t *testing.T) {
+	db := openTestDB(t)
+	m, err := New(db)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	applied, err := m.Up()
+	if err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	if len(applied) != len(m.migrations) {
+		t.Fatalf("applied %d migrations; want %d", len(applied), len(m.migrations))
+	}
+	if !db.Migrator().HasTable("post_models") {
+		t.Fatal("post_models should exist after Up")
+	}
+
+	// 2 回目は何もしない
+	if again, err := m.Up(); err != nil || len(again) != 0 {
+		t.Fatalf("second Up = %d, %v; want 0, nil", len(again), err)
+	}
+
+	reverted, err := m.Down(len(m.migrations))
+	if err != nil {
+		t.Fatalf("Down: %v", err)
+	}
+	if len(reverted) != len(m.migrations) {
+		t.Fatalf("reverted %d migrations; want %d", len(reverted), len(m.migrations))
+	}
+	if db.Migrator().HasTable("post_models") {
+		t.Fatal("post_models should be dropped after Down")
+	}
+
+	statuses, err := m.Status()
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	for _, s := range statuses {
+		if s.Applied {
+			t.Errorf("%04d_%s is still applied", s.Version, s.Name)
+		}
+	}
+}
+
+func TestMigrator_StatusReportsMissing(t *testing.T) {
+	db := openTestDB(t)
+	m, err := New(db)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if _, err := m.Up(); err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	// 別ブランチで適用されたなど、コードに無いバージョン
+	if err := db.Exec("INSERT INTO schema_migrations (version, name, applied_at) VALUES (9999, 'unknown', CURRENT_TIMESTAMP)").Error; err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+
+	statuses, err := m.Status()
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	last := statuses[len(statuses)-1]
+	if last.Version != 9999 || !last.Missing {
+		t.Errorf("last status = %+v; want missing 9999", last)
+	}
+}
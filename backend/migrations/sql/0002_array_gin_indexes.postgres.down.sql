@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_saved_searches_skills;
+DROP INDEX IF EXISTS idx_saved_searches_genres;
+DROP INDEX IF EXISTS idx_job_postings_job_types;
+DROP INDEX IF EXISTS idx_job_postings_skills;
+DROP INDEX IF EXISTS idx_user_models_desired_job_types;
+DROP INDEX IF EXISTS idx_user_models_skills;
+DROP INDEX IF EXISTS idx_post_models_skills;
+DROP INDEX IF EXISTS idx_post_models_genres;
@@ -0,0 +1,9 @@
+-- text[] カラムの「含む」検索 (@>) 用の GIN インデックス
+CREATE INDEX IF NOT EXISTS idx_post_models_genres ON post_models USING GIN (genres);
+CREATE INDEX IF NOT EXISTS idx_post_models_skills ON post_models USING GIN (skills);
+CREATE INDEX IF NOT EXISTS idx_user_models_skills ON user_models USING GIN (skills);
+CREATE INDEX IF NOT EXISTS idx_user_models_desired_job_types ON user_models USING GIN (desired_job_types);
+CREATE INDEX IF NOT EXISTS idx_job_postings_skills ON job_postings USING GIN (skills);
+CREATE INDEX IF NOT EXISTS idx_job_postings_job_types ON job_postings USING GIN (job_types);
+CREATE INDEX IF NOT EXISTS idx_saved_searches_genres ON saved_searches USING GIN (genres);
+CREATE INDEX IF NOT EXISTS idx_saved_searches_skills ON saved_searches USING GIN (skills);
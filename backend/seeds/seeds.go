@@ -0,0 +1,71 @@
+// Package seeds は初期データ（選択肢の一覧など）の定義を読み込みます
+package seeds
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"os"
+
+	domainTaxonomy "backend/domain/taxonomy"
+)
+
+//go:embed taxonomy.json
+var defaultTaxonomy []byte
+
+// Taxonomy は職種・スキル・ジャンルの初期データです
+// 配列の並び順がそのまま表示順になります
+type Taxonomy struct {
+	JobTypes []Entry `json:"jobTypes"`
+	Skills   []Entry `json:"skills"`
+	Genres   []Entry `json:"genres"`
+}
+
+// Entry は選択肢 1 件です
+// 名前だけなら "Go"、分類や別名を付けるなら {"name": "Go", "category": "language", "aliases": ["golang"]} と書きます
+type Entry struct {
+	Name     string   `json:"name"`
+	Category string   `json:"category"`
+	Aliases  []string `json:"aliases"`
+}
+
+func (e *Entry) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		return json.Unmarshal(data, &e.Name)
+	}
+	type plain Entry
+	return json.Unmarshal(data, (*plain)(e))
+}
+
+// ByKind は種類ごとの選択肢の一覧を返します
+func (t *Taxonomy) ByKind() map[domainTaxonomy.Kind][]domainTaxonomy.SeedEntry {
+	return map[domainTaxonomy.Kind][]domainTaxonomy.SeedEntry{
+		domainTaxonomy.KindJobType: toSeedEntries(t.JobTypes),
+		domainTaxonomy.KindSkill:   toSeedEntries(t.Skills),
+		domainTaxonomy.KindGenre:   toSeedEntries(t.Genres),
+	}
+}
+
+func toSeedEntries(entries []Entry) []domainTaxonomy.SeedEntry {
+	seedEntries := make([]domainTaxonomy.SeedEntry, 0, len(entries))
+	for _, e := range entries {
+		seedEntries = append(seedEntries, domainTaxonomy.SeedEntry{Name: e.Name, Category: e.Category, Aliases: e.Aliases})
+	}
+	return seedEntries
+}
+
+// LoadTaxonomy は path の JSON を読み込みます。path が空の場合は組み込みの seeds/taxonomy.json を使います
+func LoadTaxonomy(path string) (*Taxonomy, error) {
+	data := defaultTaxonomy
+	if path != "" {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	var t Taxonomy
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -1,69 +1,635 @@
 package services
 
 import (
+	domainAudit "backend/domain/audit"
+	domainCareer "backend/domain/career"
+	domainMarkdown "backend/domain/markdown"
 	domainPortfolio "backend/domain/portfolio"
+	domainTaxonomy "backend/domain/taxonomy"
+	domainUnitOfWork "backend/domain/unitofwork"
 	"backend/dto"
+	"errors"
 	"fmt"
 	"io"
+	"log"
 	"mime/multipart"
 	"os"
 	"time"
+
+	"gorm.io/gorm"
 )
 
+// ErrUnknownPostImage は投稿に登録されていない画像 URL を指定した編集です
+var ErrUnknownPostImage = errors.New("image does not belong to the post")
+
+// RevisionDiff は 2 つの版の差分です。From が 0 なら空の内容との差分
+type RevisionDiff struct {
+	From    int                           `json:"from"`
+	To      int                           `json:"to"`
+	Changes []domainPortfolio.FieldChange `json:"changes"`
+}
+
 type IPortfolioService interface {
-	CreatePost(input dto.CreatePostInput, files []*multipart.FileHeader, userID uint) error
-	GetPostByID(id uint) (*domainPortfolio.Post, error)
+	CreatePost(input dto.CreatePostInput, files []*multipart.FileHeader, actor domainAudit.Actor) (*domainPortfolio.Post, error)
+	// GetPostByID は公開中の投稿を返します。公開前・公開終了の投稿は投稿者と共同制作者（招待中を含む）にだけ返す
+	GetPostByID(viewerID, id uint) (*domainPortfolio.Post, error)
+	// GetPostsByUserID は本人の投稿と、共同制作者として承諾した投稿を返します
 	GetPostsByUserID(userID uint) ([]*domainPortfolio.Post, error)
 	GetAllPosts() ([]*domainPortfolio.Post, error)
+	SearchPosts(input dto.PostSearchInput) ([]*domainPortfolio.Post, error)
+	// GetDrafts は本人の下書きと予約投稿（GitHub から取り込んだものなど）を返します
+	GetDrafts(userID uint) ([]*domainPortfolio.Post, error)
+	GetArchived(userID uint) ([]*domainPortfolio.Post, error)
+
+	// CreateDraft はタイトルだけでも保存できる下書きを作ります。画像は AddImages で追加する
+	CreateDraft(actor domainAudit.Actor, input dto.PostContentInput) (*domainPortfolio.Post, error)
+	// UpdatePost は投稿の内容を置き換え、版を残します
+	// version は読み込んだときの版番号（If-Match）で、ほかの操作が先に保存していたら domainPortfolio.ErrVersionConflict を返す。0 なら版を問わない
+	UpdatePost(actor domainAudit.Actor, id uint, version int, input dto.PostContentInput) (*domainPortfolio.Post, error)
+	// AutosaveDraft は下書きの内容を保存します。編集中の途中保存なので版は残さない。version は UpdatePost と同じ
+	AutosaveDraft(actor domainAudit.Actor, id uint, version int, input dto.PostContentInput) (*domainPortfolio.Post, error)
+	// DeletePost は投稿を削除します。共同制作者は削除できない
+	DeletePost(actor domainAudit.Actor, id uint) error
+	// AddImages は画像をアップロードして投稿の末尾に追加します
+	AddImages(actor domainAudit.Actor, id uint, version int, files []*multipart.FileHeader, images []dto.PostImageInput) (*domainPortfolio.Post, error)
+
+	Publish(actor domainAudit.Actor, id uint) (*domainPortfolio.Post, error)
+	Schedule(actor domainAudit.Actor, id uint, publishAt time.Time) (*domainPortfolio.Post, error)
+	Unschedule(actor domainAudit.Actor, id uint) (*domainPortfolio.Post, error)
+	Archive(actor domainAudit.Actor, id uint) (*domainPortfolio.Post, error)
+	Unarchive(actor domainAudit.Actor, id uint) (*domainPortfolio.Post, error)
+	// PublishDue は公開日時を過ぎた予約投稿を公開し、公開した件数を返します（定期ジョブ用）
+	PublishDue(now time.Time) (int, error)
+
+	// 版の履歴（本人のみ）
+	GetRevisions(userID, id uint) ([]*domainPortfolio.Revision, error)
+	GetRevision(userID, id uint, number int) (*domainPortfolio.Revision, error)
+	// DiffRevisions は against 番の版から number 番の版への差分を返します。against が 0 なら直前の版と比べる
+	DiffRevisions(userID, id uint, number, against int) (*RevisionDiff, error)
+	// RestoreRevision は投稿の内容を number 番の版に戻し、戻したことを新しい版として残します
+	RestoreRevision(actor domainAudit.Actor, id uint, version int, number int) (*domainPortfolio.Post, error)
 }
 
 type PortfolioService struct {
 	// portfolioRepository repositories.IPortfolioRepository
 	portfolioRepository domainPortfolio.Repository
+	unitOfWork          domainUnitOfWork.UnitOfWork
+	auditService        IAuditService
+	taxonomyService     ITaxonomyService
+	markdownService     IMarkdownService
 }
 
-func NewPortfolioService(portfolioRepository domainPortfolio.Repository) IPortfolioService {
-	return &PortfolioService{portfolioRepository: portfolioRepository}
+func NewPortfolioService(portfolioRepository domainPortfolio.Repository, unitOfWork domainUnitOfWork.UnitOfWork, auditService IAuditService, taxonomyService ITaxonomyService, markdownService IMarkdownService) IPortfolioService {
+	return &PortfolioService{portfolioRepository: portfolioRepository, unitOfWork: unitOfWork, auditService: auditService, taxonomyService: taxonomyService, markdownService: markdownService}
 }
 
 func (s *PortfolioService) CreatePost(input dto.CreatePostInput,
 	files []*multipart.FileHeader,
-	userID uint) error {
+	actor domainAudit.Actor) (*domainPortfolio.Post, error) {
 
-	// 1) 画像を保存
-	var images []domainPortfolio.Image
-	for _, fileHeader := range files {
+	// 1) 画像の説明はファイルと同じ順に対応させる（保存前に検証して、不正な入力でファイルを残さない）
+	images := make([]domainPortfolio.Image, len(files))
+	for i, fileHeader := range files {
 		if fileHeader.Size > 8*1024*1024 {
-			return fmt.Errorf("file %s is too large", fileHeader.Filename)
+			return nil, fmt.Errorf("file %s is too large", fileHeader.Filename)
 		}
-		image, err := saveImage(fileHeader)
-		if err != nil {
-			return err
+		if i < len(input.Images) {
+			images[i].AltText = input.Images[i].AltText
+			images[i].Caption = input.Images[i].Caption
 		}
-		images = append(images, domainPortfolio.Image{
-			URL: image.URL,
-		})
 	}
 
+	if err := domainMarkdown.Validate(input.Description); err != nil {
+		return nil, err
+	}
+	skills, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, input.Skills)
+	if err != nil {
+		return nil, err
+	}
+	details, err := projectDetailsFromInput(input.ProjectDetailsInput)
+	if err != nil {
+		return nil, err
+	}
 	post, err := domainPortfolio.NewPost(
 		input.Title,
 		input.Description,
 		input.Genres,
-		input.Skills,
+		skills,
 		images,
-		userID,
+		details,
+		actor.UserID,
 	)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if err := post.SetCoverImage(input.CoverImageIndex); err != nil {
+		return nil, err
+	}
+
+	// 2) 画像と投稿を 1 つのトランザクションで保存する。投稿を保存できなければ保存した画像も消す
+	err = s.unitOfWork.Do(func(tx domainUnitOfWork.Tx) error {
+		for i, fileHeader := range files {
+			image, err := saveImage(fileHeader)
+			if err != nil {
+				return err
+			}
+			removeOnRollback(tx, image.URL)
+			post.Images[i].URL = image.URL
+		}
+		return createPost(tx.Posts(), post, actor.UserID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionPostCreated, "post", post.ID,
+		map[string]interface{}{"title": post.Title})
+	s.renderDescriptions(post)
+	return post, nil
+}
+
+// projectDetailsFromInput は入力の制作情報をドメインの値に変換します（検証はドメインで行う）
+func projectDetailsFromInput(input dto.ProjectDetailsInput) (domainPortfolio.ProjectDetails, error) {
+	start, err := domainCareer.ParseYearMonth(input.StartDate)
+	if err != nil {
+		return domainPortfolio.ProjectDetails{}, err
+	}
+	end, err := domainCareer.ParseYearMonth(input.EndDate)
+	if err != nil {
+		return domainPortfolio.ProjectDetails{}, err
+	}
+	stack := make([]domainPortfolio.TechStackItem, 0, len(input.TechStack))
+	for _, item := range input.TechStack {
+		stack = append(stack, domainPortfolio.TechStackItem{Name: item.Name, Version: item.Version})
 	}
-	return s.portfolioRepository.CreatePost(post)
+	return domainPortfolio.ProjectDetails{
+		RepositoryURL: input.RepositoryURL,
+		DemoURL:       input.DemoURL,
+		Role:          input.Role,
+		TeamSize:      input.TeamSize,
+		StartDate:     start,
+		EndDate:       end,
+		TechStack:     stack,
+	}, nil
 }
-func (s *PortfolioService) GetPostByID(id uint) (*domainPortfolio.Post, error) {
-	return s.portfolioRepository.GetPostByID(id)
+
+func (s *PortfolioService) GetPostByID(viewerID, id uint) (*domainPortfolio.Post, error) {
+	post, err := s.portfolioRepository.GetPostByIDAnyStatus(id)
+	if err != nil {
+		return nil, err
+	}
+	if !post.IsPublished() && post.UserID != viewerID {
+		// 招待中の共同制作者は承諾する前に下書きを確認できる
+		if _, err := s.portfolioRepository.GetCollaborator(post.ID, viewerID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, gorm.ErrRecordNotFound
+			}
+			return nil, err
+		}
+	}
+	s.renderDescriptions(post)
+	return post, nil
 }
 
 func (s *PortfolioService) GetPostsByUserID(userID uint) ([]*domainPortfolio.Post, error) {
-	return s.portfolioRepository.GetPostsByUserID(userID)
+	posts, err := s.portfolioRepository.GetPostsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	s.renderDescriptions(posts...)
+	return posts, nil
+}
+
+func (s *PortfolioService) GetDrafts(userID uint) ([]*domainPortfolio.Post, error) {
+	posts, err := s.portfolioRepository.GetPostsByStatus(userID, domainPortfolio.StatusDraft, domainPortfolio.StatusScheduled)
+	if err != nil {
+		return nil, err
+	}
+	s.renderDescriptions(posts...)
+	return posts, nil
+}
+
+func (s *PortfolioService) GetArchived(userID uint) ([]*domainPortfolio.Post, error) {
+	posts, err := s.portfolioRepository.GetPostsByStatus(userID, domainPortfolio.StatusArchived)
+	if err != nil {
+		return nil, err
+	}
+	s.renderDescriptions(posts...)
+	return posts, nil
+}
+
+func (s *PortfolioService) CreateDraft(actor domainAudit.Actor, input dto.PostContentInput) (*domainPortfolio.Post, error) {
+	content, err := s.contentFromInput(input, nil)
+	if err != nil {
+		return nil, err
+	}
+	post, err := domainPortfolio.NewDraftPost(content.Title, content.Description, content.Genres, content.Skills, content.ProjectDetails, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.unitOfWork.Do(func(tx domainUnitOfWork.Tx) error {
+		return createPost(tx.Posts(), post, actor.UserID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionPostCreated, "post", post.ID,
+		map[string]interface{}{"title": post.Title, "status": post.Status})
+	s.renderDescriptions(post)
+	return post, nil
+}
+
+func (s *PortfolioService) UpdatePost(actor domainAudit.Actor, id uint, version int, input dto.PostContentInput) (*domainPortfolio.Post, error) {
+	post, err := s.ownPost(actor.UserID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := post.CheckVersion(version); err != nil {
+		return nil, err
+	}
+	content, err := s.contentFromInput(input, post.Images)
+	if err != nil {
+		return nil, err
+	}
+	return s.edit(actor, post, content, domainPortfolio.RevisionEdited, 0, nil)
+}
+
+func (s *PortfolioService) AutosaveDraft(actor domainAudit.Actor, id uint, version int, input dto.PostContentInput) (*domainPortfolio.Post, error) {
+	post, err := s.ownPost(actor.UserID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := post.CheckVersion(version); err != nil {
+		return nil, err
+	}
+	if !post.IsDraft() {
+		return nil, fmt.Errorf("%w: only drafts can be autosaved", domainPortfolio.ErrInvalidTransition)
+	}
+	content, err := s.contentFromInput(input, post.Images)
+	if err != nil {
+		return nil, err
+	}
+	if err := post.Edit(content, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.portfolioRepository.UpdatePost(post); err != nil {
+		return nil, err
+	}
+	s.renderDescriptions(post)
+	return post, nil
+}
+
+func (s *PortfolioService) DeletePost(actor domainAudit.Actor, id uint) error {
+	post, err := s.ownPost(actor.UserID, id)
+	if err != nil {
+		return err
+	}
+	if err := s.portfolioRepository.DeletePost(post.ID); err != nil {
+		return err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionPostDeleted, "post", post.ID,
+		map[string]interface{}{"title": post.Title, "status": post.Status})
+	s.markdownService.Invalidate(domainMarkdown.PostDescriptionKey(post.ID))
+	return nil
+}
+
+func (s *PortfolioService) AddImages(actor domainAudit.Actor, id uint, version int, files []*multipart.FileHeader, images []dto.PostImageInput) (*domainPortfolio.Post, error) {
+	post, err := s.ownPost(actor.UserID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := post.CheckVersion(version); err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no image files were uploaded")
+	}
+	content := post.Content()
+	content.Images = append([]domainPortfolio.Image(nil), content.Images...)
+	for i, fileHeader := range files {
+		if fileHeader.Size > 8*1024*1024 {
+			return nil, fmt.Errorf("file %s is too large", fileHeader.Filename)
+		}
+		var image domainPortfolio.Image
+		if i < len(images) {
+			image.AltText = images[i].AltText
+			image.Caption = images[i].Caption
+		}
+		content.Images = append(content.Images, image)
+	}
+
+	// 保存前に内容を検証して、不正な入力でファイルを残さない
+	check := *post
+	if err := check.Edit(content, time.Now()); err != nil {
+		return nil, err
+	}
+	offset := len(post.Images)
+	return s.edit(actor, post, content, domainPortfolio.RevisionEdited, 0,
+		func(tx domainUnitOfWork.Tx, content *domainPortfolio.Content) error {
+			for i, fileHeader := range files {
+				saved, err := saveImage(fileHeader)
+				if err != nil {
+					return err
+				}
+				removeOnRollback(tx, saved.URL)
+				content.Images[offset+i].URL = saved.URL
+			}
+			return nil
+		})
+}
+
+func (s *PortfolioService) Publish(actor domainAudit.Actor, id uint) (*domainPortfolio.Post, error) {
+	return s.transition(actor, id, domainAudit.ActionPostPublished, func(p *domainPortfolio.Post, now time.Time) error {
+		return p.Publish(now)
+	})
+}
+
+func (s *PortfolioService) Schedule(actor domainAudit.Actor, id uint, publishAt time.Time) (*domainPortfolio.Post, error) {
+	return s.transition(actor, id, domainAudit.ActionPostScheduled, func(p *domainPortfolio.Post, now time.Time) error {
+		return p.Schedule(publishAt, now)
+	})
+}
+
+func (s *PortfolioService) Unschedule(actor domainAudit.Actor, id uint) (*domainPortfolio.Post, error) {
+	return s.transition(actor, id, domainAudit.ActionPostUnscheduled, func(p *domainPortfolio.Post, now time.Time) error {
+		return p.Unschedule(now)
+	})
+}
+
+func (s *PortfolioService) Archive(actor domainAudit.Actor, id uint) (*domainPortfolio.Post, error) {
+	return s.transition(actor, id, domainAudit.ActionPostArchived, func(p *domainPortfolio.Post, now time.Time) error {
+		return p.Archive(now)
+	})
+}
+
+func (s *PortfolioService) Unarchive(actor domainAudit.Actor, id uint) (*domainPortfolio.Post, error) {
+	return s.transition(actor, id, domainAudit.ActionPostUnarchived, func(p *domainPortfolio.Post, now time.Time) error {
+		return p.Unarchive(now)
+	})
+}
+
+// PublishDue は予約投稿を公開します。複数のレプリカで同時に動いても、公開状態の条件付き更新で 1 回だけ公開する
+// 公開日時はジョブが公開した時刻にする（保存した検索の新着通知が公開日時で絞り込むため）
+func (s *PortfolioService) PublishDue(now time.Time) (int, error) {
+	posts, err := s.portfolioRepository.GetDuePosts(now)
+	if err != nil {
+		return 0, err
+	}
+	published := 0
+	for _, post := range posts {
+		if err := post.Publish(now); err != nil {
+			log.Printf("scheduled post %d could not be published: %v", post.ID, err)
+			continue
+		}
+		ok, err := s.portfolioRepository.UpdatePostStatus(post, domainPortfolio.StatusScheduled)
+		if err != nil {
+			return published, err
+		}
+		if !ok {
+			continue
+		}
+		published++
+		recordAudit(s.auditService, domainAudit.Actor{UserID: post.UserID}, domainAudit.ActionPostPublished, "post", post.ID,
+			map[string]interface{}{"from": domainPortfolio.StatusScheduled, "to": post.Status, "scheduled": true})
+	}
+	return published, nil
+}
+
+func (s *PortfolioService) GetRevisions(userID, id uint) ([]*domainPortfolio.Revision, error) {
+	if _, err := s.ownPost(userID, id); err != nil {
+		return nil, err
+	}
+	return s.portfolioRepository.GetRevisions(id)
+}
+
+func (s *PortfolioService) GetRevision(userID, id uint, number int) (*domainPortfolio.Revision, error) {
+	if _, err := s.ownPost(userID, id); err != nil {
+		return nil, err
+	}
+	return s.portfolioRepository.GetRevision(id, number)
+}
+
+func (s *PortfolioService) DiffRevisions(userID, id uint, number, against int) (*RevisionDiff, error) {
+	to, err := s.GetRevision(userID, id, number)
+	if err != nil {
+		return nil, err
+	}
+	if against == 0 {
+		against = number - 1
+	}
+	var from domainPortfolio.Content
+	if against > 0 {
+		rev, err := s.portfolioRepository.GetRevision(id, against)
+		if err != nil {
+			return nil, err
+		}
+		from = rev.Content
+	}
+	return &RevisionDiff{From: against, To: number, Changes: domainPortfolio.Diff(from, to.Content)}, nil
+}
+
+func (s *PortfolioService) RestoreRevision(actor domainAudit.Actor, id uint, version int, number int) (*domainPortfolio.Post, error) {
+	post, err := s.ownPost(actor.UserID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := post.CheckVersion(version); err != nil {
+		return nil, err
+	}
+	rev, err := s.portfolioRepository.GetRevision(id, number)
+	if err != nil {
+		return nil, err
+	}
+	return s.edit(actor, post, rev.Content, domainPortfolio.RevisionRestored, number, nil)
+}
+
+// ownPost は投稿者本人の投稿を公開状態によらず返します
+// 共同制作者には ErrForbidden を返し（編集・削除は投稿者だけ）、ほかのユーザーには見つからない扱いにする
+func (s *PortfolioService) ownPost(userID, id uint) (*domainPortfolio.Post, error) {
+	post, err := s.portfolioRepository.GetPostByIDAnyStatus(id)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID == userID {
+		return post, nil
+	}
+	if _, err := s.portfolioRepository.GetCollaborator(id, userID); err == nil {
+		return nil, ErrForbidden
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// edit は投稿の内容を置き換えて保存し、版と監査ログを残します。内容が変わらなければ何もしない
+// 内容と版は 1 つのトランザクションで保存する。upload は保存の前に同じトランザクションで実行し、
+// 追加した画像ファイルの保存など content を仕上げるのに使う（nil なら何もしない）
+func (s *PortfolioService) edit(
+	actor domainAudit.Actor,
+	post *domainPortfolio.Post,
+	content domainPortfolio.Content,
+	reason domainPortfolio.RevisionReason,
+	restoredFrom int,
+	upload func(tx domainUnitOfWork.Tx, content *domainPortfolio.Content) error,
+) (*domainPortfolio.Post, error) {
+	before := post.Content()
+	now := time.Now()
+	var changes []domainPortfolio.FieldChange
+	var rev *domainPortfolio.Revision
+	err := s.unitOfWork.Do(func(tx domainUnitOfWork.Tx) error {
+		if upload != nil {
+			if err := upload(tx, &content); err != nil {
+				return err
+			}
+		}
+		if err := post.Edit(content, now); err != nil {
+			return err
+		}
+		changes = domainPortfolio.Diff(before, post.Content())
+		if len(changes) == 0 {
+			return nil
+		}
+		if err := tx.Posts().UpdatePost(post); err != nil {
+			return err
+		}
+		if err := ensureBaselineRevision(tx.Posts(), post, before); err != nil {
+			return err
+		}
+		rev = domainPortfolio.NewRevision(post, actor.UserID, reason, now)
+		rev.RestoredFrom = restoredFrom
+		return tx.Posts().CreateRevision(rev)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		s.renderDescriptions(post)
+		return post, nil
+	}
+
+	fields := make([]string, len(changes))
+	for i, c := range changes {
+		fields[i] = c.Field
+	}
+	action := domainAudit.ActionPostUpdated
+	detail := map[string]interface{}{"fields": fields, "revision": rev.Number}
+	if reason == domainPortfolio.RevisionRestored {
+		action = domainAudit.ActionPostRestored
+		detail["restoredFrom"] = restoredFrom
+	}
+	recordAudit(s.auditService, actor, action, "post", post.ID, detail)
+
+	s.markdownService.Invalidate(domainMarkdown.PostDescriptionKey(post.ID))
+	s.renderDescriptions(post)
+	return post, nil
+}
+
+// ensureBaselineRevision は版の履歴がない投稿（履歴の導入前に作った投稿）に、編集前の内容を最初の版として残します
+func ensureBaselineRevision(repo domainPortfolio.Repository, post *domainPortfolio.Post, before domainPortfolio.Content) error {
+	_, err := repo.GetRevision(post.ID, 1)
+	if err == nil || !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return repo.CreateRevision(&domainPortfolio.Revision{
+		PostID:    post.ID,
+		EditorID:  post.UserID,
+		Reason:    domainPortfolio.RevisionCreated,
+		Content:   before,
+		CreatedAt: post.CreatedAt,
+	})
+}
+
+// transition は公開状態を変更します。読み込んでから保存するまでにほかの操作で公開状態が変わっていたら失敗する
+func (s *PortfolioService) transition(
+	actor domainAudit.Actor,
+	id uint,
+	action domainAudit.Action,
+	apply func(p *domainPortfolio.Post, now time.Time) error,
+) (*domainPortfolio.Post, error) {
+	post, err := s.ownPost(actor.UserID, id)
+	if err != nil {
+		return nil, err
+	}
+	from := post.Status
+	if err := apply(post, time.Now()); err != nil {
+		return nil, err
+	}
+	ok, err := s.portfolioRepository.UpdatePostStatus(post, from)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: the post status was changed by another request", domainPortfolio.ErrInvalidTransition)
+	}
+	detail := map[string]interface{}{"from": from, "to": post.Status}
+	if post.PublishAt != nil {
+		detail["publishAt"] = post.PublishAt
+	}
+	recordAudit(s.auditService, actor, action, "post", post.ID, detail)
+	s.renderDescriptions(post)
+	return post, nil
+}
+
+// contentFromInput は編集の入力をドメインの内容に変換します
+// 画像は投稿に登録済みのもの（current）だけを指定でき、説明は入力の値で置き換える
+func (s *PortfolioService) contentFromInput(input dto.PostContentInput, current []domainPortfolio.Image) (domainPortfolio.Content, error) {
+	if err := domainMarkdown.Validate(input.Description); err != nil {
+		return domainPortfolio.Content{}, err
+	}
+	skills, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, input.Skills)
+	if err != nil {
+		return domainPortfolio.Content{}, err
+	}
+	details, err := projectDetailsFromInput(input.ProjectDetailsInput)
+	if err != nil {
+		return domainPortfolio.Content{}, err
+	}
+	known := make(map[string]bool, len(current))
+	for _, img := range current {
+		known[img.URL] = true
+	}
+	images := make([]domainPortfolio.Image, 0, len(input.Images))
+	for i, img := range input.Images {
+		if !known[img.URL] {
+			return domainPortfolio.Content{}, fmt.Errorf("%w: %s", ErrUnknownPostImage, img.URL)
+		}
+		images = append(images, domainPortfolio.Image{
+			URL:     img.URL,
+			AltText: img.AltText,
+			Caption: img.Caption,
+			IsCover: i == input.CoverImageIndex,
+		})
+	}
+	if len(images) > 0 && (input.CoverImageIndex < 0 || input.CoverImageIndex >= len(images)) {
+		return domainPortfolio.Content{}, fmt.Errorf("cover image index is out of range")
+	}
+	return domainPortfolio.Content{
+		Title:          input.Title,
+		Description:    input.Description,
+		Genres:         input.Genres,
+		Skills:         skills,
+		Images:         images,
+		ProjectDetails: details,
+	}, nil
+}
+
+// renderDescriptions は説明文の Markdown を表示用の HTML に変換して設定します
+func (s *PortfolioService) renderDescriptions(posts ...*domainPortfolio.Post) {
+	for _, p := range posts {
+		p.DescriptionHTML = s.markdownService.RenderCached(domainMarkdown.PostDescriptionKey(p.ID), p.Description)
+	}
+}
+
+// createPost は投稿と最初の版を保存します
+func createPost(repo domainPortfolio.Repository, post *domainPortfolio.Post, actorID uint) error {
+	if err := repo.CreatePost(post); err != nil {
+		return err
+	}
+	return repo.CreateRevision(domainPortfolio.NewRevision(post, actorID, domainPortfolio.RevisionCreated, post.CreatedAt))
+}
+
+// removeOnRollback はトランザクションが取り消されたら、その中で保存したファイルを消すように登録します
+func removeOnRollback(tx domainUnitOfWork.Tx, path string) {
+	tx.OnRollback(func() { removeFile(path) })
 }
 
 // 画像を保存し、Imageモデルを返す
@@ -75,6 +641,11 @@ func saveImage(fileHeader *multipart.FileHeader) (domainPortfolio.Image, error)
 	}
 	defer file.Close()
 
+	return saveImageFile(fileHeader.Filename, file)
+}
+
+// saveImageFile は src の内容を filename の画像として保存し、Imageモデルを返す（ZIP からの取り込みでも使う）
+func saveImageFile(filename string, src io.Reader) (domainPortfolio.Image, error) {
 	// アップロード先ディレクトリを確認または作成
 	uploadDir := "uploads/PortfolioImages"
 	if _, err := os.Stat(uploadDir); os.IsNotExist(err) {
@@ -84,7 +655,7 @@ func saveImage(fileHeader *multipart.FileHeader) (domainPortfolio.Image, error)
 	}
 
 	// ユニークなファイル名を生成
-	filename := fmt.Sprintf("%d_%s", time.Now().UnixNano(), fileHeader.Filename)
+	filename = fmt.Sprintf("%d_%s", time.Now().UnixNano(), filename)
 
 	// 保存先のパスを設定
 	savePath := fmt.Sprintf("%s/%s", uploadDir, filename)
@@ -96,7 +667,10 @@ func saveImage(fileHeader *multipart.FileHeader) (domainPortfolio.Image, error)
 	}
 	defer out.Close()
 
-	if _, err = io.Copy(out, file); err != nil {
+	if _, err = io.Copy(out, src); err != nil {
+		// 書きかけのファイルは残さない
+		out.Close()
+		removeFile(savePath)
 		return domainPortfolio.Image{}, fmt.Errorf("failed to save file: %v", err)
 	}
 
@@ -110,5 +684,30 @@ func saveImage(fileHeader *multipart.FileHeader) (domainPortfolio.Image, error)
 }
 
 func (s *PortfolioService) GetAllPosts() ([]*domainPortfolio.Post, error) {
-	return s.portfolioRepository.GetAllPosts()
+	posts, err := s.portfolioRepository.GetAllPosts()
+	if err != nil {
+		return nil, err
+	}
+	s.renderDescriptions(posts...)
+	return posts, nil
+}
+
+// SearchPosts は投稿フィードを条件で絞り込みます
+func (s *PortfolioService) SearchPosts(input dto.PostSearchInput) ([]*domainPortfolio.Post, error) {
+	skills, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, input.Skills)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := s.portfolioRepository.SearchPosts(domainPortfolio.SearchCriteria{
+		Keyword:        input.Keyword,
+		Genres:         input.Genres,
+		Skills:         skills,
+		GraduationYear: input.GraduationYear,
+		Sort:           domainPortfolio.SortOrder(input.Sort),
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.renderDescriptions(posts...)
+	return posts, nil
 }
@@ -0,0 +1,239 @@
+// services/analytics_service.go
+
+package services
+
+import (
+	domainAnalytics "backend/domain/analytics"
+	domainOrganization "backend/domain/organization"
+	domainPortfolio "backend/domain/portfolio"
+	domainUser "backend/domain/user"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/url"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	rawViewRetentionDays = 90 // 閲覧の生データの保存期間。集計は削除しない
+	topPostsLimit        = 5
+	topReferrersLimit    = 10
+)
+
+type IAnalyticsService interface {
+	// RecordView は投稿・プロフィールの閲覧を記録します。本人の閲覧と、同じ日の同じ閲覧者の 2 回目以降は数えない
+	RecordView(visit domainAnalytics.Visit) error
+	// Rollup は前回集計した日から now の日までの生データを日ごとの集計にまとめ、古い生データを削除します
+	Rollup(now time.Time) (int, error)
+	// GetInsights は本人の投稿・プロフィールの直近 days 日の閲覧の集計を返します
+	GetInsights(userID uint, days int, now time.Time) (*domainAnalytics.Insights, error)
+}
+
+type AnalyticsService struct {
+	repository             domainAnalytics.Repository
+	portfolioRepository    domainPortfolio.Repository
+	userRepository         domainUser.IUserRepository
+	organizationRepository domainOrganization.Repository
+	salt                   string
+	ownHost                string
+}
+
+// NewAnalyticsService は閲覧の集計のサービスを生成します
+// siteURL はフロントエンドの URL で、サイト内の移動を参照元から除くために使う
+// salt が空の場合はプロセスごとにランダムな値を使う（再起動すると同じ日の未ログインの閲覧者を重複して数えることがある）
+func NewAnalyticsService(
+	repository domainAnalytics.Repository,
+	portfolioRepository domainPortfolio.Repository,
+	userRepository domainUser.IUserRepository,
+	organizationRepository domainOrganization.Repository,
+	salt string,
+	siteURL string,
+) IAnalyticsService {
+	if salt == "" {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			log.Fatalf("Failed to generate analytics salt: %v", err)
+		}
+		salt = hex.EncodeToString(b)
+	}
+	var ownHost string
+	if u, err := url.Parse(siteURL); err == nil {
+		ownHost = u.Hostname()
+	}
+	return &AnalyticsService{
+		repository:             repository,
+		portfolioRepository:    portfolioRepository,
+		userRepository:         userRepository,
+		organizationRepository: organizationRepository,
+		salt:                   salt,
+		ownHost:                ownHost,
+	}
+}
+
+func (s *AnalyticsService) RecordView(visit domainAnalytics.Visit) error {
+	ownerID, err := s.visibleOwner(visit)
+	if err != nil {
+		return err
+	}
+	if ownerID == visit.ViewerID {
+		return nil
+	}
+
+	var organizationID *uint
+	if visit.ViewerID != 0 {
+		id, err := s.organizationRepository.GetDisclosedOrganizationID(visit.ViewerID)
+		if err != nil {
+			return err
+		}
+		if id != 0 {
+			organizationID = &id
+		}
+	}
+
+	view := domainAnalytics.NewView(visit, ownerID, organizationID, s.salt, s.ownHost)
+	_, err = s.repository.RecordView(view)
+	return err
+}
+
+// visibleOwner は閲覧者から見える対象の持ち主（投稿者・プロフィールの本人）を返します
+func (s *AnalyticsService) visibleOwner(visit domainAnalytics.Visit) (uint, error) {
+	switch visit.TargetType {
+	case domainAnalytics.TargetPost:
+		post, err := s.portfolioRepository.GetPostByID(visit.TargetID)
+		if err != nil {
+			return 0, err
+		}
+		return post.UserID, nil
+	case domainAnalytics.TargetProfile:
+		user, err := s.userRepository.FindByID(visit.TargetID)
+		if err != nil {
+			return 0, err
+		}
+		if !user.IsVisibleTo(visit.ViewerID) {
+			return 0, gorm.ErrRecordNotFound
+		}
+		return user.ID, nil
+	}
+	_, err := domainAnalytics.ParseTargetType(string(visit.TargetType))
+	return 0, err
+}
+
+func (s *AnalyticsService) Rollup(now time.Time) (int, error) {
+	from, err := s.repository.RolledUpThrough()
+	if err != nil {
+		return 0, err
+	}
+	if from == "" {
+		if from, err = s.repository.FirstViewDay(); err != nil || from == "" {
+			return 0, err
+		}
+	}
+	start, err := domainAnalytics.ParseDay(from)
+	if err != nil {
+		return 0, err
+	}
+
+	// 前回の最後の日は途中までしか集計していないので、その日から集計し直す
+	today := domainAnalytics.Day(now)
+	n := 0
+	for d := start; domainAnalytics.Day(d) <= today; d = d.AddDate(0, 0, 1) {
+		if err := s.repository.Rollup(domainAnalytics.Day(d), now); err != nil {
+			return n, err
+		}
+		n++
+	}
+
+	cutoff := now.In(domainAnalytics.Location).AddDate(0, 0, -rawViewRetentionDays)
+	if err := s.repository.DeleteViewsBefore(domainAnalytics.Day(cutoff)); err != nil {
+		return n, err
+	}
+	return n, nil
+}
+
+func (s *AnalyticsService) GetInsights(userID uint, days int, now time.Time) (*domainAnalytics.Insights, error) {
+	if days <= 0 {
+		days = domainAnalytics.DefaultInsightDays
+	}
+	if days > domainAnalytics.MaxInsightDays {
+		days = domainAnalytics.MaxInsightDays
+	}
+	from := domainAnalytics.Day(now.In(domainAnalytics.Location).AddDate(0, 0, -(days - 1)))
+	to := domainAnalytics.Day(now)
+
+	daily, err := s.repository.GetDailyViews(userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	series, err := domainAnalytics.FillSeries(from, to, daily)
+	if err != nil {
+		return nil, err
+	}
+	insights := &domainAnalytics.Insights{From: from, To: to, Series: series}
+	for _, d := range series {
+		insights.TotalPostViews += d.PostViews
+		insights.TotalProfileViews += d.ProfileViews
+	}
+
+	if insights.TopPosts, err = s.topPosts(userID, from, to); err != nil {
+		return nil, err
+	}
+	if insights.Referrers, err = s.repository.GetReferrers(userID, from, to, topReferrersLimit); err != nil {
+		return nil, err
+	}
+	if insights.Organizations, err = s.organizationViews(userID, from, to); err != nil {
+		return nil, err
+	}
+	return insights, nil
+}
+
+// topPosts は閲覧の多い投稿を返します。削除・非表示になった投稿は除く
+func (s *AnalyticsService) topPosts(userID uint, from, to string) ([]domainAnalytics.PostViews, error) {
+	targets, err := s.repository.GetTopTargets(userID, domainAnalytics.TargetPost, from, to, topPostsLimit)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint, 0, len(targets))
+	for _, t := range targets {
+		ids = append(ids, t.TargetID)
+	}
+	posts, err := s.portfolioRepository.GetPostsByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	titles := make(map[uint]string, len(posts))
+	for _, p := range posts {
+		titles[p.ID] = p.Title
+	}
+	result := make([]domainAnalytics.PostViews, 0, len(targets))
+	for _, t := range targets {
+		title, ok := titles[t.TargetID]
+		if !ok {
+			continue
+		}
+		result = append(result, domainAnalytics.PostViews{PostID: t.TargetID, Title: title, Views: t.Views})
+	}
+	return result, nil
+}
+
+func (s *AnalyticsService) organizationViews(userID uint, from, to string) ([]domainAnalytics.OrganizationViews, error) {
+	views, err := s.repository.GetOrganizationViews(userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]domainAnalytics.OrganizationViews, 0, len(views))
+	for _, v := range views {
+		org, err := s.organizationRepository.GetOrganizationByID(v.OrganizationID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue // 削除された企業
+			}
+			return nil, err
+		}
+		v.Name = org.Name
+		result = append(result, v)
+	}
+	return result, nil
+}
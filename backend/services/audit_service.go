@@ -0,0 +1,141 @@
+// services/audit_service.go
+
+package services
+
+import (
+	domainAudit "backend/domain/audit"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"errors"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type IAuditService interface {
+	Record(actor domainAudit.Actor, action domainAudit.Action, targetType string, targetID uint, detail interface{}) error
+	// ログイン失敗など、ユーザーがメールアドレスでしか特定できない操作を記録する
+	RecordByEmail(actor domainAudit.Actor, action domainAudit.Action, email string, detail map[string]interface{}) error
+
+	// 本人のセキュリティ履歴
+	GetSecurityEvents(userID uint, page, perPage int) ([]*domainAudit.Entry, int64, error)
+	// 運営向けの全件検索
+	SearchEntries(input dto.AuditLogSearchInput) ([]*domainAudit.Entry, int64, error)
+	// 保存期間を過ぎたログを削除する
+	PurgeOldEntries() error
+}
+
+const (
+	defaultAuditLogsPerPage = 50
+	maxAuditLogsPerPage     = 200
+
+	// AUDIT_LOG_RETENTION_DAYS が未設定の場合の保存期間
+	defaultAuditLogRetentionDays = 365
+)
+
+type AuditService struct {
+	repository     domainAudit.Repository
+	userRepository domainUser.IUserRepository
+}
+
+func NewAuditService(repository domainAudit.Repository, userRepository domainUser.IUserRepository) IAuditService {
+	return &AuditService{repository: repository, userRepository: userRepository}
+}
+
+// Record は監査ログを 1 件追記します
+func (s *AuditService) Record(actor domainAudit.Actor, action domainAudit.Action, targetType string, targetID uint, detail interface{}) error {
+	entry, err := domainAudit.NewEntry(actor, action, targetType, targetID, detail, time.Now())
+	if err != nil {
+		return err
+	}
+	return s.repository.Append(entry)
+}
+
+// RecordByEmail はメールアドレスから対象ユーザーを引いて記録します
+// 存在しないアドレスの場合も、対象なし (TargetID = 0) として入力されたアドレスを残します
+func (s *AuditService) RecordByEmail(actor domainAudit.Actor, action domainAudit.Action, email string, detail map[string]interface{}) error {
+	if detail == nil {
+		detail = map[string]interface{}{}
+	}
+	detail["email"] = email
+
+	var targetID uint
+	user, err := s.userRepository.FindUserByEmail(email)
+	switch {
+	case err == nil:
+		targetID = user.ID
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return err
+	}
+	return s.Record(actor, action, "user", targetID, detail)
+}
+
+// GetSecurityEvents は本人のアカウントに対する認証・プロフィール関連の操作を新しい順に返します
+func (s *AuditService) GetSecurityEvents(userID uint, page, perPage int) ([]*domainAudit.Entry, int64, error) {
+	limit, offset := auditLogPage(page, perPage)
+	return s.repository.Find(domainAudit.Filter{
+		TargetType: "user",
+		TargetID:   userID,
+		Actions:    domainAudit.SecurityActions,
+		Limit:      limit,
+		Offset:     offset,
+	})
+}
+
+func (s *AuditService) SearchEntries(input dto.AuditLogSearchInput) ([]*domainAudit.Entry, int64, error) {
+	limit, offset := auditLogPage(input.Page, input.PerPage)
+	filter := domainAudit.Filter{
+		ActorID:    input.ActorID,
+		TargetType: input.TargetType,
+		TargetID:   input.TargetID,
+		Limit:      limit,
+		Offset:     offset,
+	}
+	for _, a := range input.Actions {
+		filter.Actions = append(filter.Actions, domainAudit.Action(a))
+	}
+	if input.From != nil {
+		filter.From = *input.From
+	}
+	if input.To != nil {
+		filter.To = *input.To
+	}
+	return s.repository.Find(filter)
+}
+
+func (s *AuditService) PurgeOldEntries() error {
+	days := defaultAuditLogRetentionDays
+	if v, err := strconv.Atoi(os.Getenv("AUDIT_LOG_RETENTION_DAYS")); err == nil && v > 0 {
+		days = v
+	}
+	deleted, err := s.repository.DeleteBefore(time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		return err
+	}
+	log.Printf("Purged %d audit log entries older than %d days", deleted, days)
+	return nil
+}
+
+func auditLogPage(page, perPage int) (limit, offset int) {
+	if perPage < 1 {
+		perPage = defaultAuditLogsPerPage
+	}
+	if perPage > maxAuditLogsPerPage {
+		perPage = maxAuditLogsPerPage
+	}
+	if page < 1 {
+		page = 1
+	}
+	return perPage, (page - 1) * perPage
+}
+
+// recordAudit は監査ログの書き込みに失敗しても本来の処理を止めないためのヘルパーです
+// 運営による操作のように記録が必須のものは Record のエラーを呼び出し元に返してください
+func recordAudit(auditService IAuditService, actor domainAudit.Actor, action domainAudit.Action, targetType string, targetID uint, detail interface{}) {
+	if err := auditService.Record(actor, action, targetType, targetID, detail); err != nil {
+		log.Printf("Error recording audit log (%s): %v", action, err)
+	}
+}
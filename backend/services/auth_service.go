@@ -192,6 +192,9 @@ func (s *AuthService) FindOrCreateUserByGoogle(userinfo *oauth2Google.Userinfo)
 				LastName:   "",
 				IsVerified: true,
 				Password:   nil,
+				Role:       domainUser.RoleStudent,
+
+				ProfileVisibility: domainUser.VisibilityPublic,
 			}
 			if err := s.repository.CreateUser(user); err != nil {
 				return nil, err
@@ -0,0 +1,414 @@
+// services/career_service.go
+
+package services
+
+import (
+	domainAudit "backend/domain/audit"
+	domainCareer "backend/domain/career"
+	domainTaxonomy "backend/domain/taxonomy"
+	domainUnitOfWork "backend/domain/unitofwork"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ICareerService interface {
+	GetEducations(userID uint) ([]*domainCareer.Education, error)
+	CreateEducation(actor domainAudit.Actor, input dto.EducationInput) (*domainCareer.Education, error)
+	UpdateEducation(actor domainAudit.Actor, id uint, input dto.EducationInput) (*domainCareer.Education, error)
+	DeleteEducation(actor domainAudit.Actor, id uint) error
+	ReorderEducations(actor domainAudit.Actor, ids []uint) ([]*domainCareer.Education, error)
+
+	// GetExperiences は継続中のものを先頭に、開始年月の新しい順に返します
+	GetExperiences(userID uint) ([]*domainCareer.Experience, error)
+	CreateExperience(actor domainAudit.Actor, input dto.ExperienceInput) (*domainCareer.Experience, error)
+	UpdateExperience(actor domainAudit.Actor, id uint, input dto.ExperienceInput) (*domainCareer.Experience, error)
+	DeleteExperience(actor domainAudit.Actor, id uint) error
+
+	// ApplyProfileEducation は UpdateMinimumUserInfo で指定された学校名・卒業年などを先頭の学歴に反映し、
+	// user の学校名・卒業年も揃えます。学歴も user も保存せず、保存する学歴を返す（変更がなければ nil）
+	// 呼び出し側は user と同じトランザクションで saveEducation する
+	ApplyProfileEducation(user *domainUser.UserModel, input dto.MinimumUserInfoInput) (*domainCareer.Education, error)
+}
+
+type CareerService struct {
+	repository      domainCareer.Repository
+	unitOfWork      domainUnitOfWork.UnitOfWork
+	auditService    IAuditService
+	taxonomyService ITaxonomyService
+}
+
+func NewCareerService(
+	repository domainCareer.Repository,
+	unitOfWork domainUnitOfWork.UnitOfWork,
+	auditService IAuditService,
+	taxonomyService ITaxonomyService,
+) ICareerService {
+	return &CareerService{
+		repository:      repository,
+		unitOfWork:      unitOfWork,
+		auditService:    auditService,
+		taxonomyService: taxonomyService,
+	}
+}
+
+func (s *CareerService) GetEducations(userID uint) ([]*domainCareer.Education, error) {
+	return s.repository.GetEducationsByUserID(userID)
+}
+
+func (s *CareerService) CreateEducation(actor domainAudit.Actor, input dto.EducationInput) (*domainCareer.Education, error) {
+	degree, start, end, err := parseEducationInput(input)
+	if err != nil {
+		return nil, err
+	}
+	education, err := domainCareer.NewEducation(actor.UserID, degree, input.SchoolName, input.Department, input.Laboratory, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	// 新しい学歴は末尾に追加する（プロフィールの学校を変えたい場合は並べ替える）
+	existing, err := s.repository.GetEducationsByUserID(actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range existing {
+		if e.DisplayOrder >= education.DisplayOrder {
+			education.DisplayOrder = e.DisplayOrder + 1
+		}
+	}
+	err = s.unitOfWork.Do(func(tx domainUnitOfWork.Tx) error {
+		if err := tx.Careers().CreateEducation(education); err != nil {
+			return err
+		}
+		return syncEducationSummary(tx, actor.UserID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recordAudit(s.auditService, actor, domainAudit.ActionEducationCreated, "education", education.ID,
+		educationSnapshot(education))
+	return education, nil
+}
+
+func (s *CareerService) UpdateEducation(actor domainAudit.Actor, id uint, input dto.EducationInput) (*domainCareer.Education, error) {
+	education, err := s.getOwnEducation(actor.UserID, id)
+	if err != nil {
+		return nil, err
+	}
+	degree, start, end, err := parseEducationInput(input)
+	if err != nil {
+		return nil, err
+	}
+	before := educationSnapshot(education)
+	if err := education.Update(degree, input.SchoolName, input.Department, input.Laboratory, start, end); err != nil {
+		return nil, err
+	}
+	err = s.unitOfWork.Do(func(tx domainUnitOfWork.Tx) error {
+		if err := tx.Careers().UpdateEducation(education); err != nil {
+			return err
+		}
+		return syncEducationSummary(tx, actor.UserID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if changes := domainAudit.Diff(before, educationSnapshot(education)); len(changes) > 0 {
+		recordAudit(s.auditService, actor, domainAudit.ActionEducationUpdated, "education", education.ID,
+			map[string]interface{}{"changes": changes})
+	}
+	return education, nil
+}
+
+func (s *CareerService) DeleteEducation(actor domainAudit.Actor, id uint) error {
+	education, err := s.getOwnEducation(actor.UserID, id)
+	if err != nil {
+		return err
+	}
+	err = s.unitOfWork.Do(func(tx domainUnitOfWork.Tx) error {
+		if err := tx.Careers().DeleteEducation(education.ID); err != nil {
+			return err
+		}
+		return syncEducationSummary(tx, actor.UserID)
+	})
+	if err != nil {
+		return err
+	}
+
+	recordAudit(s.auditService, actor, domainAudit.ActionEducationDeleted, "education", education.ID,
+		educationSnapshot(education))
+	return nil
+}
+
+// ReorderEducations は ids の順に学歴を並べ替えます。ids に含めなかった学歴は今の順のまま後ろに続けます
+func (s *CareerService) ReorderEducations(actor domainAudit.Actor, ids []uint) ([]*domainCareer.Education, error) {
+	all, err := s.repository.GetEducationsByUserID(actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[uint]bool, len(all))
+	for _, e := range all {
+		known[e.ID] = true
+	}
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		if !known[id] || seen[id] {
+			return nil, gorm.ErrRecordNotFound
+		}
+		seen[id] = true
+	}
+	order := append([]uint{}, ids...)
+	for _, e := range all {
+		if !seen[e.ID] {
+			order = append(order, e.ID)
+		}
+	}
+
+	err = s.unitOfWork.Do(func(tx domainUnitOfWork.Tx) error {
+		if err := tx.Careers().ReorderEducations(actor.UserID, order); err != nil {
+			return err
+		}
+		return syncEducationSummary(tx, actor.UserID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionEducationsReordered, "user", actor.UserID,
+		map[string]interface{}{"ids": ids})
+	return s.repository.GetEducationsByUserID(actor.UserID)
+}
+
+func (s *CareerService) GetExperiences(userID uint) ([]*domainCareer.Experience, error) {
+	experiences, err := s.repository.GetExperiencesByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	domainCareer.SortExperiences(experiences)
+	return experiences, nil
+}
+
+func (s *CareerService) CreateExperience(actor domainAudit.Actor, input dto.ExperienceInput) (*domainCareer.Experience, error) {
+	experienceType, start, end, skills, err := s.parseExperienceInput(input)
+	if err != nil {
+		return nil, err
+	}
+	experience, err := domainCareer.NewExperience(actor.UserID, experienceType, input.CompanyName, input.Role, start, end, input.Description, skills)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.CreateExperience(experience); err != nil {
+		return nil, err
+	}
+
+	recordAudit(s.auditService, actor, domainAudit.ActionExperienceCreated, "experience", experience.ID,
+		experienceSnapshot(experience))
+	return experience, nil
+}
+
+func (s *CareerService) UpdateExperience(actor domainAudit.Actor, id uint, input dto.ExperienceInput) (*domainCareer.Experience, error) {
+	experience, err := s.getOwnExperience(actor.UserID, id)
+	if err != nil {
+		return nil, err
+	}
+	experienceType, start, end, skills, err := s.parseExperienceInput(input)
+	if err != nil {
+		return nil, err
+	}
+	before := experienceSnapshot(experience)
+	if err := experience.Update(experienceType, input.CompanyName, input.Role, start, end, input.Description, skills); err != nil {
+		return nil, err
+	}
+	if err := s.repository.UpdateExperience(experience); err != nil {
+		return nil, err
+	}
+
+	if changes := domainAudit.Diff(before, experienceSnapshot(experience)); len(changes) > 0 {
+		recordAudit(s.auditService, actor, domainAudit.ActionExperienceUpdated, "experience", experience.ID,
+			map[string]interface{}{"changes": changes})
+	}
+	return experience, nil
+}
+
+func (s *CareerService) DeleteExperience(actor domainAudit.Actor, id uint) error {
+	experience, err := s.getOwnExperience(actor.UserID, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repository.DeleteExperience(experience.ID); err != nil {
+		return err
+	}
+
+	recordAudit(s.auditService, actor, domainAudit.ActionExperienceDeleted, "experience", experience.ID,
+		experienceSnapshot(experience))
+	return nil
+}
+
+func (s *CareerService) ApplyProfileEducation(user *domainUser.UserModel, input dto.MinimumUserInfoInput) (*domainCareer.Education, error) {
+	if input.SchoolName == nil && input.Department == nil && input.Laboratory == nil && input.GraduationYear == nil {
+		return nil, nil
+	}
+
+	educations, err := s.repository.GetEducationsByUserID(user.ID)
+	if err != nil {
+		return nil, err
+	}
+	primary := domainCareer.Primary(educations)
+	isNew := primary == nil
+	if isNew {
+		primary = &domainCareer.Education{UserID: user.ID}
+	}
+
+	schoolName, department, laboratory, end := primary.SchoolName, primary.Department, primary.Laboratory, primary.EndDate
+	if input.SchoolName != nil {
+		schoolName = *input.SchoolName
+	}
+	if input.Department != nil {
+		department = *input.Department
+	}
+	if input.Laboratory != nil {
+		laboratory = *input.Laboratory
+	}
+	if input.GraduationYear != nil {
+		end = nil
+		if *input.GraduationYear != "" {
+			year, err := domainCareer.ParseGraduationYear(*input.GraduationYear)
+			if err != nil {
+				return nil, err
+			}
+			end = domainCareer.GraduationDate(year)
+		}
+	}
+
+	// 学歴が未登録で、すべて空のまま保存された場合は何も作らない
+	if isNew && schoolName == "" && department == "" && laboratory == "" && end == nil {
+		return nil, nil
+	}
+	if err := primary.Update(primary.Degree, schoolName, department, laboratory, primary.StartDate, end); err != nil {
+		return nil, err
+	}
+	user.SetEducationSummary(primary.SchoolName, primary.Department, primary.Laboratory, primary.GraduationYear())
+	return primary, nil
+}
+
+// saveEducation は未保存の学歴なら作成し、保存済みなら更新します
+func saveEducation(repo domainCareer.Repository, e *domainCareer.Education) error {
+	if e.ID == 0 {
+		return repo.CreateEducation(e)
+	}
+	return repo.UpdateEducation(e)
+}
+
+// syncEducationSummary は先頭の学歴をユーザーの学校名・卒業年に反映します
+// 学歴の変更と同じトランザクションで呼び、ユーザーの保存がほかの操作と重なったら学歴の変更ごと取り消す
+func syncEducationSummary(tx domainUnitOfWork.Tx, userID uint) error {
+	user, err := tx.Users().FindByID(userID)
+	if err != nil {
+		return err
+	}
+	educations, err := tx.Careers().GetEducationsByUserID(userID)
+	if err != nil {
+		return err
+	}
+	schoolName, department, laboratory, graduationYear := "", "", "", ""
+	if primary := domainCareer.Primary(educations); primary != nil {
+		schoolName, department, laboratory, graduationYear = primary.SchoolName, primary.Department, primary.Laboratory, primary.GraduationYear()
+	}
+	if user.SchoolName == schoolName && user.Department == department &&
+		user.Laboratory == laboratory && user.GraduationYear == graduationYear {
+		return nil
+	}
+	user.SetEducationSummary(schoolName, department, laboratory, graduationYear)
+	return tx.Users().UpdateUser(user)
+}
+
+func (s *CareerService) getOwnEducation(userID, id uint) (*domainCareer.Education, error) {
+	education, err := s.repository.GetEducationByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if education.UserID != userID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return education, nil
+}
+
+func (s *CareerService) getOwnExperience(userID, id uint) (*domainCareer.Experience, error) {
+	experience, err := s.repository.GetExperienceByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if experience.UserID != userID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return experience, nil
+}
+
+func parseEducationInput(input dto.EducationInput) (domainCareer.Degree, *time.Time, *time.Time, error) {
+	degree, err := domainCareer.ParseDegree(input.Degree)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	start, err := domainCareer.ParseYearMonth(input.StartDate)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	end, err := domainCareer.ParseYearMonth(input.EndDate)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return degree, start, end, nil
+}
+
+func (s *CareerService) parseExperienceInput(input dto.ExperienceInput) (domainCareer.ExperienceType, *time.Time, *time.Time, []string, error) {
+	experienceType, err := domainCareer.ParseExperienceType(input.Type)
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
+	start, err := domainCareer.ParseYearMonth(input.StartDate)
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
+	end, err := domainCareer.ParseYearMonth(input.EndDate)
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
+	skills, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, input.Skills)
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
+	return experienceType, start, end, skills, nil
+}
+
+// educationSnapshot は学歴の内容を監査ログ用に取り出します
+func educationSnapshot(e *domainCareer.Education) map[string]interface{} {
+	return map[string]interface{}{
+		"degree":     string(e.Degree),
+		"schoolName": e.SchoolName,
+		"department": e.Department,
+		"laboratory": e.Laboratory,
+		"startDate":  formatYearMonth(e.StartDate),
+		"endDate":    formatYearMonth(e.EndDate),
+	}
+}
+
+func experienceSnapshot(e *domainCareer.Experience) map[string]interface{} {
+	return map[string]interface{}{
+		"type":        string(e.Type),
+		"companyName": e.CompanyName,
+		"role":        e.Role,
+		"startDate":   formatYearMonth(&e.StartDate),
+		"endDate":     formatYearMonth(e.EndDate),
+		"description": e.Description,
+		"skills":      append([]string{}, e.Skills...),
+	}
+}
+
+func formatYearMonth(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01")
+}
@@ -0,0 +1,79 @@
+// backend/services/career_service_test.go
+package services
+
+import (
+	"errors"
+	"testing"
+
+	domainAudit "backend/domain/audit"
+	domainUnitOfWork "backend/domain/unitofwork"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	careerInfra "backend/infrastructure/career"
+	unitOfWorkInfra "backend/infrastructure/unitofwork"
+	userInfra "backend/infrastructure/user"
+)
+
+// --- トランザクションの中で読んだユーザーが、ほかの操作に先を越されていたことにする Unit of Work ---
+type staleUserUnitOfWork struct {
+	domainUnitOfWork.UnitOfWork
+}
+
+type staleUserTx struct {
+	domainUnitOfWork.Tx
+}
+
+type staleUserRepo struct {
+	domainUser.IUserRepository
+}
+
+func (u staleUserUnitOfWork) Do(fn func(tx domainUnitOfWork.Tx) error) error {
+	return u.UnitOfWork.Do(func(tx domainUnitOfWork.Tx) error {
+		return fn(staleUserTx{Tx: tx})
+	})
+}
+
+func (t staleUserTx) Users() domainUser.IUserRepository {
+	return staleUserRepo{IUserRepository: t.Tx.Users()}
+}
+
+func (r staleUserRepo) FindByID(id uint) (*domainUser.UserModel, error) {
+	user, err := r.IUserRepository.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	user.Version--
+	return user, nil
+}
+
+// --- テスト: プロフィールの学校名を保存できなければ、学歴の追加も取り消す ---
+func TestCareerService_CreateEducationRollsBackOnVersionConflict(t *testing.T) {
+	db := openTestDB(t)
+	userRepo := userInfra.NewUserRepository(db)
+	careerRepo := careerInfra.NewCareerRepo(db)
+	if err := userRepo.CreateUser(&domainUser.UserModel{Email: "a@example.com"}); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	user, err := userRepo.FindUserByEmail("a@example.com")
+	if err != nil {
+		t.Fatalf("FindUserByEmail: %v", err)
+	}
+
+	svc := NewCareerService(careerRepo, staleUserUnitOfWork{unitOfWorkInfra.NewUnitOfWork(db)}, fakeAuditService{}, fakeTaxonomyService{})
+	_, err = svc.CreateEducation(domainAudit.Actor{UserID: user.ID}, dto.EducationInput{SchoolName: "A大学"})
+	if !errors.Is(err, domainUser.ErrVersionConflict) {
+		t.Fatalf("CreateEducation = %v, want ErrVersionConflict", err)
+	}
+
+	educations, err := careerRepo.GetEducationsByUserID(user.ID)
+	if err != nil {
+		t.Fatalf("GetEducationsByUserID: %v", err)
+	}
+	if len(educations) != 0 {
+		t.Errorf("education should not be saved, got %+v", educations)
+	}
+	saved, _ := userRepo.FindByID(user.ID)
+	if saved.SchoolName != "" || saved.Version != user.Version {
+		t.Errorf("user should be unchanged, got school=%q version=%d", saved.SchoolName, saved.Version)
+	}
+}
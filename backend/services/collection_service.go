@@ -0,0 +1,458 @@
+// services/collection_service.go
+
+package services
+
+import (
+	domainAudit "backend/domain/audit"
+	domainCollection "backend/domain/collection"
+	domainPortfolio "backend/domain/portfolio"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var ErrPostNotCollectable = errors.New("only your own or co-authored posts can be added to a collection")
+
+type ICollectionService interface {
+	// GetMyCollections は本人のコレクションを下書きの投稿も含めて返します
+	GetMyCollections(userID uint) ([]*domainCollection.Collection, error)
+	// GetUserCollections は他のユーザーのプロフィールに表示するコレクションを返します
+	// 閲覧者に見せられる投稿がないコレクションは返さない
+	GetUserCollections(viewerID, userID uint) ([]*domainCollection.Collection, error)
+	GetCollection(viewerID, id uint) (*domainCollection.Collection, error)
+	CreateCollection(actor domainAudit.Actor, input dto.CollectionInput) (*domainCollection.Collection, error)
+	UpdateCollection(actor domainAudit.Actor, id uint, input dto.CollectionInput) (*domainCollection.Collection, error)
+	DeleteCollection(actor domainAudit.Actor, id uint) error
+	ReorderCollections(actor domainAudit.Actor, ids []uint) ([]*domainCollection.Collection, error)
+	// SetPosts はコレクションの投稿を ids の並びで置き換えます
+	SetPosts(actor domainAudit.Actor, id uint, postIDs []uint) (*domainCollection.Collection, error)
+	AddPost(actor domainAudit.Actor, id, postID uint) (*domainCollection.Collection, error)
+	RemovePost(actor domainAudit.Actor, id, postID uint) (*domainCollection.Collection, error)
+
+	// 以下はトップページの特集。追加・並び替えは運営のみ
+	GetFeatured(viewerID uint) ([]*domainCollection.Collection, error)
+	Feature(actor domainAudit.Actor, id uint) (*domainCollection.Collection, error)
+	Unfeature(actor domainAudit.Actor, id uint) (*domainCollection.Collection, error)
+	ReorderFeatured(actor domainAudit.Actor, ids []uint) ([]*domainCollection.Collection, error)
+}
+
+type CollectionService struct {
+	repository          domainCollection.Repository
+	portfolioRepository domainPortfolio.Repository
+	userRepository      domainUser.IUserRepository
+	auditService        IAuditService
+}
+
+func NewCollectionService(
+	repository domainCollection.Repository,
+	portfolioRepository domainPortfolio.Repository,
+	userRepository domainUser.IUserRepository,
+	auditService IAuditService,
+) ICollectionService {
+	return &CollectionService{
+		repository:          repository,
+		portfolioRepository: portfolioRepository,
+		userRepository:      userRepository,
+		auditService:        auditService,
+	}
+}
+
+func (s *CollectionService) GetMyCollections(userID uint) ([]*domainCollection.Collection, error) {
+	collections, err := s.repository.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachPosts(userID, collections...); err != nil {
+		return nil, err
+	}
+	return collections, nil
+}
+
+func (s *CollectionService) GetUserCollections(viewerID, userID uint) ([]*domainCollection.Collection, error) {
+	if viewerID == userID {
+		return s.GetMyCollections(userID)
+	}
+	owner, err := s.userRepository.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !owner.IsVisibleTo(viewerID) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	collections, err := s.repository.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachPosts(viewerID, collections...); err != nil {
+		return nil, err
+	}
+	return nonEmpty(collections), nil
+}
+
+func (s *CollectionService) GetCollection(viewerID, id uint) (*domainCollection.Collection, error) {
+	c, err := s.repository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if c.UserID != viewerID {
+		owner, err := s.userRepository.FindByID(c.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !owner.IsVisibleTo(viewerID) {
+			return nil, gorm.ErrRecordNotFound
+		}
+	}
+	if err := s.attachPosts(viewerID, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *CollectionService) CreateCollection(actor domainAudit.Actor, input dto.CollectionInput) (*domainCollection.Collection, error) {
+	existing, err := s.repository.GetByUserID(actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= domainCollection.MaxCollections {
+		return nil, fmt.Errorf("you can create at most %d collections", domainCollection.MaxCollections)
+	}
+	if input.CoverPostID != nil {
+		return nil, fmt.Errorf("add posts to the collection before choosing a cover")
+	}
+
+	c, err := domainCollection.NewCollection(actor.UserID, input.Title, input.Description)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.Create(c); err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionCollectionCreated, "collection", c.ID,
+		map[string]interface{}{"title": c.Title})
+	if err := s.attachPosts(actor.UserID, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *CollectionService) UpdateCollection(actor domainAudit.Actor, id uint, input dto.CollectionInput) (*domainCollection.Collection, error) {
+	c, err := s.ownCollection(actor.UserID, id)
+	if err != nil {
+		return nil, err
+	}
+	before := collectionSnapshot(c)
+	if err := c.Update(input.Title, input.Description, input.CoverPostID, time.Now()); err != nil {
+		return nil, err
+	}
+	return s.save(actor, c, before)
+}
+
+func (s *CollectionService) DeleteCollection(actor domainAudit.Actor, id uint) error {
+	c, err := s.ownCollection(actor.UserID, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repository.Delete(c.ID); err != nil {
+		return err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionCollectionDeleted, "collection", c.ID,
+		map[string]interface{}{"title": c.Title, "postIds": c.PostIDs})
+	return nil
+}
+
+func (s *CollectionService) ReorderCollections(actor domainAudit.Actor, ids []uint) ([]*domainCollection.Collection, error) {
+	all, err := s.repository.GetByUserID(actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	order, err := completeOrder(all, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.Reorder(actor.UserID, order); err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionCollectionsReordered, "user", actor.UserID,
+		map[string]interface{}{"ids": ids})
+	return s.GetMyCollections(actor.UserID)
+}
+
+func (s *CollectionService) SetPosts(actor domainAudit.Actor, id uint, postIDs []uint) (*domainCollection.Collection, error) {
+	c, err := s.ownCollection(actor.UserID, id)
+	if err != nil {
+		return nil, err
+	}
+	// 入っている投稿はそのまま残せる（共同制作から抜けた後なども外さない）。新しく入れる投稿だけ確認する
+	for _, postID := range postIDs {
+		if c.Contains(postID) {
+			continue
+		}
+		if err := s.checkCollectable(actor.UserID, postID); err != nil {
+			return nil, err
+		}
+	}
+	before := collectionSnapshot(c)
+	if err := c.SetPosts(postIDs, time.Now()); err != nil {
+		return nil, err
+	}
+	return s.save(actor, c, before)
+}
+
+func (s *CollectionService) AddPost(actor domainAudit.Actor, id, postID uint) (*domainCollection.Collection, error) {
+	c, err := s.ownCollection(actor.UserID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCollectable(actor.UserID, postID); err != nil {
+		return nil, err
+	}
+	before := collectionSnapshot(c)
+	if err := c.AddPost(postID, time.Now()); err != nil {
+		return nil, err
+	}
+	return s.save(actor, c, before)
+}
+
+func (s *CollectionService) RemovePost(actor domainAudit.Actor, id, postID uint) (*domainCollection.Collection, error) {
+	c, err := s.ownCollection(actor.UserID, id)
+	if err != nil {
+		return nil, err
+	}
+	before := collectionSnapshot(c)
+	if !c.RemovePost(postID, time.Now()) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return s.save(actor, c, before)
+}
+
+func (s *CollectionService) GetFeatured(viewerID uint) ([]*domainCollection.Collection, error) {
+	featured, err := s.repository.GetFeatured()
+	if err != nil {
+		return nil, err
+	}
+	visible := make([]*domainCollection.Collection, 0, len(featured))
+	owners := make(map[uint]bool)
+	for _, c := range featured {
+		ok, checked := owners[c.UserID]
+		if !checked {
+			owner, err := s.userRepository.FindByID(c.UserID)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, err
+			}
+			ok = err == nil && owner.IsVisibleTo(viewerID)
+			owners[c.UserID] = ok
+		}
+		if ok {
+			visible = append(visible, c)
+		}
+	}
+	if err := s.attachPosts(viewerID, visible...); err != nil {
+		return nil, err
+	}
+	return nonEmpty(visible), nil
+}
+
+func (s *CollectionService) Feature(actor domainAudit.Actor, id uint) (*domainCollection.Collection, error) {
+	c, err := s.repository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	featured, err := s.repository.GetFeatured()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Feature(len(featured), time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.repository.Update(c); err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionCollectionFeatured, "collection", c.ID,
+		map[string]interface{}{"title": c.Title, "ownerId": c.UserID})
+	if err := s.attachPosts(actor.UserID, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *CollectionService) Unfeature(actor domainAudit.Actor, id uint) (*domainCollection.Collection, error) {
+	c, err := s.repository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Unfeature(); err != nil {
+		return nil, err
+	}
+	if err := s.repository.Update(c); err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionCollectionUnfeatured, "collection", c.ID,
+		map[string]interface{}{"title": c.Title, "ownerId": c.UserID})
+	if err := s.attachPosts(actor.UserID, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *CollectionService) ReorderFeatured(actor domainAudit.Actor, ids []uint) ([]*domainCollection.Collection, error) {
+	featured, err := s.repository.GetFeatured()
+	if err != nil {
+		return nil, err
+	}
+	order, err := completeOrder(featured, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.ReorderFeatured(order); err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionFeaturedReordered, "collection", 0,
+		map[string]interface{}{"ids": ids})
+	return s.GetFeatured(actor.UserID)
+}
+
+// save は変更したコレクションを保存し、変わった項目を監査ログに残します
+func (s *CollectionService) save(actor domainAudit.Actor, c *domainCollection.Collection, before map[string]interface{}) (*domainCollection.Collection, error) {
+	if err := s.repository.Update(c); err != nil {
+		return nil, err
+	}
+	if changes := domainAudit.Diff(before, collectionSnapshot(c)); len(changes) > 0 {
+		recordAudit(s.auditService, actor, domainAudit.ActionCollectionUpdated, "collection", c.ID, changes)
+	}
+	if err := s.attachPosts(actor.UserID, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ownCollection は本人のコレクションを返します。他のユーザーのコレクションは見つからない扱いにする
+func (s *CollectionService) ownCollection(userID, id uint) (*domainCollection.Collection, error) {
+	c, err := s.repository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if c.UserID != userID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return c, nil
+}
+
+// checkCollectable はコレクションに入れられる投稿かを確認します
+// 本人の投稿と、共同制作者として承諾した投稿を入れられる。運営は特集のために公開中のどの投稿でも入れられる
+func (s *CollectionService) checkCollectable(userID, postID uint) error {
+	post, err := s.portfolioRepository.GetPostByIDAnyStatus(postID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrPostNotCollectable
+	}
+	if err != nil {
+		return err
+	}
+	if post.UserID == userID {
+		return nil
+	}
+	collaborator, err := s.portfolioRepository.GetCollaborator(postID, userID)
+	if err == nil && collaborator.IsAccepted() {
+		return nil
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if post.IsPublished() {
+		user, err := s.userRepository.FindByID(userID)
+		if err != nil {
+			return err
+		}
+		if user.Role == domainUser.RoleAdmin {
+			return nil
+		}
+	}
+	return ErrPostNotCollectable
+}
+
+// attachPosts は PostIDs を表示用の投稿の概要に解決し、表紙の画像を決めます
+// 公開中でない投稿は投稿者本人にだけ見せる。非表示・削除済みの投稿は一覧から外します（コレクションからは外さない）
+func (s *CollectionService) attachPosts(viewerID uint, collections ...*domainCollection.Collection) error {
+	var ids []uint
+	for _, c := range collections {
+		ids = append(ids, c.PostIDs...)
+	}
+	posts, err := s.portfolioRepository.GetPostsByIDs(ids)
+	if err != nil {
+		return err
+	}
+	byID := make(map[uint]*domainPortfolio.Post, len(posts))
+	for _, p := range posts {
+		if p.IsPublished() || p.UserID == viewerID {
+			byID[p.ID] = p
+		}
+	}
+	for _, c := range collections {
+		c.Posts = []domainCollection.PostSummary{}
+		c.CoverImageURL = ""
+		for _, id := range c.PostIDs {
+			p, ok := byID[id]
+			if !ok {
+				continue
+			}
+			summary := domainCollection.PostSummary{ID: p.ID, Title: p.Title}
+			if cover, ok := p.CoverImage(); ok {
+				summary.ThumbnailURL = cover.URL
+			}
+			c.Posts = append(c.Posts, summary)
+			if c.CoverImageURL == "" || (c.CoverPostID != nil && *c.CoverPostID == id) {
+				c.CoverImageURL = summary.ThumbnailURL
+			}
+		}
+	}
+	return nil
+}
+
+// completeOrder は ids を検証し、指定されなかったコレクションを今の並びのまま後ろに付けます
+func completeOrder(all []*domainCollection.Collection, ids []uint) ([]uint, error) {
+	known := make(map[uint]bool, len(all))
+	for _, c := range all {
+		known[c.ID] = true
+	}
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		if !known[id] || seen[id] {
+			return nil, gorm.ErrRecordNotFound
+		}
+		seen[id] = true
+	}
+	order := append([]uint{}, ids...)
+	for _, c := range all {
+		if !seen[c.ID] {
+			order = append(order, c.ID)
+		}
+	}
+	return order, nil
+}
+
+func nonEmpty(collections []*domainCollection.Collection) []*domainCollection.Collection {
+	result := make([]*domainCollection.Collection, 0, len(collections))
+	for _, c := range collections {
+		if len(c.Posts) > 0 {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func collectionSnapshot(c *domainCollection.Collection) map[string]interface{} {
+	var cover uint
+	if c.CoverPostID != nil {
+		cover = *c.CoverPostID
+	}
+	return map[string]interface{}{
+		"title":       c.Title,
+		"description": c.Description,
+		"coverPostId": cover,
+		"postIds":     append([]uint{}, c.PostIDs...),
+	}
+}
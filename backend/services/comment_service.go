@@ -0,0 +1,72 @@
+// services/comment_service.go
+
+package services
+
+import (
+	domainComment "backend/domain/comment"
+	domainPortfolio "backend/domain/portfolio"
+	domainRealtime "backend/domain/realtime"
+	"log"
+)
+
+type ICommentService interface {
+	CreateComment(postID uint, userID uint, body string) (*domainComment.Comment, error)
+	GetCommentsByPostID(postID uint) ([]*domainComment.Comment, error)
+}
+
+type CommentService struct {
+	commentRepository   domainComment.Repository
+	portfolioRepository domainPortfolio.Repository
+	realtimeService     IRealtimeService
+}
+
+func NewCommentService(
+	commentRepository domainComment.Repository,
+	portfolioRepository domainPortfolio.Repository,
+	realtimeService IRealtimeService,
+) ICommentService {
+	return &CommentService{
+		commentRepository:   commentRepository,
+		portfolioRepository: portfolioRepository,
+		realtimeService:     realtimeService,
+	}
+}
+
+func (s *CommentService) CreateComment(postID uint, userID uint, body string) (*domainComment.Comment, error) {
+	post, err := s.portfolioRepository.GetPostByID(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := domainComment.NewComment(post.ID, userID, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.commentRepository.CreateComment(comment); err != nil {
+		return nil, err
+	}
+
+	// 自分の投稿へのコメント以外は投稿者にリアルタイム通知する
+	// 配信に失敗してもコメント自体は保存済みなので、ログだけ残す
+	if post.UserID != userID {
+		payload := map[string]interface{}{
+			"commentId": comment.ID,
+			"postId":    post.ID,
+			"postTitle": post.Title,
+			"userId":    userID,
+			"body":      comment.Body,
+		}
+		if err := s.realtimeService.Publish(post.UserID, domainRealtime.EventComment, payload); err != nil {
+			log.Printf("Error publishing comment event: %v", err)
+		}
+	}
+	return comment, nil
+}
+
+func (s *CommentService) GetCommentsByPostID(postID uint) ([]*domainComment.Comment, error) {
+	// 非表示・削除済みの投稿のコメントは返さない
+	if _, err := s.portfolioRepository.GetPostByID(postID); err != nil {
+		return nil, err
+	}
+	return s.commentRepository.GetCommentsByPostID(postID)
+}
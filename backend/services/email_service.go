@@ -2,8 +2,10 @@ package services
 
 import (
 	"fmt"
+	"html"
 	"net/smtp"
 	"os"
+	"strings"
 )
 
 // IEmailService はメール送信機能のインターフェースです。
@@ -12,6 +14,14 @@ type IEmailService interface {
 	SendPasswordResetEmail(to string, resetToken string) error
 	SendWelcomeEmail(to string) error
 	SendPasswordResetConfirmationEmail(to string) error
+	SendSavedSearchDigestEmail(to string, searchName string, items []DigestItem, unsubscribeURL string) error
+}
+
+// DigestItem は保存した検索の新着通知メールに載せる 1 件分の情報です
+type DigestItem struct {
+	Title   string
+	Summary string
+	URL     string
 }
 
 // EmailService は IEmailService の実装です。
@@ -163,3 +173,46 @@ func (s *EmailService) SendPasswordResetConfirmationEmail(to string) error {
 	addr := fmt.Sprintf("%s:%s", host, port)
 	return smtp.SendMail(addr, auth, from, []string{to}, message)
 }
+
+// SendSavedSearchDigestEmail は保存した検索条件に一致する新着をまとめて送信します。
+// 件名・本文にはユーザーが入力した文字列が入るため、HTML としてエスケープします。
+func (s *EmailService) SendSavedSearchDigestEmail(to string, searchName string, items []DigestItem, unsubscribeURL string) error {
+	from := os.Getenv("SMTP_USERNAME")
+
+	subject := fmt.Sprintf("「%s」の新着が%d件あります", strings.NewReplacer("\r", "", "\n", "").Replace(searchName), len(items))
+
+	var list strings.Builder
+	for _, item := range items {
+		fmt.Fprintf(&list, `
+            <li style="margin-bottom: 12px;">
+                <a href="%s" style="color: #F15A24; font-weight: bold;">%s</a>
+                <p style="margin: 4px 0;">%s</p>
+            </li>`, html.EscapeString(item.URL), html.EscapeString(item.Title), html.EscapeString(item.Summary))
+	}
+
+	body := fmt.Sprintf(`
+    <html>
+    <body>
+        <div style="font-family: Arial, sans-serif; color: #333;">
+            <h2 style="color: #F15A24;">エンジニアのポートフォリオ</h2>
+            <p>保存した検索条件「%s」に一致する新着があります。</p>
+            <ul style="padding-left: 20px;">%s
+            </ul>
+            <hr>
+            <p style="font-size: 12px; color: #888;">このメールの配信を停止するには<a href="%s">こちら</a>をクリックしてください。</p>
+        </div>
+    </body>
+    </html>`, html.EscapeString(searchName), list.String(), html.EscapeString(unsubscribeURL))
+
+	message := []byte("To: " + to + "\r\n" +
+		"Subject: " + subject + "\r\n" +
+		"MIME-version: 1.0;\r\n" +
+		"Content-Type: text/html; charset=\"UTF-8\";\r\n" +
+		"List-Unsubscribe: <" + unsubscribeURL + ">\r\n" +
+		"List-Unsubscribe-Post: List-Unsubscribe=One-Click\r\n" +
+		"\r\n" + body + "\r\n")
+
+	host, port, auth := getSMTPConfig()
+	addr := fmt.Sprintf("%s:%s", host, port)
+	return smtp.SendMail(addr, auth, from, []string{to}, message)
+}
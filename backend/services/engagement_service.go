@@ -0,0 +1,85 @@
+// services/engagement_service.go
+
+package services
+
+import (
+	domainEngagement "backend/domain/engagement"
+	domainPortfolio "backend/domain/portfolio"
+)
+
+type IEngagementService interface {
+	// React は公開中の投稿にいいね・ブックマークを付けます。付けていた場合はそのまま
+	React(userID, postID uint, kind domainEngagement.Kind) (*domainEngagement.Summary, error)
+	// Unreact はいいね・ブックマークを外します。付けていなかった場合はそのまま
+	Unreact(userID, postID uint, kind domainEngagement.Kind) (*domainEngagement.Summary, error)
+	GetSummary(viewerID, postID uint) (*domainEngagement.Summary, error)
+	// GetBookmarks はブックマークした公開中の投稿を、ブックマークの新しい順に返します
+	GetBookmarks(userID uint) ([]*domainPortfolio.Post, error)
+}
+
+type EngagementService struct {
+	repository          domainEngagement.Repository
+	portfolioRepository domainPortfolio.Repository
+}
+
+func NewEngagementService(repository domainEngagement.Repository, portfolioRepository domainPortfolio.Repository) IEngagementService {
+	return &EngagementService{repository: repository, portfolioRepository: portfolioRepository}
+}
+
+func (s *EngagementService) React(userID, postID uint, kind domainEngagement.Kind) (*domainEngagement.Summary, error) {
+	if _, err := s.portfolioRepository.GetPostByID(postID); err != nil {
+		return nil, err
+	}
+	reaction, err := domainEngagement.NewReaction(postID, userID, kind)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repository.Add(reaction); err != nil {
+		return nil, err
+	}
+	return s.repository.GetSummary(postID, userID)
+}
+
+func (s *EngagementService) Unreact(userID, postID uint, kind domainEngagement.Kind) (*domainEngagement.Summary, error) {
+	// 非公開になった投稿からも外せるよう、投稿の状態は確認しない
+	if _, err := s.repository.Remove(postID, userID, kind); err != nil {
+		return nil, err
+	}
+	return s.repository.GetSummary(postID, userID)
+}
+
+func (s *EngagementService) GetSummary(viewerID, postID uint) (*domainEngagement.Summary, error) {
+	if _, err := s.portfolioRepository.GetPostByID(postID); err != nil {
+		return nil, err
+	}
+	return s.repository.GetSummary(postID, viewerID)
+}
+
+func (s *EngagementService) GetBookmarks(userID uint) ([]*domainPortfolio.Post, error) {
+	ids, err := s.repository.GetPostIDs(userID, domainEngagement.KindBookmark)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := s.portfolioRepository.GetPostsByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	return publishedInOrder(ids, posts), nil
+}
+
+// publishedInOrder は posts のうち公開中の投稿を ids の順に並べて返します
+func publishedInOrder(ids []uint, posts []*domainPortfolio.Post) []*domainPortfolio.Post {
+	byID := make(map[uint]*domainPortfolio.Post, len(posts))
+	for _, p := range posts {
+		if p.IsPublished() {
+			byID[p.ID] = p
+		}
+	}
+	ordered := make([]*domainPortfolio.Post, 0, len(byID))
+	for _, id := range ids {
+		if p, ok := byID[id]; ok {
+			ordered = append(ordered, p)
+		}
+	}
+	return ordered
+}
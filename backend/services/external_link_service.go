@@ -0,0 +1,68 @@
+// services/external_link_service.go
+
+package services
+
+import (
+	domainAudit "backend/domain/audit"
+	domainExternalLink "backend/domain/externallink"
+	"backend/dto"
+)
+
+type IExternalLinkService interface {
+	GetLinks(userID uint) ([]*domainExternalLink.Link, error)
+	// UpdateLinks は本人の外部リンクを input の並びで置き換えます
+	UpdateLinks(actor domainAudit.Actor, input dto.UpdateExternalLinksInput) ([]*domainExternalLink.Link, error)
+}
+
+type ExternalLinkService struct {
+	repository   domainExternalLink.Repository
+	auditService IAuditService
+}
+
+func NewExternalLinkService(repository domainExternalLink.Repository, auditService IAuditService) IExternalLinkService {
+	return &ExternalLinkService{repository: repository, auditService: auditService}
+}
+
+func (s *ExternalLinkService) GetLinks(userID uint) ([]*domainExternalLink.Link, error) {
+	return s.repository.GetByUserID(userID)
+}
+
+func (s *ExternalLinkService) UpdateLinks(actor domainAudit.Actor, input dto.UpdateExternalLinksInput) ([]*domainExternalLink.Link, error) {
+	links := make([]*domainExternalLink.Link, 0, len(input.Links))
+	for _, in := range input.Links {
+		linkType, err := domainExternalLink.ParseType(in.Type)
+		if err != nil {
+			return nil, err
+		}
+		link, err := domainExternalLink.NewLink(actor.UserID, linkType, in.URL)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	if err := domainExternalLink.ValidateLinks(links); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repository.GetByUserID(actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.ReplaceForUser(actor.UserID, links); err != nil {
+		return nil, err
+	}
+
+	if changes := domainAudit.Diff(linksSnapshot(existing), linksSnapshot(links)); len(changes) > 0 {
+		recordAudit(s.auditService, actor, domainAudit.ActionProfileUpdated, "user", actor.UserID,
+			map[string]interface{}{"changes": changes})
+	}
+	return links, nil
+}
+
+func linksSnapshot(links []*domainExternalLink.Link) map[string]interface{} {
+	urls := make([]string, 0, len(links))
+	for _, l := range links {
+		urls = append(urls, string(l.Type)+":"+l.URL)
+	}
+	return map[string]interface{}{"links": urls}
+}
@@ -0,0 +1,200 @@
+// services/github_import_service.go
+
+package services
+
+import (
+	domainAudit "backend/domain/audit"
+	domainExternalLink "backend/domain/externallink"
+	domainGitHub "backend/domain/github"
+	domainPortfolio "backend/domain/portfolio"
+	domainTaxonomy "backend/domain/taxonomy"
+	domainUnitOfWork "backend/domain/unitofwork"
+	domainUser "backend/domain/user"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrGitHubNotConfigured = errors.New("github import is not configured")
+	ErrGitHubLinkMissing   = errors.New("register a github link on your profile first")
+	ErrGitHubRepoNotFound  = errors.New("repository not found in your public repositories")
+)
+
+type IGitHubImportService interface {
+	// Preview はプロフィールの GitHub リンクのユーザーの公開リポジトリと、
+	// 使用言語から求めたスキルの候補（プロフィールにまだないもの）を返します
+	Preview(userID uint) (*domainGitHub.ImportPreview, error)
+	// ImportRepositories は選んだリポジトリから下書きの投稿を作ります。1 件でも保存できなければどれも作らない
+	ImportRepositories(actor domainAudit.Actor, names []string) ([]*domainPortfolio.Post, error)
+}
+
+type GitHubImportService struct {
+	client          domainGitHub.Client // nil なら取り込みは無効
+	linkRepository  domainExternalLink.Repository
+	userRepository  domainUser.IUserRepository
+	unitOfWork      domainUnitOfWork.UnitOfWork
+	auditService    IAuditService
+	taxonomyService ITaxonomyService
+}
+
+func NewGitHubImportService(
+	client domainGitHub.Client,
+	linkRepository domainExternalLink.Repository,
+	userRepository domainUser.IUserRepository,
+	unitOfWork domainUnitOfWork.UnitOfWork,
+	auditService IAuditService,
+	taxonomyService ITaxonomyService,
+) IGitHubImportService {
+	return &GitHubImportService{
+		client:          client,
+		linkRepository:  linkRepository,
+		userRepository:  userRepository,
+		unitOfWork:      unitOfWork,
+		auditService:    auditService,
+		taxonomyService: taxonomyService,
+	}
+}
+
+func (s *GitHubImportService) Preview(userID uint) (*domainGitHub.ImportPreview, error) {
+	username, repos, err := s.listRepositories(userID)
+	if err != nil {
+		return nil, err
+	}
+	languages := domainGitHub.AggregateLanguages(repos)
+
+	user, err := s.userRepository.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]string, 0, len(languages))
+	for _, l := range languages {
+		candidates = append(candidates, l.Language)
+	}
+	for _, r := range repos {
+		if !r.Fork {
+			candidates = append(candidates, r.Topics...)
+		}
+	}
+	matched, err := s.taxonomyService.MatchRegistered(domainTaxonomy.KindSkill, candidates)
+	if err != nil {
+		return nil, err
+	}
+	owned := make(map[string]bool, len(user.Skills))
+	for _, skill := range user.Skills {
+		owned[skill] = true
+	}
+	suggested := make([]string, 0, len(matched))
+	for _, skill := range matched {
+		if !owned[skill] {
+			suggested = append(suggested, skill)
+		}
+	}
+
+	return &domainGitHub.ImportPreview{
+		Username:        username,
+		Repositories:    repos,
+		Languages:       languages,
+		SuggestedSkills: suggested,
+	}, nil
+}
+
+func (s *GitHubImportService) ImportRepositories(actor domainAudit.Actor, names []string) ([]*domainPortfolio.Post, error) {
+	_, repos, err := s.listRepositories(actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 取り込むリポジトリは GitHub から取得した一覧の中から選ぶ（クライアントの値は名前しか信用しない）
+	selected := make([]*domainGitHub.Repo, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		repo := findRepo(repos, name)
+		if repo == nil {
+			return nil, fmt.Errorf("%w: %s", ErrGitHubRepoNotFound, name)
+		}
+		if !seen[repo.FullName] {
+			seen[repo.FullName] = true
+			selected = append(selected, repo)
+		}
+	}
+
+	posts := make([]*domainPortfolio.Post, 0, len(selected))
+	for _, repo := range selected {
+		post, err := domainPortfolio.NewDraftPost(repo.Name, repo.Description, nil, repo.Skills, repoDetails(repo), actor.UserID)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, post)
+	}
+	err = s.unitOfWork.Do(func(tx domainUnitOfWork.Tx) error {
+		for _, post := range posts {
+			if err := createPost(tx.Posts(), post, actor.UserID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, post := range posts {
+		recordAudit(s.auditService, actor, domainAudit.ActionPostCreated, "post", post.ID,
+			map[string]interface{}{"title": post.Title, "status": post.Status, "source": "github"})
+	}
+	return posts, nil
+}
+
+// listRepositories はプロフィールの GitHub リンクのユーザー名で公開リポジトリを取得し、言語をスキル名に読み替えます
+func (s *GitHubImportService) listRepositories(userID uint) (string, []*domainGitHub.Repo, error) {
+	if s.client == nil {
+		return "", nil, ErrGitHubNotConfigured
+	}
+	links, err := s.linkRepository.GetByUserID(userID)
+	if err != nil {
+		return "", nil, err
+	}
+	link, ok := domainExternalLink.Find(links, domainExternalLink.TypeGitHub)
+	if !ok || link.Username() == "" {
+		return "", nil, ErrGitHubLinkMissing
+	}
+	username := link.Username()
+
+	repos, err := s.client.ListRepositories(username)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, r := range repos {
+		skills, err := s.taxonomyService.MatchRegistered(domainTaxonomy.KindSkill, append(r.LanguageNames(), r.Topics...))
+		if err != nil {
+			return "", nil, err
+		}
+		r.Skills = skills
+	}
+	return username, repos, nil
+}
+
+func findRepo(repos []*domainGitHub.Repo, name string) *domainGitHub.Repo {
+	for _, r := range repos {
+		if strings.EqualFold(r.Name, name) || strings.EqualFold(r.FullName, name) {
+			return r
+		}
+	}
+	return nil
+}
+
+// repoDetails はリポジトリの URL と使用言語を下書きの制作情報にします
+// ホームページが URL として不正な場合はデモの URL に入れない
+func repoDetails(repo *domainGitHub.Repo) domainPortfolio.ProjectDetails {
+	details := domainPortfolio.ProjectDetails{RepositoryURL: repo.HTMLURL}
+	if domainPortfolio.IsProjectURL(repo.Homepage) {
+		details.DemoURL = repo.Homepage
+	}
+	for _, lang := range repo.LanguageNames() {
+		if len(details.TechStack) == domainPortfolio.MaxTechStack {
+			break
+		}
+		details.TechStack = append(details.TechStack, domainPortfolio.TechStackItem{Name: lang})
+	}
+	return details
+}
@@ -0,0 +1,341 @@
+// services/job_service.go
+
+package services
+
+import (
+	domainJob "backend/domain/job"
+	domainNotification "backend/domain/notification"
+	domainOrganization "backend/domain/organization"
+	domainPortfolio "backend/domain/portfolio"
+	domainTaxonomy "backend/domain/taxonomy"
+	"backend/dto"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type IJobService interface {
+	CreateJobPosting(userID uint, input dto.JobPostingInput) (*domainJob.JobPosting, error)
+	UpdateJobPosting(userID uint, jobID uint, input dto.JobPostingInput) (*domainJob.JobPosting, error)
+	DeleteJobPosting(userID uint, jobID uint) error
+	PublishJobPosting(userID uint, jobID uint) (*domainJob.JobPosting, error)
+	CloseJobPosting(userID uint, jobID uint) (*domainJob.JobPosting, error)
+	GetJobPosting(userID uint, jobID uint) (*domainJob.JobPosting, error)
+	GetOrganizationJobPostings(userID uint, organizationID uint) ([]*domainJob.JobPosting, error)
+	SearchJobPostings(input dto.JobSearchInput) ([]*domainJob.JobPosting, error)
+
+	Apply(userID uint, jobID uint, input dto.ApplyJobInput) (*domainJob.Application, error)
+	GetMyApplications(userID uint) ([]*domainJob.Application, error)
+	GetJobApplications(userID uint, jobID uint) ([]*domainJob.Application, error)
+	UpdateApplicationStatus(userID uint, applicationID uint, status domainJob.ApplicationStatus) (*domainJob.Application, error)
+}
+
+var (
+	ErrAlreadyApplied  = errors.New("already applied to this job")
+	ErrInvalidPostLink = errors.New("attached post does not belong to the applicant")
+)
+
+var applicationStatusLabels = map[domainJob.ApplicationStatus]string{
+	domainJob.ApplicationApplied:   "応募済み",
+	domainJob.ApplicationScreening: "書類選考中",
+	domainJob.ApplicationInterview: "面接",
+	domainJob.ApplicationOffer:     "内定",
+	domainJob.ApplicationRejected:  "見送り",
+}
+
+type JobService struct {
+	jobRepository          domainJob.Repository
+	organizationRepository domainOrganization.Repository
+	portfolioRepository    domainPortfolio.Repository
+	notificationService    INotificationService
+	taxonomyService        ITaxonomyService
+}
+
+func NewJobService(
+	jobRepository domainJob.Repository,
+	organizationRepository domainOrganization.Repository,
+	portfolioRepository domainPortfolio.Repository,
+	notificationService INotificationService,
+	taxonomyService ITaxonomyService,
+) IJobService {
+	return &JobService{
+		jobRepository:          jobRepository,
+		organizationRepository: organizationRepository,
+		portfolioRepository:    portfolioRepository,
+		notificationService:    notificationService,
+		taxonomyService:        taxonomyService,
+	}
+}
+
+func (s *JobService) CreateJobPosting(userID uint, input dto.JobPostingInput) (*domainJob.JobPosting, error) {
+	if err := s.requireMember(input.OrganizationID, userID); err != nil {
+		return nil, err
+	}
+	skills, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, input.Skills)
+	if err != nil {
+		return nil, err
+	}
+	posting, err := domainJob.NewJobPosting(
+		input.OrganizationID,
+		userID,
+		input.Title,
+		input.Description,
+		domainJob.EmploymentType(input.EmploymentType),
+		input.JobTypes,
+		skills,
+		input.Location,
+		input.Deadline,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.jobRepository.CreateJobPosting(posting); err != nil {
+		return nil, err
+	}
+	return posting, nil
+}
+
+func (s *JobService) UpdateJobPosting(userID uint, jobID uint, input dto.JobPostingInput) (*domainJob.JobPosting, error) {
+	posting, err := s.getPostingForMember(userID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	skills, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, input.Skills)
+	if err != nil {
+		return nil, err
+	}
+	if err := posting.Update(
+		input.Title,
+		input.Description,
+		domainJob.EmploymentType(input.EmploymentType),
+		input.JobTypes,
+		skills,
+		input.Location,
+		input.Deadline,
+	); err != nil {
+		return nil, err
+	}
+	if err := s.jobRepository.UpdateJobPosting(posting); err != nil {
+		return nil, err
+	}
+	return posting, nil
+}
+
+func (s *JobService) DeleteJobPosting(userID uint, jobID uint) error {
+	posting, err := s.getPostingForMember(userID, jobID)
+	if err != nil {
+		return err
+	}
+	return s.jobRepository.DeleteJobPosting(posting.ID)
+}
+
+func (s *JobService) PublishJobPosting(userID uint, jobID uint) (*domainJob.JobPosting, error) {
+	posting, err := s.getPostingForMember(userID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if err := posting.Publish(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.jobRepository.UpdateJobPosting(posting); err != nil {
+		return nil, err
+	}
+	return posting, nil
+}
+
+func (s *JobService) CloseJobPosting(userID uint, jobID uint) (*domainJob.JobPosting, error) {
+	posting, err := s.getPostingForMember(userID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if err := posting.Close(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.jobRepository.UpdateJobPosting(posting); err != nil {
+		return nil, err
+	}
+	return posting, nil
+}
+
+// GetJobPosting は求人詳細を返します。下書きは企業メンバーにしか見せません
+func (s *JobService) GetJobPosting(userID uint, jobID uint) (*domainJob.JobPosting, error) {
+	posting, err := s.jobRepository.GetJobPostingByID(jobID)
+	if err != nil {
+		return nil, err
+	}
+	if posting.Status == domainJob.PostingDraft {
+		if err := s.requireMember(posting.OrganizationID, userID); err != nil {
+			return nil, gorm.ErrRecordNotFound
+		}
+	}
+	return posting, nil
+}
+
+func (s *JobService) GetOrganizationJobPostings(userID uint, organizationID uint) ([]*domainJob.JobPosting, error) {
+	if err := s.requireMember(organizationID, userID); err != nil {
+		return nil, err
+	}
+	return s.jobRepository.GetJobPostingsByOrganizationID(organizationID)
+}
+
+func (s *JobService) SearchJobPostings(input dto.JobSearchInput) ([]*domainJob.JobPosting, error) {
+	skill := input.Skill
+	if skill != "" {
+		skills, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, []string{skill})
+		if err != nil {
+			return nil, err
+		}
+		if len(skills) > 0 {
+			skill = skills[0]
+		}
+	}
+	return s.jobRepository.SearchJobPostings(domainJob.SearchCriteria{
+		Keyword:        input.Keyword,
+		JobType:        input.JobType,
+		Skill:          skill,
+		Location:       input.Location,
+		EmploymentType: domainJob.EmploymentType(input.EmploymentType),
+		OpenAt:         time.Now(),
+	})
+}
+
+// Apply は学生の応募を受け付け、企業メンバーに通知します
+func (s *JobService) Apply(userID uint, jobID uint, input dto.ApplyJobInput) (*domainJob.Application, error) {
+	posting, err := s.jobRepository.GetJobPostingByID(jobID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.jobRepository.FindApplication(jobID, userID); err == nil {
+		return nil, ErrAlreadyApplied
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	// 添付できるのは自分の作品だけ
+	for _, postID := range input.PostIDs {
+		post, err := s.portfolioRepository.GetPostByID(postID)
+		if err != nil || post.UserID != userID {
+			return nil, ErrInvalidPostLink
+		}
+	}
+
+	application, err := domainJob.NewApplication(posting, userID, input.Message, input.PostIDs, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.jobRepository.CreateApplication(application); err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := s.organizationRepository.GetMemberUserIDs(posting.OrganizationID)
+	if err != nil {
+		log.Printf("Error loading organization members: %v", err)
+	}
+	for _, memberID := range memberIDs {
+		if err := s.notificationService.Notify(
+			memberID,
+			domainNotification.TypeApplicationReceived,
+			fmt.Sprintf("「%s」に新しい応募がありました", posting.Title),
+			"",
+			fmt.Sprintf("/jobs/%d/applications", posting.ID),
+		); err != nil {
+			log.Printf("Error notifying new application: %v", err)
+		}
+	}
+	return application, nil
+}
+
+func (s *JobService) GetMyApplications(userID uint) ([]*domainJob.Application, error) {
+	applications, err := s.jobRepository.GetApplicationsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	s.attachPosts(applications)
+	return applications, nil
+}
+
+func (s *JobService) GetJobApplications(userID uint, jobID uint) ([]*domainJob.Application, error) {
+	posting, err := s.getPostingForMember(userID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	applications, err := s.jobRepository.GetApplicationsByJobPostingID(posting.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.attachPosts(applications)
+	return applications, nil
+}
+
+// UpdateApplicationStatus は選考ステータスを変更し、応募者に通知します
+func (s *JobService) UpdateApplicationStatus(userID uint, applicationID uint, status domainJob.ApplicationStatus) (*domainJob.Application, error) {
+	application, err := s.jobRepository.GetApplicationByID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireMember(application.JobPosting.OrganizationID, userID); err != nil {
+		return nil, err
+	}
+	if err := application.ChangeStatus(status); err != nil {
+		return nil, err
+	}
+	if err := s.jobRepository.UpdateApplication(application); err != nil {
+		return nil, err
+	}
+
+	if err := s.notificationService.Notify(
+		application.UserID,
+		domainNotification.TypeApplicationStatusChanged,
+		fmt.Sprintf("「%s」の選考状況が「%s」になりました", application.JobPosting.Title, applicationStatusLabels[status]),
+		"",
+		"/applications",
+	); err != nil {
+		log.Printf("Error notifying application status: %v", err)
+	}
+	return application, nil
+}
+
+// requireMember は承認済みの企業に所属していることを確かめます
+func (s *JobService) requireMember(organizationID, userID uint) error {
+	if _, err := s.organizationRepository.FindMember(organizationID, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrForbidden
+		}
+		return err
+	}
+	org, err := s.organizationRepository.GetOrganizationByID(organizationID)
+	if err != nil {
+		return err
+	}
+	if !org.IsApproved() {
+		return ErrOrganizationNotApproved
+	}
+	return nil
+}
+
+func (s *JobService) getPostingForMember(userID uint, jobID uint) (*domainJob.JobPosting, error) {
+	posting, err := s.jobRepository.GetJobPostingByID(jobID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireMember(posting.OrganizationID, userID); err != nil {
+		return nil, err
+	}
+	return posting, nil
+}
+
+// attachPosts は応募に添付された作品を表示用に詰めます。削除済みの作品は読み飛ばします
+func (s *JobService) attachPosts(applications []*domainJob.Application) {
+	for _, a := range applications {
+		for _, postID := range a.PostIDs {
+			post, err := s.portfolioRepository.GetPostByID(postID)
+			if err != nil {
+				continue
+			}
+			a.Posts = append(a.Posts, post)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// services/markdown_service.go
+
+package services
+
+import (
+	domainMarkdown "backend/domain/markdown"
+	"log"
+)
+
+type IMarkdownService interface {
+	// Preview はエディタのプレビュー用に変換します（キャッシュしない）
+	Preview(source string) (string, error)
+	// RenderCached は key のキャッシュを使って変換します。キャッシュの原文と違えば変換し直す
+	RenderCached(key, source string) string
+	// Invalidate は原文を編集したときにキャッシュを捨てます
+	Invalidate(key string)
+}
+
+type MarkdownService struct {
+	renderer domainMarkdown.Renderer
+	cache    domainMarkdown.Cache
+}
+
+func NewMarkdownService(renderer domainMarkdown.Renderer, cache domainMarkdown.Cache) IMarkdownService {
+	return &MarkdownService{renderer: renderer, cache: cache}
+}
+
+func (s *MarkdownService) Preview(source string) (string, error) {
+	if err := domainMarkdown.Validate(source); err != nil {
+		return "", err
+	}
+	return s.renderer.Render(source)
+}
+
+func (s *MarkdownService) RenderCached(key, source string) string {
+	if source == "" {
+		return ""
+	}
+	hash := domainMarkdown.Hash(source)
+	if cached, ok := s.cache.Get(key); ok && cached.SourceHash == hash {
+		return cached.HTML
+	}
+	html, err := s.renderer.Render(source)
+	if err != nil {
+		// 表示用の HTML がなくても原文は返せるので、読み込み自体は失敗させない
+		log.Printf("failed to render markdown for %s: %v", key, err)
+		return ""
+	}
+	s.cache.Set(key, domainMarkdown.Cached{SourceHash: hash, HTML: html})
+	return html
+}
+
+func (s *MarkdownService) Invalidate(key string) {
+	s.cache.Delete(key)
+}
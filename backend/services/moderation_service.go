@@ -0,0 +1,270 @@
+// services/moderation_service.go
+
+package services
+
+import (
+	domainAudit "backend/domain/audit"
+	domainComment "backend/domain/comment"
+	domainModeration "backend/domain/moderation"
+	domainPortfolio "backend/domain/portfolio"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type IModerationService interface {
+	// 一般ユーザー向け
+	CreateReport(reporterID uint, input dto.CreateReportInput) (*domainModeration.Report, error)
+
+	// 以下は運営向け。操作はすべて監査ログに記録する
+	GetReports(input dto.ReportListInput) ([]*domainModeration.Report, int64, error)
+	CloseReport(actor domainAudit.Actor, reportID uint, input dto.CloseReportInput) (*domainModeration.Report, error)
+	HidePost(actor domainAudit.Actor, postID uint, reason string) (*domainPortfolio.Post, error)
+	UnhidePost(actor domainAudit.Actor, postID uint) (*domainPortfolio.Post, error)
+	SuspendUser(actor domainAudit.Actor, userID uint, reason string) error
+	UnsuspendUser(actor domainAudit.Actor, userID uint) error
+	AddNote(actor domainAudit.Actor, input dto.CreateModerationNoteInput) (*domainModeration.Note, error)
+	GetNotes(targetType domainModeration.TargetType, targetID uint) ([]*domainModeration.Note, error)
+}
+
+var ErrAlreadyReported = errors.New("already reported")
+
+const (
+	defaultReportsPerPage = 50
+	maxReportsPerPage     = 100
+)
+
+type ModerationService struct {
+	repository          domainModeration.Repository
+	portfolioRepository domainPortfolio.Repository
+	commentRepository   domainComment.Repository
+	userRepository      domainUser.IUserRepository
+	auditService        IAuditService
+}
+
+func NewModerationService(
+	repository domainModeration.Repository,
+	portfolioRepository domainPortfolio.Repository,
+	commentRepository domainComment.Repository,
+	userRepository domainUser.IUserRepository,
+	auditService IAuditService,
+) IModerationService {
+	return &ModerationService{
+		repository:          repository,
+		portfolioRepository: portfolioRepository,
+		commentRepository:   commentRepository,
+		userRepository:      userRepository,
+		auditService:        auditService,
+	}
+}
+
+// CreateReport は投稿・コメント・プロフィールへの通報を受け付けます
+// 同じ対象への未対応の通報を同じユーザーが重ねて送ることはできません
+func (s *ModerationService) CreateReport(reporterID uint, input dto.CreateReportInput) (*domainModeration.Report, error) {
+	report, err := domainModeration.NewReport(
+		reporterID,
+		domainModeration.TargetType(input.TargetType),
+		input.TargetID,
+		domainModeration.ReasonCategory(input.Category),
+		input.Detail,
+		time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureTargetExists(report.TargetType, report.TargetID); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repository.HasOpenReport(reporterID, report.TargetType, report.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrAlreadyReported
+	}
+
+	if err := s.repository.CreateReport(report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (s *ModerationService) GetReports(input dto.ReportListInput) ([]*domainModeration.Report, int64, error) {
+	status := domainModeration.ReportStatus(input.Status)
+	if status == "" {
+		status = domainModeration.ReportOpen
+	}
+	perPage := input.PerPage
+	if perPage < 1 {
+		perPage = defaultReportsPerPage
+	}
+	if perPage > maxReportsPerPage {
+		perPage = maxReportsPerPage
+	}
+	page := input.Page
+	if page < 1 {
+		page = 1
+	}
+	return s.repository.FindReports(domainModeration.ReportFilter{
+		Status:     status,
+		TargetType: domainModeration.TargetType(input.TargetType),
+		Limit:      perPage,
+		Offset:     (page - 1) * perPage,
+	})
+}
+
+func (s *ModerationService) CloseReport(actor domainAudit.Actor, reportID uint, input dto.CloseReportInput) (*domainModeration.Report, error) {
+	report, err := s.repository.GetReportByID(reportID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	status := domainModeration.ReportStatus(input.Status)
+	if err := report.Close(status, actor.UserID, now); err != nil {
+		return nil, err
+	}
+
+	var note *domainModeration.Note
+	if input.Note != "" {
+		note, err = domainModeration.NewNote(report.TargetType, report.TargetID, actor.UserID, input.Note, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repository.UpdateReport(report); err != nil {
+		return nil, err
+	}
+	if note != nil {
+		if err := s.repository.CreateNote(note); err != nil {
+			return nil, err
+		}
+	}
+
+	action := domainAudit.ActionReportResolved
+	if status == domainModeration.ReportDismissed {
+		action = domainAudit.ActionReportDismissed
+	}
+	detail := map[string]interface{}{
+		"targetType": report.TargetType,
+		"targetId":   report.TargetID,
+		"category":   report.Category,
+	}
+	if note != nil {
+		detail["noteId"] = note.ID
+	}
+	if err := s.auditService.Record(actor, action, "report", report.ID, detail); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (s *ModerationService) HidePost(actor domainAudit.Actor, postID uint, reason string) (*domainPortfolio.Post, error) {
+	post, err := s.portfolioRepository.GetPostByIDIncludingHidden(postID)
+	if err != nil {
+		return nil, err
+	}
+	if err := post.Hide(reason, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.portfolioRepository.UpdatePostVisibility(post); err != nil {
+		return nil, err
+	}
+	if err := s.auditService.Record(actor, domainAudit.ActionPostHidden, string(domainModeration.TargetPost), post.ID,
+		map[string]interface{}{"reason": reason, "ownerId": post.UserID}); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+func (s *ModerationService) UnhidePost(actor domainAudit.Actor, postID uint) (*domainPortfolio.Post, error) {
+	post, err := s.portfolioRepository.GetPostByIDIncludingHidden(postID)
+	if err != nil {
+		return nil, err
+	}
+	previousReason := post.HiddenReason
+	post.Unhide()
+	if err := s.portfolioRepository.UpdatePostVisibility(post); err != nil {
+		return nil, err
+	}
+	if err := s.auditService.Record(actor, domainAudit.ActionPostUnhidden, string(domainModeration.TargetPost), post.ID,
+		map[string]interface{}{"previousReason": previousReason, "ownerId": post.UserID}); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+func (s *ModerationService) SuspendUser(actor domainAudit.Actor, userID uint, reason string) error {
+	user, err := s.userRepository.FindByID(userID)
+	if err != nil {
+		return err
+	}
+	if err := user.Suspend(reason, time.Now()); err != nil {
+		return err
+	}
+	if err := s.userRepository.UpdateUser(user); err != nil {
+		return err
+	}
+	return s.auditService.Record(actor, domainAudit.ActionUserSuspended, string(domainModeration.TargetUser), user.ID,
+		map[string]interface{}{"reason": reason})
+}
+
+func (s *ModerationService) UnsuspendUser(actor domainAudit.Actor, userID uint) error {
+	user, err := s.userRepository.FindByID(userID)
+	if err != nil {
+		return err
+	}
+	previousReason := user.SuspensionReason
+	user.Unsuspend(time.Now())
+	if err := s.userRepository.UpdateUser(user); err != nil {
+		return err
+	}
+	return s.auditService.Record(actor, domainAudit.ActionUserUnsuspended, string(domainModeration.TargetUser), user.ID,
+		map[string]interface{}{"previousReason": previousReason})
+}
+
+func (s *ModerationService) AddNote(actor domainAudit.Actor, input dto.CreateModerationNoteInput) (*domainModeration.Note, error) {
+	note, err := domainModeration.NewNote(
+		domainModeration.TargetType(input.TargetType),
+		input.TargetID,
+		actor.UserID,
+		input.Body,
+		time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.CreateNote(note); err != nil {
+		return nil, err
+	}
+	// 本文は監査ログに複製せず、メモの ID だけを残す
+	if err := s.auditService.Record(actor, domainAudit.ActionNoteAdded, string(note.TargetType), note.TargetID,
+		map[string]interface{}{"noteId": note.ID}); err != nil {
+		return nil, err
+	}
+	return note, nil
+}
+
+func (s *ModerationService) GetNotes(targetType domainModeration.TargetType, targetID uint) ([]*domainModeration.Note, error) {
+	return s.repository.GetNotes(targetType, targetID)
+}
+
+// ensureTargetExists は通報対象が存在するかを確認します
+// 非表示・削除済みの投稿は一般ユーザーから見えないため gorm.ErrRecordNotFound になります
+func (s *ModerationService) ensureTargetExists(targetType domainModeration.TargetType, targetID uint) error {
+	var err error
+	switch targetType {
+	case domainModeration.TargetPost:
+		_, err = s.portfolioRepository.GetPostByID(targetID)
+	case domainModeration.TargetComment:
+		_, err = s.commentRepository.GetCommentByID(targetID)
+	case domainModeration.TargetUser:
+		_, err = s.userRepository.FindByID(targetID)
+	default:
+		err = fmt.Errorf("unknown report target: %s", targetType)
+	}
+	return err
+}
@@ -0,0 +1,64 @@
+// services/notification_service.go
+
+package services
+
+import (
+	domainNotification "backend/domain/notification"
+	domainRealtime "backend/domain/realtime"
+	"log"
+)
+
+type INotificationService interface {
+	Notify(userID uint, notificationType domainNotification.Type, title, body, link string) error
+	GetNotifications(userID uint) ([]*domainNotification.Notification, int64, error)
+	MarkAsRead(userID uint, id uint) error
+	MarkAllAsRead(userID uint) error
+}
+
+// 一覧で返す通知の最大件数
+const notificationListLimit = 50
+
+type NotificationService struct {
+	repository      domainNotification.Repository
+	realtimeService IRealtimeService
+}
+
+func NewNotificationService(repository domainNotification.Repository, realtimeService IRealtimeService) INotificationService {
+	return &NotificationService{repository: repository, realtimeService: realtimeService}
+}
+
+// Notify は通知を保存し、接続中のクライアントへリアルタイムに配信します
+func (s *NotificationService) Notify(userID uint, notificationType domainNotification.Type, title, body, link string) error {
+	n, err := domainNotification.NewNotification(userID, notificationType, title, body, link)
+	if err != nil {
+		return err
+	}
+	if err := s.repository.CreateNotification(n); err != nil {
+		return err
+	}
+	// 配信に失敗しても通知は保存済みなので、一覧取得で確認できる
+	if err := s.realtimeService.Publish(userID, domainRealtime.EventNotification, n); err != nil {
+		log.Printf("Error publishing notification event: %v", err)
+	}
+	return nil
+}
+
+func (s *NotificationService) GetNotifications(userID uint) ([]*domainNotification.Notification, int64, error) {
+	notifications, err := s.repository.GetNotificationsByUserID(userID, notificationListLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+	unread, err := s.repository.CountUnread(userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return notifications, unread, nil
+}
+
+func (s *NotificationService) MarkAsRead(userID uint, id uint) error {
+	return s.repository.MarkAsRead(userID, id)
+}
+
+func (s *NotificationService) MarkAllAsRead(userID uint) error {
+	return s.repository.MarkAllAsRead(userID)
+}
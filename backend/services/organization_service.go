@@ -0,0 +1,214 @@
+// services/organization_service.go
+
+package services
+
+import (
+	domainAudit "backend/domain/audit"
+	domainNotification "backend/domain/notification"
+	domainOrganization "backend/domain/organization"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"errors"
+	"fmt"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+type IOrganizationService interface {
+	CreateOrganization(actor domainAudit.Actor, input dto.CreateOrganizationInput) (*domainOrganization.Organization, error)
+	GetMyOrganizations(userID uint) ([]*domainOrganization.Organization, error)
+	// GetPendingOrganizations は運営の審査待ちの企業アカウントを古い順に返します
+	GetPendingOrganizations() ([]*domainOrganization.Organization, error)
+	// ApproveOrganization は運営が企業アカウントを承認し、所属メンバーを採用担当にします
+	ApproveOrganization(actor domainAudit.Actor, organizationID uint) (*domainOrganization.Organization, error)
+	AddMember(actor domainAudit.Actor, organizationID uint, input dto.AddOrganizationMemberInput) error
+	// SetViewDisclosure は採用担当が学生のプロフィール・作品を閲覧したとき、企業名を知らせるかを設定します
+	SetViewDisclosure(actor domainAudit.Actor, organizationID uint, enabled bool) error
+}
+
+var (
+	ErrForbidden     = errors.New("forbidden")
+	ErrAlreadyMember = errors.New("user is already a member")
+
+	ErrOrganizationNotApproved = errors.New("organization is not approved yet")
+)
+
+type OrganizationService struct {
+	organizationRepository domainOrganization.Repository
+	userRepository         domainUser.IUserRepository
+	notificationService    INotificationService
+	auditService           IAuditService
+}
+
+func NewOrganizationService(
+	organizationRepository domainOrganization.Repository,
+	userRepository domainUser.IUserRepository,
+	notificationService INotificationService,
+	auditService IAuditService,
+) IOrganizationService {
+	return &OrganizationService{
+		organizationRepository: organizationRepository,
+		userRepository:         userRepository,
+		notificationService:    notificationService,
+		auditService:           auditService,
+	}
+}
+
+// CreateOrganization は企業アカウントを審査待ちで作成し、作成者をオーナーにします
+// 採用担当への昇格は運営が承認したときに行う
+func (s *OrganizationService) CreateOrganization(actor domainAudit.Actor, input dto.CreateOrganizationInput) (*domainOrganization.Organization, error) {
+	user, err := s.userRepository.FindByID(actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := domainOrganization.NewOrganization(input.Name, input.Description, input.WebsiteURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.organizationRepository.CreateOrganization(org, user.ID); err != nil {
+		return nil, err
+	}
+	return org, nil
+}
+
+func (s *OrganizationService) GetMyOrganizations(userID uint) ([]*domainOrganization.Organization, error) {
+	return s.organizationRepository.GetOrganizationsByUserID(userID)
+}
+
+func (s *OrganizationService) GetPendingOrganizations() ([]*domainOrganization.Organization, error) {
+	return s.organizationRepository.GetOrganizationsByStatus(domainOrganization.StatusPending)
+}
+
+func (s *OrganizationService) ApproveOrganization(actor domainAudit.Actor, organizationID uint) (*domainOrganization.Organization, error) {
+	org, err := s.organizationRepository.GetOrganizationByID(organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := org.Approve(); err != nil {
+		return nil, err
+	}
+	if err := s.organizationRepository.UpdateStatus(org); err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionOrganizationApproved, "organization", org.ID, map[string]interface{}{
+		"name": org.Name,
+	})
+
+	// 審査待ちの間はメンバーを追加できないので、実際にはオーナーだけが昇格する
+	memberIDs, err := s.organizationRepository.GetMemberUserIDs(org.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, memberID := range memberIDs {
+		member, err := s.userRepository.FindByID(memberID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.promoteToRecruiter(actor, member, org.ID); err != nil {
+			return nil, err
+		}
+		if err := s.notificationService.Notify(
+			member.ID,
+			domainNotification.TypeOrganizationApproved,
+			fmt.Sprintf("%s の企業アカウントが承認されました", org.Name),
+			"",
+			fmt.Sprintf("/organizations/%d", org.ID),
+		); err != nil {
+			log.Printf("Error notifying organization approval: %v", err)
+		}
+	}
+	return org, nil
+}
+
+// AddMember はオーナーがメールアドレスで指定したユーザーを企業アカウントに追加します
+func (s *OrganizationService) AddMember(actor domainAudit.Actor, organizationID uint, input dto.AddOrganizationMemberInput) error {
+	owner, err := s.organizationRepository.FindMember(organizationID, actor.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrForbidden
+		}
+		return err
+	}
+	if owner.Role != domainOrganization.MemberRoleOwner {
+		return ErrForbidden
+	}
+
+	org, err := s.organizationRepository.GetOrganizationByID(organizationID)
+	if err != nil {
+		return err
+	}
+	if !org.IsApproved() {
+		return ErrOrganizationNotApproved
+	}
+	user, err := s.userRepository.FindUserByEmail(input.Email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	if _, err := s.organizationRepository.FindMember(organizationID, user.ID); err == nil {
+		return ErrAlreadyMember
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	member := &domainOrganization.Member{
+		OrganizationID: organizationID,
+		UserID:         user.ID,
+		Role:           domainOrganization.MemberRoleMember,
+	}
+	if err := s.organizationRepository.AddMember(member); err != nil {
+		return err
+	}
+	if err := s.promoteToRecruiter(actor, user, org.ID); err != nil {
+		return err
+	}
+
+	if err := s.notificationService.Notify(
+		user.ID,
+		domainNotification.TypeOrganizationInvited,
+		fmt.Sprintf("%s の採用担当に追加されました", org.Name),
+		"",
+		fmt.Sprintf("/organizations/%d", org.ID),
+	); err != nil {
+		log.Printf("Error notifying organization member: %v", err)
+	}
+	return nil
+}
+
+func (s *OrganizationService) SetViewDisclosure(actor domainAudit.Actor, organizationID uint, enabled bool) error {
+	if _, err := s.organizationRepository.FindMember(organizationID, actor.UserID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrForbidden
+		}
+		return err
+	}
+	if err := s.organizationRepository.SetDiscloseViews(organizationID, actor.UserID, enabled); err != nil {
+		return err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionPrivacyUpdated, "user", actor.UserID, map[string]interface{}{
+		"organizationId": organizationID,
+		"discloseViews":  enabled,
+	})
+	return nil
+}
+
+func (s *OrganizationService) promoteToRecruiter(actor domainAudit.Actor, user *domainUser.UserModel, organizationID uint) error {
+	if user.IsRecruiter() || user.Role == domainUser.RoleAdmin {
+		return nil
+	}
+	previous := user.Role
+	user.BecomeRecruiter()
+	if err := s.userRepository.UpdateUser(user); err != nil {
+		return err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionRoleChanged, "user", user.ID, map[string]interface{}{
+		"from":           previous,
+		"to":             user.Role,
+		"organizationId": organizationID,
+	})
+	return nil
+}
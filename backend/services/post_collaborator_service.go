@@ -0,0 +1,257 @@
+// services/post_collaborator_service.go
+
+package services
+
+import (
+	domainAudit "backend/domain/audit"
+	domainNotification "backend/domain/notification"
+	domainPortfolio "backend/domain/portfolio"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var ErrAlreadyCollaborator = errors.New("user is already invited to this post")
+
+type IPostCollaboratorService interface {
+	// Invite は投稿者がユーザー ID かメールアドレスで指定したユーザーを共同制作者に招待します
+	Invite(actor domainAudit.Actor, postID uint, input dto.InviteCollaboratorInput) (*domainPortfolio.Collaborator, error)
+	// GetCollaborators は招待中を含む共同制作者を返します（投稿者と招待されたユーザーのみ）
+	GetCollaborators(userID, postID uint) ([]*domainPortfolio.Collaborator, error)
+	// GetInvitations はログイン中のユーザーへの招待中の依頼を返します
+	GetInvitations(userID uint) ([]*domainPortfolio.Collaborator, error)
+	Accept(actor domainAudit.Actor, postID uint, input dto.ContributionInput) (*domainPortfolio.Collaborator, error)
+	Decline(actor domainAudit.Actor, postID uint) error
+	// UpdateContribution は共同制作者本人が自分の担当と貢献内容を更新します
+	UpdateContribution(actor domainAudit.Actor, postID uint, input dto.ContributionInput) (*domainPortfolio.Collaborator, error)
+	// Remove は投稿者が共同制作者を外すか、共同制作者本人が抜けます
+	Remove(actor domainAudit.Actor, postID, userID uint) error
+}
+
+type PostCollaboratorService struct {
+	portfolioRepository domainPortfolio.Repository
+	userRepository      domainUser.IUserRepository
+	notificationService INotificationService
+	auditService        IAuditService
+}
+
+func NewPostCollaboratorService(
+	portfolioRepository domainPortfolio.Repository,
+	userRepository domainUser.IUserRepository,
+	notificationService INotificationService,
+	auditService IAuditService,
+) IPostCollaboratorService {
+	return &PostCollaboratorService{
+		portfolioRepository: portfolioRepository,
+		userRepository:      userRepository,
+		notificationService: notificationService,
+		auditService:        auditService,
+	}
+}
+
+func (s *PostCollaboratorService) Invite(actor domainAudit.Actor, postID uint, input dto.InviteCollaboratorInput) (*domainPortfolio.Collaborator, error) {
+	post, err := s.ownedPost(actor.UserID, postID)
+	if err != nil {
+		return nil, err
+	}
+	invitee, err := s.findInvitee(input)
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators, err := s.portfolioRepository.GetCollaborators(postID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range collaborators {
+		if c.UserID == invitee.ID {
+			return nil, ErrAlreadyCollaborator
+		}
+	}
+	if len(collaborators) >= domainPortfolio.MaxCollaborators {
+		return nil, fmt.Errorf("a post can have at most %d collaborators", domainPortfolio.MaxCollaborators)
+	}
+
+	collaborator, err := domainPortfolio.NewInvitation(post, invitee.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.portfolioRepository.CreateCollaborator(collaborator); err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionCollaboratorInvited, "post", post.ID,
+		map[string]interface{}{"userId": invitee.ID})
+
+	if err := s.notificationService.Notify(
+		invitee.ID,
+		domainNotification.TypeCollaboratorInvited,
+		fmt.Sprintf("作品「%s」の共同制作者に招待されました", post.Title),
+		"",
+		fmt.Sprintf("/Portfolio/%d", post.ID),
+	); err != nil {
+		log.Printf("Error notifying collaborator invitation: %v", err)
+	}
+	return collaborator, nil
+}
+
+func (s *PostCollaboratorService) GetCollaborators(userID, postID uint) ([]*domainPortfolio.Collaborator, error) {
+	post, err := s.portfolioRepository.GetPostByIDAnyStatus(postID)
+	if err != nil {
+		return nil, err
+	}
+	collaborators, err := s.portfolioRepository.GetCollaborators(postID)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID == userID {
+		return collaborators, nil
+	}
+	for _, c := range collaborators {
+		if c.UserID == userID {
+			return collaborators, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (s *PostCollaboratorService) GetInvitations(userID uint) ([]*domainPortfolio.Collaborator, error) {
+	return s.portfolioRepository.GetInvitations(userID)
+}
+
+func (s *PostCollaboratorService) Accept(actor domainAudit.Actor, postID uint, input dto.ContributionInput) (*domainPortfolio.Collaborator, error) {
+	post, collaborator, err := s.ownCollaboration(actor.UserID, postID)
+	if err != nil {
+		return nil, err
+	}
+	if err := collaborator.Accept(input.Role, input.Contribution, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.portfolioRepository.UpdateCollaborator(collaborator); err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionCollaboratorAccepted, "post", post.ID,
+		map[string]interface{}{"role": collaborator.Role})
+
+	if err := s.notificationService.Notify(
+		post.UserID,
+		domainNotification.TypeCollaboratorAccepted,
+		fmt.Sprintf("%s %s さんが作品「%s」の共同制作者になりました", collaborator.LastName, collaborator.FirstName, post.Title),
+		"",
+		fmt.Sprintf("/Portfolio/%d", post.ID),
+	); err != nil {
+		log.Printf("Error notifying collaborator acceptance: %v", err)
+	}
+	return collaborator, nil
+}
+
+func (s *PostCollaboratorService) Decline(actor domainAudit.Actor, postID uint) error {
+	post, collaborator, err := s.ownCollaboration(actor.UserID, postID)
+	if err != nil {
+		return err
+	}
+	if collaborator.IsAccepted() {
+		return fmt.Errorf("%w: the invitation has already been accepted", domainPortfolio.ErrInvalidTransition)
+	}
+	if err := s.portfolioRepository.DeleteCollaborator(post.ID, actor.UserID); err != nil {
+		return err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionCollaboratorRemoved, "post", post.ID,
+		map[string]interface{}{"userId": actor.UserID, "reason": "declined"})
+	return nil
+}
+
+func (s *PostCollaboratorService) UpdateContribution(actor domainAudit.Actor, postID uint, input dto.ContributionInput) (*domainPortfolio.Collaborator, error) {
+	post, collaborator, err := s.ownCollaboration(actor.UserID, postID)
+	if err != nil {
+		return nil, err
+	}
+	before := map[string]interface{}{"role": collaborator.Role, "contribution": collaborator.Contribution}
+	if err := collaborator.UpdateContribution(input.Role, input.Contribution, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.portfolioRepository.UpdateCollaborator(collaborator); err != nil {
+		return nil, err
+	}
+	after := map[string]interface{}{"role": collaborator.Role, "contribution": collaborator.Contribution}
+	recordAudit(s.auditService, actor, domainAudit.ActionContributionUpdated, "post", post.ID, domainAudit.Diff(before, after))
+	return collaborator, nil
+}
+
+func (s *PostCollaboratorService) Remove(actor domainAudit.Actor, postID, userID uint) error {
+	post, err := s.portfolioRepository.GetPostByIDAnyStatus(postID)
+	if err != nil {
+		return err
+	}
+	if post.UserID != actor.UserID && userID != actor.UserID {
+		return ErrForbidden
+	}
+	if _, err := s.portfolioRepository.GetCollaborator(postID, userID); err != nil {
+		return err
+	}
+	if err := s.portfolioRepository.DeleteCollaborator(postID, userID); err != nil {
+		return err
+	}
+	reason := "removed"
+	if userID == actor.UserID {
+		reason = "left"
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionCollaboratorRemoved, "post", post.ID,
+		map[string]interface{}{"userId": userID, "reason": reason})
+	return nil
+}
+
+// ownedPost は投稿者本人の投稿を返します。共同制作者には ErrForbidden、それ以外には見つからない扱いにする
+func (s *PostCollaboratorService) ownedPost(userID, postID uint) (*domainPortfolio.Post, error) {
+	post, err := s.portfolioRepository.GetPostByIDAnyStatus(postID)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID == userID {
+		return post, nil
+	}
+	if _, err := s.portfolioRepository.GetCollaborator(postID, userID); err == nil {
+		return nil, ErrForbidden
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// ownCollaboration はログイン中のユーザー自身の招待（共同制作者としての登録）を返します
+func (s *PostCollaboratorService) ownCollaboration(userID, postID uint) (*domainPortfolio.Post, *domainPortfolio.Collaborator, error) {
+	post, err := s.portfolioRepository.GetPostByIDAnyStatus(postID)
+	if err != nil {
+		return nil, nil, err
+	}
+	collaborator, err := s.portfolioRepository.GetCollaborator(postID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return post, collaborator, nil
+}
+
+func (s *PostCollaboratorService) findInvitee(input dto.InviteCollaboratorInput) (*domainUser.UserModel, error) {
+	var (
+		user *domainUser.UserModel
+		err  error
+	)
+	switch {
+	case input.UserID != 0 && input.Email != "":
+		return nil, fmt.Errorf("specify either userId or email, not both")
+	case input.UserID != 0:
+		user, err = s.userRepository.FindByID(input.UserID)
+	case input.Email != "":
+		user, err = s.userRepository.FindUserByEmail(input.Email)
+	default:
+		return nil, fmt.Errorf("userId or email is required")
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrUserNotFound
+	}
+	return user, err
+}
@@ -0,0 +1,288 @@
+// services/post_import_service.go
+
+package services
+
+import (
+	"archive/zip"
+	domainAudit "backend/domain/audit"
+	domainMarkdown "backend/domain/markdown"
+	domainPortfolio "backend/domain/portfolio"
+	domainPostImport "backend/domain/postimport"
+	domainTaxonomy "backend/domain/taxonomy"
+	domainUnitOfWork "backend/domain/unitofwork"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"strings"
+)
+
+const (
+	maxImportArchiveSize  = 200 << 20 // 取り込む ZIP の大きさの上限
+	maxImportArchiveFiles = 2000      // ZIP に入れられるファイル数の上限
+	maxImportImageSize    = 8 << 20   // 画像 1 枚の上限（CreatePost と同じ）
+)
+
+// importImageTypes は取り込める画像の種類です。拡張子ではなく中身から判定する
+var importImageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// PostImportResult は一括取り込みの結果です
+type PostImportResult struct {
+	Imported []*domainPortfolio.Post      `json:"imported"`
+	Errors   []domainPostImport.ItemError `json:"errors"`
+}
+
+type IPostImportService interface {
+	// Import は ZIP（manifest.json と画像）から投稿を下書きとして一括で取り込みます
+	// 検証に通った投稿だけを 1 つのトランザクションで保存し、通らなかった投稿は理由を Errors に入れて返す
+	Import(actor domainAudit.Actor, file *multipart.FileHeader) (*PostImportResult, error)
+}
+
+type PostImportService struct {
+	unitOfWork      domainUnitOfWork.UnitOfWork
+	auditService    IAuditService
+	taxonomyService ITaxonomyService
+}
+
+func NewPostImportService(
+	unitOfWork domainUnitOfWork.UnitOfWork,
+	auditService IAuditService,
+	taxonomyService ITaxonomyService,
+) IPostImportService {
+	return &PostImportService{
+		unitOfWork:      unitOfWork,
+		auditService:    auditService,
+		taxonomyService: taxonomyService,
+	}
+}
+
+// importArchive はマニフェストと、マニフェストから参照できる ZIP 内のファイルです
+type importArchive struct {
+	manifest *domainPostImport.Manifest
+	base     string               // マニフェストのあるディレクトリ。画像のパスはここからの相対パス
+	files    map[string]*zip.File // ZIP 内のパス（区切りは "/"）→ ファイル
+}
+
+// importItem は検証に通った投稿と、投稿の画像と同じ順の ZIP 内の画像です
+type importItem struct {
+	post   *domainPortfolio.Post
+	images []*zip.File
+}
+
+func (s *PostImportService) Import(actor domainAudit.Actor, file *multipart.FileHeader) (*PostImportResult, error) {
+	if file.Size > maxImportArchiveSize {
+		return nil, fmt.Errorf("file %s is too large", file.Filename)
+	}
+	src, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %v", err)
+	}
+	defer src.Close()
+
+	archive, err := openImportArchive(src, file)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1) すべての投稿を検証する。画像はまだ保存しない
+	result := &PostImportResult{
+		Imported: []*domainPortfolio.Post{},
+		Errors:   []domainPostImport.ItemError{},
+	}
+	var items []importItem
+	for i, entry := range archive.manifest.Posts {
+		item, err := s.prepare(actor.UserID, entry, archive)
+		if err != nil {
+			result.Errors = append(result.Errors, domainPostImport.ItemError{
+				Index: i,
+				Title: strings.TrimSpace(entry.Title),
+				Error: err.Error(),
+			})
+			continue
+		}
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return result, nil
+	}
+
+	// 2) 画像と検証に通った投稿を 1 つのトランザクションで保存する。保存できなければ保存した画像も消す
+	posts := make([]*domainPortfolio.Post, 0, len(items))
+	err = s.unitOfWork.Do(func(tx domainUnitOfWork.Tx) error {
+		for _, item := range items {
+			for j, f := range item.images {
+				image, err := saveArchiveImage(f)
+				if err != nil {
+					return err
+				}
+				removeOnRollback(tx, image.URL)
+				item.post.Images[j].URL = image.URL
+			}
+			if err := createPost(tx.Posts(), item.post, actor.UserID); err != nil {
+				return err
+			}
+			posts = append(posts, item.post)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range posts {
+		recordAudit(s.auditService, actor, domainAudit.ActionPostCreated, "post", p.ID,
+			map[string]interface{}{"title": p.Title, "status": p.Status, "source": "import"})
+	}
+	result.Imported = posts
+	return result, nil
+}
+
+// prepare はマニフェストの 1 件を下書きの投稿にします。画像は ZIP 内にあり、取り込める種類・大きさであること
+func (s *PostImportService) prepare(userID uint, entry domainPostImport.Entry, archive *importArchive) (importItem, error) {
+	if err := domainMarkdown.Validate(entry.Description); err != nil {
+		return importItem{}, err
+	}
+	skills, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, entry.Skills)
+	if err != nil {
+		return importItem{}, err
+	}
+
+	images := make([]domainPortfolio.Image, len(entry.Images))
+	files := make([]*zip.File, len(entry.Images))
+	for i, img := range entry.Images {
+		name, err := domainPostImport.ResolvePath(archive.base, img.File)
+		if err != nil {
+			return importItem{}, err
+		}
+		f, ok := archive.files[name]
+		if !ok {
+			return importItem{}, fmt.Errorf("image %s is not in the archive", img.File)
+		}
+		if err := checkArchiveImage(f); err != nil {
+			return importItem{}, err
+		}
+		images[i] = domainPortfolio.Image{AltText: img.Alt, Caption: img.Caption, IsCover: img.Cover}
+		files[i] = f
+	}
+
+	details := domainPortfolio.ProjectDetails{
+		RepositoryURL: entry.Links.Repository,
+		DemoURL:       entry.Links.Demo,
+	}
+	post, err := domainPortfolio.NewImportedDraft(
+		entry.Title,
+		entry.Description,
+		entry.Genres,
+		skills,
+		images,
+		details,
+		userID,
+	)
+	if err != nil {
+		return importItem{}, err
+	}
+	return importItem{post: post, images: files}, nil
+}
+
+// openImportArchive はアップロードされた ZIP を開き、マニフェストを読み込みます
+func openImportArchive(src multipart.File, file *multipart.FileHeader) (*importArchive, error) {
+	zr, err := zip.NewReader(src, file.Size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid zip archive: %v", err)
+	}
+	if len(zr.File) > maxImportArchiveFiles {
+		return nil, fmt.Errorf("zip archive has too many files")
+	}
+
+	// マニフェストは一番浅い階層のものを使う（フォルダごと圧縮した ZIP にも対応する）
+	archive := &importArchive{files: make(map[string]*zip.File, len(zr.File))}
+	var manifestFile *zip.File
+	manifestName := ""
+	for _, f := range zr.File {
+		name := path.Clean(strings.ReplaceAll(f.Name, "\\", "/"))
+		if f.FileInfo().IsDir() || strings.HasPrefix(name, "__MACOSX/") {
+			continue
+		}
+		archive.files[name] = f
+		if path.Base(name) == domainPostImport.ManifestName &&
+			(manifestFile == nil || strings.Count(name, "/") < strings.Count(manifestName, "/")) {
+			manifestFile, manifestName = f, name
+		}
+	}
+	if manifestFile == nil {
+		return nil, fmt.Errorf("%s is missing in the zip archive", domainPostImport.ManifestName)
+	}
+	if dir := path.Dir(manifestName); dir != "." {
+		archive.base = dir
+	}
+
+	rc, err := manifestFile.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", domainPostImport.ManifestName, err)
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(io.LimitReader(rc, domainPostImport.MaxManifestSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", domainPostImport.ManifestName, err)
+	}
+	if archive.manifest, err = domainPostImport.ParseManifest(data); err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+// checkArchiveImage は ZIP 内の画像が上限以下の大きさで、取り込める種類かを確認します
+func checkArchiveImage(f *zip.File) error {
+	if f.UncompressedSize64 > maxImportImageSize {
+		return fmt.Errorf("image %s is too large", f.Name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("failed to read image %s: %v", f.Name, err)
+	}
+	defer rc.Close()
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(rc, head)
+	if !importImageTypes[http.DetectContentType(head[:n])] {
+		return fmt.Errorf("%s is not a supported image", f.Name)
+	}
+	return nil
+}
+
+// saveArchiveImage は ZIP 内の画像を投稿の画像と同じ場所に保存します
+// ZIP のヘッダーの大きさは偽れるので、実際に上限を超えて読めた場合もエラーにする
+func saveArchiveImage(f *zip.File) (domainPortfolio.Image, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return domainPortfolio.Image{}, fmt.Errorf("failed to open file: %v", err)
+	}
+	defer rc.Close()
+	return saveImageFile(path.Base(f.Name), &imageSizeLimiter{r: rc, name: f.Name, remaining: maxImportImageSize})
+}
+
+// imageSizeLimiter は remaining バイトを超えて読めた時点でエラーを返す io.Reader です
+// io.LimitReader と違い、超えた分を黙って切り捨てない
+type imageSizeLimiter struct {
+	r         io.Reader
+	name      string
+	remaining int64
+}
+
+func (l *imageSizeLimiter) Read(p []byte) (int, error) {
+	// 上限ちょうどで終わるかを確かめるため、上限より 1 バイトだけ多く読む
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, fmt.Errorf("image %s is too large", l.name)
+	}
+	return n, err
+}
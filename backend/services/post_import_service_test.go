@@ -0,0 +1,220 @@
+// backend/services/post_import_service_test.go
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	domainAudit "backend/domain/audit"
+	domainCareer "backend/domain/career"
+	domainPortfolio "backend/domain/portfolio"
+	domainUnitOfWork "backend/domain/unitofwork"
+	domainUser "backend/domain/user"
+	domainUserSkill "backend/domain/userskill"
+)
+
+// --- フェイク・投稿リポジトリ（CreatePost と CreateRevision だけを実装する） ---
+type fakeImportPostRepo struct {
+	domainPortfolio.Repository
+	created []*domainPortfolio.Post
+	failAt  int // この件数目の CreatePost を失敗させる（0 なら失敗しない）
+}
+
+func (f *fakeImportPostRepo) CreatePost(p *domainPortfolio.Post) error {
+	if f.failAt != 0 && len(f.created)+1 == f.failAt {
+		return errors.New("insert failed")
+	}
+	p.ID = uint(len(f.created) + 1)
+	f.created = append(f.created, p)
+	return nil
+}
+
+func (f *fakeImportPostRepo) CreateRevision(*domainPortfolio.Revision) error { return nil }
+
+// --- フェイク・Unit of Work（失敗したら保存した投稿を捨て、OnRollback を逆順に呼ぶ） ---
+type fakeUnitOfWork struct {
+	posts     *fakeImportPostRepo
+	committed []*domainPortfolio.Post
+}
+
+type fakeTx struct {
+	posts      *fakeImportPostRepo
+	onRollback []func()
+}
+
+func (t *fakeTx) Users() domainUser.IUserRepository      { return nil }
+func (t *fakeTx) Posts() domainPortfolio.Repository      { return t.posts }
+func (t *fakeTx) UserSkills() domainUserSkill.Repository { return nil }
+func (t *fakeTx) Careers() domainCareer.Repository       { return nil }
+func (t *fakeTx) OnRollback(fn func())                   { t.onRollback = append(t.onRollback, fn) }
+
+func (u *fakeUnitOfWork) Do(fn func(tx domainUnitOfWork.Tx) error) error {
+	u.posts.created = nil
+	tx := &fakeTx{posts: u.posts}
+	if err := fn(tx); err != nil {
+		for i := len(tx.onRollback) - 1; i >= 0; i-- {
+			tx.onRollback[i]()
+		}
+		return err
+	}
+	u.committed = append(u.committed, u.posts.created...)
+	return nil
+}
+
+// --- フェイク・監査ログ（Record だけを実装する） ---
+type fakeAuditService struct {
+	IAuditService
+}
+
+func (fakeAuditService) Record(domainAudit.Actor, domainAudit.Action, string, uint, interface{}) error {
+	return nil
+}
+
+// 1x1 の PNG
+var testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01\x00\x00\x00\x01\x08\x06\x00\x00\x00\x1f\x15\xc4\x89\x00\x00\x00\rIDATx\x9cc\xf8\x0f\x00\x00\x01\x01\x00\x05\x18\xd8N\x00\x00\x00\x00IEND\xaeB`\x82")
+
+// newImportArchive は manifest.json と files を入れた ZIP を multipart のファイルとして返します
+func newImportArchive(t *testing.T, posts []map[string]interface{}, files map[string][]byte) *multipart.FileHeader {
+	t.Helper()
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	manifest, _ := json.Marshal(map[string]interface{}{"posts": posts})
+	files["manifest.json"] = manifest
+	for name, data := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("zip create failed: %v", err)
+		}
+		w.Write(data)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("zip close failed: %v", err)
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fw, _ := mw.CreateFormFile("file", "posts.zip")
+	fw.Write(archive.Bytes())
+	mw.Close()
+	req := httptest.NewRequest("POST", "/Portfolio/import", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	if err := req.ParseMultipartForm(32 << 20); err != nil {
+		t.Fatalf("ParseMultipartForm failed: %v", err)
+	}
+	return req.MultipartForm.File["file"][0]
+}
+
+// chdirTemp は画像の保存先 uploads/PortfolioImages を一時ディレクトリに作ります
+func chdirTemp(t *testing.T) {
+	t.Helper()
+	wd, _ := os.Getwd()
+	dir := t.TempDir()
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("chdir failed: %v", err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	if err := os.MkdirAll("uploads/PortfolioImages", os.ModePerm); err != nil {
+		t.Fatalf("mkdir failed: %v", err)
+	}
+}
+
+func savedImages(t *testing.T) []os.DirEntry {
+	t.Helper()
+	entries, err := os.ReadDir("uploads/PortfolioImages")
+	if err != nil {
+		t.Fatalf("ReadDir failed: %v", err)
+	}
+	return entries
+}
+
+func importEntry(title, image string) map[string]interface{} {
+	return map[string]interface{}{
+		"title":  title,
+		"genres": []string{"Web"},
+		"images": []map[string]interface{}{{"file": image, "alt": title}},
+	}
+}
+
+// --- テスト: 検証に通った投稿だけを取り込み、通らなかった投稿は理由を返す ---
+func TestPostImportService_ImportReportsItemErrors(t *testing.T) {
+	chdirTemp(t)
+	uow := &fakeUnitOfWork{posts: &fakeImportPostRepo{}}
+	svc := NewPostImportService(uow, fakeAuditService{}, fakeTaxonomyService{})
+
+	file := newImportArchive(t, []map[string]interface{}{
+		importEntry("有効な投稿", "images/top.png"),
+		importEntry("外を指す画像", "../secret.png"),
+		importEntry("", "images/top.png"),
+	}, map[string][]byte{"images/top.png": testPNG})
+
+	result, err := svc.Import(domainAudit.Actor{UserID: 1}, file)
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if len(result.Imported) != 1 || result.Imported[0].Title != "有効な投稿" {
+		t.Fatalf("unexpected imported posts: %+v", result.Imported)
+	}
+	post := result.Imported[0]
+	if post.Status != domainPortfolio.StatusDraft || post.UserID != 1 {
+		t.Errorf("imported post should be a draft of the actor, got status=%s user=%d", post.Status, post.UserID)
+	}
+	if len(post.Images) != 1 || !strings.HasPrefix(post.Images[0].URL, "uploads/PortfolioImages/") {
+		t.Errorf("image should be saved under uploads, got %+v", post.Images)
+	}
+	if len(uow.committed) != 1 || len(savedImages(t)) != 1 {
+		t.Errorf("expected 1 committed post and 1 saved image, got %d and %d", len(uow.committed), len(savedImages(t)))
+	}
+
+	if len(result.Errors) != 2 {
+		t.Fatalf("expected 2 item errors, got %+v", result.Errors)
+	}
+	if result.Errors[0].Index != 1 || result.Errors[0].Title != "外を指す画像" {
+		t.Errorf("image path outside the archive should be rejected, got %+v", result.Errors[0])
+	}
+	if result.Errors[1].Index != 2 {
+		t.Errorf("post without a title should be rejected, got %+v", result.Errors[1])
+	}
+}
+
+// --- テスト: 保存に失敗したら 1 件も取り込まず、保存した画像も消す ---
+func TestPostImportService_ImportIsAllOrNothing(t *testing.T) {
+	chdirTemp(t)
+	uow := &fakeUnitOfWork{posts: &fakeImportPostRepo{failAt: 2}}
+	svc := NewPostImportService(uow, fakeAuditService{}, fakeTaxonomyService{})
+
+	file := newImportArchive(t, []map[string]interface{}{
+		importEntry("1 件目", "a.png"),
+		importEntry("2 件目", "b.png"),
+	}, map[string][]byte{"a.png": testPNG, "b.png": testPNG})
+
+	if _, err := svc.Import(domainAudit.Actor{UserID: 1}, file); err == nil {
+		t.Fatal("expected Import to fail")
+	}
+	if len(uow.committed) != 0 {
+		t.Errorf("no post should be committed, got %d", len(uow.committed))
+	}
+	if images := savedImages(t); len(images) != 0 {
+		t.Errorf("saved images should be removed on rollback, got %d", len(images))
+	}
+}
+
+// --- テスト: 画像が上限を超えたら切り捨てずにエラーにする ---
+func TestImageSizeLimiter(t *testing.T) {
+	exact := &imageSizeLimiter{r: bytes.NewReader(make([]byte, 16)), name: "a.png", remaining: 16}
+	if data, err := io.ReadAll(exact); err != nil || len(data) != 16 {
+		t.Errorf("image at the limit should be read fully, got %d bytes, err=%v", len(data), err)
+	}
+
+	over := &imageSizeLimiter{r: bytes.NewReader(make([]byte, 17)), name: "a.png", remaining: 16}
+	if _, err := io.ReadAll(over); err == nil || !strings.Contains(err.Error(), "too large") {
+		t.Errorf("expected too large error, got %v", err)
+	}
+}
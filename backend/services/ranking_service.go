@@ -0,0 +1,73 @@
+// services/ranking_service.go
+
+package services
+
+import (
+	domainPortfolio "backend/domain/portfolio"
+	domainRanking "backend/domain/ranking"
+	"time"
+)
+
+type IRankingService interface {
+	// Recompute は直近の反応から公開中の投稿の人気度を計算し直し、人気度の付いた投稿の数を返します
+	Recompute() (int, error)
+}
+
+type RankingService struct {
+	repository          domainRanking.Repository
+	portfolioRepository domainPortfolio.Repository
+	config              domainRanking.Config
+	now                 func() time.Time
+}
+
+// NewRankingService は人気度のサービスを生成します。now は現在時刻の取得で、テストでは固定の時刻を渡す
+func NewRankingService(
+	repository domainRanking.Repository,
+	portfolioRepository domainPortfolio.Repository,
+	now func() time.Time,
+) IRankingService {
+	if now == nil {
+		now = time.Now
+	}
+	return &RankingService{
+		repository:          repository,
+		portfolioRepository: portfolioRepository,
+		config:              domainRanking.DefaultConfig,
+		now:                 now,
+	}
+}
+
+func (s *RankingService) Recompute() (int, error) {
+	now := s.now()
+	events, err := s.repository.GetEvents(now.Add(-s.config.Window))
+	if err != nil {
+		return 0, err
+	}
+
+	seen := make(map[uint]bool)
+	var ids []uint
+	for _, e := range events {
+		if !seen[e.PostID] {
+			seen[e.PostID] = true
+			ids = append(ids, e.PostID)
+		}
+	}
+	posts, err := s.portfolioRepository.GetPostsByIDs(ids)
+	if err != nil {
+		return 0, err
+	}
+	// 投稿者と、承諾済みの共同制作者の反応は自作自演として数えない
+	authors := make(map[uint][]uint, len(posts))
+	for _, p := range posts {
+		if !p.IsPublished() {
+			continue
+		}
+		authors[p.ID] = p.AuthorIDs()
+	}
+
+	scores := domainRanking.Compute(events, authors, now, s.config)
+	if err := s.repository.ReplaceScores(scores, now); err != nil {
+		return 0, err
+	}
+	return len(scores), nil
+}
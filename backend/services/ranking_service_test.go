@@ -0,0 +1,89 @@
+// backend/services/ranking_service_test.go
+package services
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	domainPortfolio "backend/domain/portfolio"
+	domainRanking "backend/domain/ranking"
+)
+
+// --- フェイク・人気度リポジトリ ---
+type fakeRankingRepo struct {
+	events []domainRanking.Event
+	since  time.Time
+	scores []domainRanking.Score
+}
+
+func (f *fakeRankingRepo) GetEvents(since time.Time) ([]domainRanking.Event, error) {
+	f.since = since
+	var out []domainRanking.Event
+	for _, e := range f.events {
+		if !e.At.Before(since) {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeRankingRepo) ReplaceScores(scores []domainRanking.Score, _ time.Time) error {
+	f.scores = scores
+	return nil
+}
+
+// --- フェイク・投稿リポジトリ（GetPostsByIDs だけを実装する） ---
+type fakeRankingPostRepo struct {
+	domainPortfolio.Repository
+	posts []*domainPortfolio.Post
+}
+
+func (f *fakeRankingPostRepo) GetPostsByIDs(ids []uint) ([]*domainPortfolio.Post, error) {
+	var out []*domainPortfolio.Post
+	for _, p := range f.posts {
+		for _, id := range ids {
+			if p.ID == id {
+				out = append(out, p)
+			}
+		}
+	}
+	return out, nil
+}
+
+func TestRankingService_Recompute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	posts := &fakeRankingPostRepo{posts: []*domainPortfolio.Post{
+		{ID: 1, UserID: 100, Status: domainPortfolio.StatusPublished},
+		{ID: 2, UserID: 200, Status: domainPortfolio.StatusPublished,
+			Collaborators: []domainPortfolio.Collaborator{{UserID: 201, Status: domainPortfolio.CollaboratorAccepted}}},
+		{ID: 3, UserID: 300, Status: domainPortfolio.StatusDraft},
+	}}
+	repo := &fakeRankingRepo{events: []domainRanking.Event{
+		{PostID: 1, UserID: 10, Signal: domainRanking.SignalBookmark, At: now.Add(-72 * time.Hour)},
+		{PostID: 1, UserID: 11, Signal: domainRanking.SignalLike, At: now.Add(-24 * time.Hour)},
+		{PostID: 2, UserID: 10, Signal: domainRanking.SignalLike, At: now.Add(-time.Hour)},
+		// 共同制作者のブックマーク・下書きへの反応は数えない
+		{PostID: 2, UserID: 201, Signal: domainRanking.SignalBookmark, At: now},
+		{PostID: 3, UserID: 10, Signal: domainRanking.SignalLike, At: now},
+		// 集計期間より前の反応は読み込まれない
+		{PostID: 1, UserID: 12, Signal: domainRanking.SignalBookmark, At: now.Add(-15 * 24 * time.Hour)},
+	}}
+
+	svc := NewRankingService(repo, posts, func() time.Time { return now })
+	n, err := svc.Recompute()
+	if err != nil {
+		t.Fatalf("Recompute failed: %v", err)
+	}
+	if !repo.since.Equal(now.Add(-domainRanking.DefaultConfig.Window)) {
+		t.Errorf("events read since %v, want the window before the fixed clock", repo.since)
+	}
+	// 新しいいいね 1 件の方が、古いブックマークといいねより上に来る
+	if n != 2 || repo.scores[0].PostID != 2 || repo.scores[1].PostID != 1 {
+		t.Fatalf("scores = %+v, want posts 2 then 1", repo.scores)
+	}
+	want := 4 * math.Pow(0.5, 1.0/36)
+	if math.Abs(repo.scores[0].Score-want) > 1e-9 {
+		t.Errorf("post 2 score = %v, want %v (collaborator bookmark counted?)", repo.scores[0].Score, want)
+	}
+}
@@ -0,0 +1,180 @@
+// services/realtime_service.go
+
+package services
+
+import (
+	domainRealtime "backend/domain/realtime"
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type IRealtimeService interface {
+	Start() error
+	Publish(userID uint, eventType domainRealtime.EventType, payload interface{}) error
+	Subscribe(userID uint, lastEventID uint) (*Subscription, error)
+	Unsubscribe(sub *Subscription)
+	PurgeOldEvents() error
+}
+
+var ErrTooManyConnections = errors.New("too many realtime connections")
+
+const (
+	defaultMaxConnectionsPerUser = 5
+	subscriptionBufferSize       = 32
+	replayPageSize               = 200
+	maxReplayEvents              = 1000
+)
+
+// Subscription は 1 本の SSE 接続に対応する購読です
+// Backlog は Last-Event-ID 以降の取りこぼし分、Events は以降のライブ配信です
+// 取りこぼしが maxReplayEvents を超えた場合は Backlog を空にして Reset を立てます
+type Subscription struct {
+	UserID  uint
+	Backlog []*domainRealtime.Event
+	Reset   bool
+	Events  chan *domainRealtime.Event
+
+	closeOnce sync.Once
+}
+
+func (s *Subscription) close() {
+	s.closeOnce.Do(func() { close(s.Events) })
+}
+
+type RealtimeService struct {
+	repository domainRealtime.IEventRepository
+	broker     domainRealtime.Broker
+
+	maxConnectionsPerUser int
+
+	mu            sync.Mutex
+	subscriptions map[uint]map[*Subscription]struct{}
+}
+
+func NewRealtimeService(repository domainRealtime.IEventRepository, broker domainRealtime.Broker) IRealtimeService {
+	maxConns := defaultMaxConnectionsPerUser
+	if v, err := strconv.Atoi(os.Getenv("REALTIME_MAX_CONNECTIONS_PER_USER")); err == nil && v > 0 {
+		maxConns = v
+	}
+	return &RealtimeService{
+		repository:            repository,
+		broker:                broker,
+		maxConnectionsPerUser: maxConns,
+		subscriptions:         make(map[uint]map[*Subscription]struct{}),
+	}
+}
+
+// Start は Broker からの配信をこのプロセスの購読者へ流し始めます
+func (s *RealtimeService) Start() error {
+	return s.broker.Listen(s.dispatch)
+}
+
+// Publish はイベントを永続化してから Broker 経由で全レプリカに配信します
+func (s *RealtimeService) Publish(userID uint, eventType domainRealtime.EventType, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	event, err := domainRealtime.NewEvent(userID, eventType, string(data))
+	if err != nil {
+		return err
+	}
+	if err := s.repository.CreateEvent(event); err != nil {
+		return err
+	}
+	return s.broker.Publish(event)
+}
+
+func (s *RealtimeService) Subscribe(userID uint, lastEventID uint) (*Subscription, error) {
+	sub := &Subscription{
+		UserID: userID,
+		Events: make(chan *domainRealtime.Event, subscriptionBufferSize),
+	}
+
+	s.mu.Lock()
+	subs := s.subscriptions[userID]
+	if len(subs) >= s.maxConnectionsPerUser {
+		s.mu.Unlock()
+		return nil, ErrTooManyConnections
+	}
+	if subs == nil {
+		subs = make(map[*Subscription]struct{})
+		s.subscriptions[userID] = subs
+	}
+	subs[sub] = struct{}{}
+	s.mu.Unlock()
+
+	// 先に購読を登録してから取りこぼし分を読むので、重複はあっても欠落はしない
+	// 重複は呼び出し側が ID で読み飛ばす
+	if lastEventID > 0 {
+		backlog, reset, err := s.loadBacklog(userID, lastEventID)
+		if err != nil {
+			s.Unsubscribe(sub)
+			return nil, err
+		}
+		sub.Backlog, sub.Reset = backlog, reset
+	}
+	return sub, nil
+}
+
+// loadBacklog は afterID 以降のイベントを尽きるまでページングして読みます
+// 上限を超えた場合は途中までを返さず reset=true を返し、クライアントに再取得させます
+func (s *RealtimeService) loadBacklog(userID uint, afterID uint) ([]*domainRealtime.Event, bool, error) {
+	var backlog []*domainRealtime.Event
+	for {
+		page, err := s.repository.FindEventsAfter(userID, afterID, replayPageSize)
+		if err != nil {
+			return nil, false, err
+		}
+		backlog = append(backlog, page...)
+		if len(backlog) > maxReplayEvents {
+			return nil, true, nil
+		}
+		if len(page) < replayPageSize {
+			return backlog, false, nil
+		}
+		afterID = page[len(page)-1].ID
+	}
+}
+
+func (s *RealtimeService) Unsubscribe(sub *Subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if subs, ok := s.subscriptions[sub.UserID]; ok {
+		delete(subs, sub)
+		if len(subs) == 0 {
+			delete(s.subscriptions, sub.UserID)
+		}
+	}
+	sub.close()
+}
+
+// dispatch は受信したイベントを宛先ユーザーの全接続に配ります
+func (s *RealtimeService) dispatch(e *domainRealtime.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subscriptions[e.UserID] {
+		select {
+		case sub.Events <- e:
+		default:
+			// 受信が追いつかない接続は切断し、Last-Event-ID での再接続に任せる
+			log.Printf("realtime: dropping slow subscriber for user %d", e.UserID)
+			delete(s.subscriptions[e.UserID], sub)
+			sub.close()
+		}
+	}
+	if len(s.subscriptions[e.UserID]) == 0 {
+		delete(s.subscriptions, e.UserID)
+	}
+}
+
+// 再送用に保持したイベントを削除するメソッド
+func (s *RealtimeService) PurgeOldEvents() error {
+	cutoffTime := time.Now().UTC().Add(-72 * time.Hour) // 再接続で再送する範囲は3日間
+	return s.repository.DeleteEventsBefore(cutoffTime)
+}
@@ -0,0 +1,161 @@
+// backend/services/realtime_service_test.go
+package services
+
+import (
+	"testing"
+	"time"
+
+	domainRealtime "backend/domain/realtime"
+)
+
+// --- フェイク・イベントリポジトリ ---
+type fakeEventRepo struct {
+	events []*domainRealtime.Event
+}
+
+func (f *fakeEventRepo) CreateEvent(e *domainRealtime.Event) error {
+	e.ID = uint(len(f.events) + 1)
+	f.events = append(f.events, e)
+	return nil
+}
+
+func (f *fakeEventRepo) FindEventByID(id uint) (*domainRealtime.Event, error) {
+	return f.events[id-1], nil
+}
+
+func (f *fakeEventRepo) FindEventsAfter(userID uint, afterID uint, limit int) ([]*domainRealtime.Event, error) {
+	var out []*domainRealtime.Event
+	for _, e := range f.events {
+		if e.UserID == userID && e.ID > afterID && len(out) < limit {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeEventRepo) DeleteEventsBefore(time.Time) error { return nil }
+
+// --- フェイク・ブローカー（同期的にハンドラを呼ぶ） ---
+type fakeBroker struct {
+	handler func(e *domainRealtime.Event)
+}
+
+func (b *fakeBroker) Publish(e *domainRealtime.Event) error {
+	if b.handler != nil {
+		b.handler(e)
+	}
+	return nil
+}
+func (b *fakeBroker) Listen(h func(e *domainRealtime.Event)) error { b.handler = h; return nil }
+func (b *fakeBroker) Close() error                                 { return nil }
+
+func newTestRealtimeService(t *testing.T) (IRealtimeService, *fakeEventRepo) {
+	t.Helper()
+	t.Setenv("REALTIME_MAX_CONNECTIONS_PER_USER", "2")
+	repo := &fakeEventRepo{}
+	svc := NewRealtimeService(repo, &fakeBroker{})
+	if err := svc.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	return svc, repo
+}
+
+// --- テスト: 購読中のユーザーにだけ配信される ---
+func TestRealtimeService_PublishDeliversToSubscriber(t *testing.T) {
+	svc, _ := newTestRealtimeService(t)
+
+	sub, err := svc.Subscribe(1, 0)
+	if err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+	other, _ := svc.Subscribe(2, 0)
+
+	if err := svc.Publish(1, domainRealtime.EventComment, map[string]uint{"postId": 10}); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+
+	select {
+	case e := <-sub.Events:
+		if e.Type != domainRealtime.EventComment || e.Data != `{"postId":10}` {
+			t.Errorf("unexpected event: %+v", e)
+		}
+	default:
+		t.Fatal("expected event to be delivered")
+	}
+	if len(other.Events) != 0 {
+		t.Error("event should not be delivered to other users")
+	}
+}
+
+// --- テスト: Last-Event-ID 以降のイベントが再送される ---
+func TestRealtimeService_SubscribeReplaysAfterLastEventID(t *testing.T) {
+	svc, _ := newTestRealtimeService(t)
+
+	for i := 0; i < 3; i++ {
+		_ = svc.Publish(1, domainRealtime.EventNotification, nil)
+	}
+	_ = svc.Publish(2, domainRealtime.EventNotification, nil)
+
+	sub, err := svc.Subscribe(1, 1)
+	if err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+	if len(sub.Backlog) != 2 || sub.Backlog[0].ID != 2 || sub.Backlog[1].ID != 3 {
+		t.Errorf("unexpected backlog: %+v", sub.Backlog)
+	}
+}
+
+// --- テスト: 1 ページを超える取りこぼしもすべて再送される ---
+func TestRealtimeService_SubscribeReplaysAllPages(t *testing.T) {
+	svc, _ := newTestRealtimeService(t)
+
+	for i := 0; i < replayPageSize*2+1; i++ {
+		_ = svc.Publish(1, domainRealtime.EventNotification, nil)
+	}
+
+	sub, err := svc.Subscribe(1, 1)
+	if err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+	if len(sub.Backlog) != replayPageSize*2 || sub.Reset {
+		t.Fatalf("expected %d events without reset, got %d (reset=%v)", replayPageSize*2, len(sub.Backlog), sub.Reset)
+	}
+	if last := sub.Backlog[len(sub.Backlog)-1]; last.ID != uint(replayPageSize*2+1) {
+		t.Errorf("unexpected last event ID: %d", last.ID)
+	}
+}
+
+// --- テスト: 取りこぼしが上限を超えたら再送せず Reset を立てる ---
+func TestRealtimeService_SubscribeResetsWhenTooFarBehind(t *testing.T) {
+	svc, _ := newTestRealtimeService(t)
+
+	for i := 0; i < maxReplayEvents+2; i++ {
+		_ = svc.Publish(1, domainRealtime.EventNotification, nil)
+	}
+
+	sub, err := svc.Subscribe(1, 1)
+	if err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+	if !sub.Reset || len(sub.Backlog) != 0 {
+		t.Errorf("expected reset with empty backlog, got reset=%v backlog=%d", sub.Reset, len(sub.Backlog))
+	}
+}
+
+// --- テスト: ユーザーごとの同時接続数の上限 ---
+func TestRealtimeService_ConnectionLimit(t *testing.T) {
+	svc, _ := newTestRealtimeService(t)
+
+	first, _ := svc.Subscribe(1, 0)
+	if _, err := svc.Subscribe(1, 0); err != nil {
+		t.Fatalf("second Subscribe failed: %v", err)
+	}
+	if _, err := svc.Subscribe(1, 0); err != ErrTooManyConnections {
+		t.Fatalf("expected ErrTooManyConnections, got %v", err)
+	}
+
+	svc.Unsubscribe(first)
+	if _, err := svc.Subscribe(1, 0); err != nil {
+		t.Errorf("Subscribe after Unsubscribe failed: %v", err)
+	}
+}
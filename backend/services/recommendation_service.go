@@ -0,0 +1,126 @@
+// services/recommendation_service.go
+
+package services
+
+import (
+	domainPortfolio "backend/domain/portfolio"
+	domainRecommendation "backend/domain/recommendation"
+)
+
+const (
+	maxSimilarPosts     = 10 // 投稿詳細に表示する似ている投稿の数
+	maxRecommendedPosts = 20 // あなたへのおすすめに表示する投稿の数
+)
+
+type IRecommendationService interface {
+	// Recompute は公開中の投稿の「似ている投稿」と、全ユーザーの「あなたへのおすすめ」を計算し直し、対象にした投稿の数を返します
+	Recompute() (int, error)
+	// GetSimilar は公開中の投稿 postID に似ている公開中の投稿を返します
+	GetSimilar(postID uint) ([]*domainPortfolio.Post, error)
+	// GetRecommended は userID のユーザーへのおすすめの投稿を返します
+	// まだ計算されていないユーザー（登録直後など）には、本人の投稿を除いたトレンドの投稿を返す
+	GetRecommended(userID uint) ([]*domainPortfolio.Post, error)
+}
+
+type RecommendationService struct {
+	repository          domainRecommendation.Repository
+	portfolioRepository domainPortfolio.Repository
+	config              domainRecommendation.Config
+}
+
+func NewRecommendationService(repository domainRecommendation.Repository, portfolioRepository domainPortfolio.Repository) IRecommendationService {
+	return &RecommendationService{
+		repository:          repository,
+		portfolioRepository: portfolioRepository,
+		config:              domainRecommendation.DefaultConfig,
+	}
+}
+
+func (s *RecommendationService) Recompute() (int, error) {
+	posts, err := s.portfolioRepository.GetAllPosts()
+	if err != nil {
+		return 0, err
+	}
+	items := make([]domainRecommendation.Item, 0, len(posts))
+	for _, p := range posts {
+		items = append(items, domainRecommendation.Item{
+			PostID:    p.ID,
+			AuthorIDs: p.AuthorIDs(),
+			Genres:    p.Genres,
+			Skills:    p.Skills,
+			Text:      p.Title + "\n" + p.Description,
+		})
+	}
+	index := domainRecommendation.NewIndex(items)
+
+	interactions, err := s.repository.GetInteractions()
+	if err != nil {
+		return 0, err
+	}
+	similarities := index.Similar(interactions, s.config)
+	if err := s.repository.ReplaceSimilarities(similarities); err != nil {
+		return 0, err
+	}
+
+	profiles, err := s.repository.GetProfiles()
+	if err != nil {
+		return 0, err
+	}
+	history := make(map[uint][]uint)
+	for _, in := range interactions {
+		history[in.UserID] = append(history[in.UserID], in.PostID)
+	}
+	var recommendations []domainRecommendation.Recommendation
+	for _, profile := range profiles {
+		profile.Interactions = history[profile.UserID]
+		recommendations = append(recommendations, index.Recommend(profile, similarities, s.config)...)
+	}
+	if err := s.repository.ReplaceRecommendations(recommendations); err != nil {
+		return 0, err
+	}
+	return len(items), nil
+}
+
+func (s *RecommendationService) GetSimilar(postID uint) ([]*domainPortfolio.Post, error) {
+	if _, err := s.portfolioRepository.GetPostByID(postID); err != nil {
+		return nil, err
+	}
+	ids, err := s.repository.GetSimilarPostIDs(postID, maxSimilarPosts)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := s.portfolioRepository.GetPostsByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	return publishedInOrder(ids, posts), nil
+}
+
+func (s *RecommendationService) GetRecommended(userID uint) ([]*domainPortfolio.Post, error) {
+	ids, err := s.repository.GetRecommendedPostIDs(userID, maxRecommendedPosts)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 {
+		posts, err := s.portfolioRepository.GetPostsByIDs(ids)
+		if err != nil {
+			return nil, err
+		}
+		return publishedInOrder(ids, posts), nil
+	}
+
+	trending, err := s.portfolioRepository.SearchPosts(domainPortfolio.SearchCriteria{Sort: domainPortfolio.SortTrending})
+	if err != nil {
+		return nil, err
+	}
+	posts := make([]*domainPortfolio.Post, 0, maxRecommendedPosts)
+	for _, p := range trending {
+		if len(posts) == maxRecommendedPosts {
+			break
+		}
+		if !p.IsAuthor(userID) {
+			posts = append(posts, p)
+		}
+	}
+	return posts, nil
+}
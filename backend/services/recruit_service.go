@@ -0,0 +1,116 @@
+// services/recruit_service.go
+
+package services
+
+import (
+	domainJob "backend/domain/job"
+	domainOrganization "backend/domain/organization"
+	domainRecruit "backend/domain/recruit"
+	domainTaxonomy "backend/domain/taxonomy"
+	"backend/dto"
+	"errors"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+// 1 回の検索で返す候補者の上限。関連度順に並べてから切り詰める
+const maxCandidateResults = 1000
+
+// CandidateSearchResult は候補者検索の結果です
+// Total は条件に合った人数で、上限を超えた場合は Truncated が立ち Candidates は上位だけになる
+type CandidateSearchResult struct {
+	Candidates []*domainRecruit.Candidate
+	Total      int
+	Limit      int
+	Truncated  bool
+}
+
+type IRecruitService interface {
+	// 関連度順に並べた候補者を上限まで返す（ページングは呼び出し側で行う）
+	SearchCandidates(userID uint, input dto.CandidateSearchInput) (*CandidateSearchResult, error)
+}
+
+type RecruitService struct {
+	candidateRepository    domainRecruit.Repository
+	jobRepository          domainJob.Repository
+	organizationRepository domainOrganization.Repository
+	taxonomyService        ITaxonomyService
+}
+
+func NewRecruitService(
+	candidateRepository domainRecruit.Repository,
+	jobRepository domainJob.Repository,
+	organizationRepository domainOrganization.Repository,
+	taxonomyService ITaxonomyService,
+) IRecruitService {
+	return &RecruitService{
+		candidateRepository:    candidateRepository,
+		jobRepository:          jobRepository,
+		organizationRepository: organizationRepository,
+		taxonomyService:        taxonomyService,
+	}
+}
+
+// SearchCandidates は条件に合う候補者を検索し、関連度の高い順に並べます
+// jobId を指定した場合はその求人の必須スキル、指定しない場合は検索条件のスキルで関連度を計算します
+func (s *RecruitService) SearchCandidates(userID uint, input dto.CandidateSearchInput) (*CandidateSearchResult, error) {
+	// 表記ゆれのある検索条件も登録済みのスキル名で探す
+	skills, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, input.Skills)
+	if err != nil {
+		return nil, err
+	}
+	postSkills, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, input.PostSkills)
+	if err != nil {
+		return nil, err
+	}
+
+	requiredSkills := append(append([]string{}, skills...), postSkills...)
+	if input.JobID != 0 {
+		posting, err := s.jobRepository.GetJobPostingByID(input.JobID)
+		if err != nil {
+			return nil, err
+		}
+		// 他社の求人を基準に検索することはできない
+		if _, err := s.organizationRepository.FindMember(posting.OrganizationID, userID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, ErrForbidden
+			}
+			return nil, err
+		}
+		requiredSkills = posting.Skills
+	}
+
+	candidates, err := s.candidateRepository.SearchCandidates(domainRecruit.SearchCriteria{
+		DesiredJobTypes: input.DesiredJobTypes,
+		Skills:          skills,
+		GraduationYear:  input.GraduationYear,
+		SchoolName:      input.SchoolName,
+		PostGenres:      input.PostGenres,
+		PostSkills:      postSkills,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range candidates {
+		c.CalculateScore(requiredSkills)
+	}
+	// 関連度が同じ場合は作品数の多い順、さらに同じなら登録の古い順
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		if candidates[i].PostCount != candidates[j].PostCount {
+			return candidates[i].PostCount > candidates[j].PostCount
+		}
+		return candidates[i].User.ID < candidates[j].User.ID
+	})
+
+	result := &CandidateSearchResult{Candidates: candidates, Total: len(candidates), Limit: maxCandidateResults}
+	if len(candidates) > maxCandidateResults {
+		result.Candidates = candidates[:maxCandidateResults]
+		result.Truncated = true
+	}
+	return result, nil
+}
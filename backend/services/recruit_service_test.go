@@ -0,0 +1,52 @@
+// backend/services/recruit_service_test.go
+package services
+
+import (
+	"testing"
+
+	domainRecruit "backend/domain/recruit"
+	domainTaxonomy "backend/domain/taxonomy"
+	domainUser "backend/domain/user"
+	"backend/dto"
+)
+
+// --- フェイク・候補者リポジトリ ---
+type fakeCandidateRepo struct {
+	candidates []*domainRecruit.Candidate
+}
+
+func (f *fakeCandidateRepo) SearchCandidates(domainRecruit.SearchCriteria) ([]*domainRecruit.Candidate, error) {
+	return f.candidates, nil
+}
+
+// --- フェイク・選択肢サービス（Normalize は入力をそのまま返す） ---
+type fakeTaxonomyService struct {
+	ITaxonomyService
+}
+
+func (fakeTaxonomyService) Normalize(_ domainTaxonomy.Kind, values []string) ([]string, error) {
+	return values, nil
+}
+
+// --- テスト: 上限を超えても関連度の高い候補者が残り、切り詰めたことが分かる ---
+func TestRecruitService_SearchCandidatesTruncatesAfterSorting(t *testing.T) {
+	repo := &fakeCandidateRepo{}
+	// ID の小さい順に並ぶリポジトリの結果の末尾にだけ、スキルが一致する候補者を置く
+	for i := 1; i <= maxCandidateResults+10; i++ {
+		repo.candidates = append(repo.candidates, &domainRecruit.Candidate{User: domainUser.UserModel{ID: uint(i)}})
+	}
+	best := &domainRecruit.Candidate{User: domainUser.UserModel{ID: uint(maxCandidateResults + 11)}, PostSkills: []string{"Go"}}
+	repo.candidates = append(repo.candidates, best)
+
+	svc := NewRecruitService(repo, nil, nil, fakeTaxonomyService{})
+	result, err := svc.SearchCandidates(1, dto.CandidateSearchInput{Skills: []string{"Go"}})
+	if err != nil {
+		t.Fatalf("SearchCandidates failed: %v", err)
+	}
+	if !result.Truncated || result.Total != maxCandidateResults+11 || len(result.Candidates) != maxCandidateResults {
+		t.Fatalf("unexpected result: truncated=%v total=%d len=%d", result.Truncated, result.Total, len(result.Candidates))
+	}
+	if result.Candidates[0] != best {
+		t.Errorf("best match should be first, got user %d", result.Candidates[0].User.ID)
+	}
+}
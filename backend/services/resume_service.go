@@ -0,0 +1,122 @@
+// services/resume_service.go
+
+package services
+
+import (
+	domainPortfolio "backend/domain/portfolio"
+	domainResume "backend/domain/resume"
+	domainUser "backend/domain/user"
+	domainUserSkill "backend/domain/userskill"
+	"backend/dto"
+	"bytes"
+	"sort"
+	"time"
+)
+
+type IResumeService interface {
+	// RenderPDF は本人のプロフィールと作品から履歴書の PDF を作ります
+	RenderPDF(userID uint, input dto.ResumeQuery) ([]byte, error)
+	// ExportJSONResume は本人のプロフィールと作品を JSON Resume 形式で返します
+	ExportJSONResume(userID uint, input dto.ResumeQuery) (*domainResume.JSONResume, error)
+}
+
+type ResumeService struct {
+	userRepository      domainUser.IUserRepository
+	userSkillRepository domainUserSkill.Repository
+	careerService       ICareerService
+	externalLinkService IExternalLinkService
+	portfolioRepository domainPortfolio.Repository
+	renderer            domainResume.Renderer
+	siteURL             string
+}
+
+func NewResumeService(
+	userRepository domainUser.IUserRepository,
+	userSkillRepository domainUserSkill.Repository,
+	careerService ICareerService,
+	externalLinkService IExternalLinkService,
+	portfolioRepository domainPortfolio.Repository,
+	renderer domainResume.Renderer,
+	siteURL string,
+) IResumeService {
+	return &ResumeService{
+		userRepository:      userRepository,
+		userSkillRepository: userSkillRepository,
+		careerService:       careerService,
+		externalLinkService: externalLinkService,
+		portfolioRepository: portfolioRepository,
+		renderer:            renderer,
+		siteURL:             siteURL,
+	}
+}
+
+func (s *ResumeService) RenderPDF(userID uint, input dto.ResumeQuery) ([]byte, error) {
+	template, err := domainResume.ParseTemplate(input.Template)
+	if err != nil {
+		return nil, err
+	}
+	resume, err := s.build(userID, input.PostIDs)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := s.renderer.Render(&buf, resume, template); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *ResumeService) ExportJSONResume(userID uint, input dto.ResumeQuery) (*domainResume.JSONResume, error) {
+	resume, err := s.build(userID, input.PostIDs)
+	if err != nil {
+		return nil, err
+	}
+	out := resume.ToJSONResume(s.siteURL)
+	return &out, nil
+}
+
+// build は履歴書に載せる内容を集めます。作品は本人の公開中の作品（共同制作を含む）から選ぶ
+func (s *ResumeService) build(userID uint, postIDs []uint) (*domainResume.Resume, error) {
+	user, err := s.userRepository.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	skills, err := s.userSkillRepository.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	educations, err := s.careerService.GetEducations(userID)
+	if err != nil {
+		return nil, err
+	}
+	experiences, err := s.careerService.GetExperiences(userID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.externalLinkService.GetLinks(userID)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := s.portfolioRepository.GetPostsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	// 指定がなければ新しく公開した作品から載せる
+	sort.SliceStable(posts, func(i, j int) bool {
+		a, b := posts[i].PublishedAt, posts[j].PublishedAt
+		return a != nil && (b == nil || a.After(*b))
+	})
+	selected, err := domainResume.SelectPosts(posts, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &domainResume.Resume{
+		User:        user,
+		Skills:      skills,
+		Educations:  educations,
+		Experiences: experiences,
+		Links:       links,
+		Posts:       selected,
+		GeneratedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,290 @@
+// services/saved_search_service.go
+
+package services
+
+import (
+	domainPortfolio "backend/domain/portfolio"
+	domainRecruit "backend/domain/recruit"
+	domainSavedSearch "backend/domain/savedsearch"
+	domainTaxonomy "backend/domain/taxonomy"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+type ISavedSearchService interface {
+	CreateSavedSearch(user *domainUser.UserModel, input dto.CreateSavedSearchInput) (*domainSavedSearch.SavedSearch, error)
+	GetSavedSearches(userID uint) ([]*domainSavedSearch.SavedSearch, error)
+	UpdateSavedSearch(userID uint, id uint, input dto.UpdateSavedSearchInput) (*domainSavedSearch.SavedSearch, error)
+	DeleteSavedSearch(userID uint, id uint) error
+	// メール内のリンクから呼ばれるため、ログインなしでトークンだけで配信を停止する
+	Unsubscribe(token string) error
+	// 通知時期を迎えた検索条件ごとに新着を探してメールを送る
+	RunDigests(now time.Time) error
+}
+
+// 1 通のメールに載せる新着の最大件数
+const maxDigestItems = 20
+
+type SavedSearchService struct {
+	repository          domainSavedSearch.Repository
+	portfolioRepository domainPortfolio.Repository
+	candidateRepository domainRecruit.Repository
+	userRepository      domainUser.IUserRepository
+	emailService        IEmailService
+	taxonomyService     ITaxonomyService
+}
+
+func NewSavedSearchService(
+	repository domainSavedSearch.Repository,
+	portfolioRepository domainPortfolio.Repository,
+	candidateRepository domainRecruit.Repository,
+	userRepository domainUser.IUserRepository,
+	emailService IEmailService,
+	taxonomyService ITaxonomyService,
+) ISavedSearchService {
+	return &SavedSearchService{
+		repository:          repository,
+		portfolioRepository: portfolioRepository,
+		candidateRepository: candidateRepository,
+		userRepository:      userRepository,
+		emailService:        emailService,
+		taxonomyService:     taxonomyService,
+	}
+}
+
+func (s *SavedSearchService) CreateSavedSearch(user *domainUser.UserModel, input dto.CreateSavedSearchInput) (*domainSavedSearch.SavedSearch, error) {
+	target := domainSavedSearch.Target(input.Target)
+	// 候補者の検索は採用担当だけが使える
+	if target == domainSavedSearch.TargetCandidates && !user.IsRecruiter() {
+		return nil, ErrForbidden
+	}
+
+	token, err := generateUnsubscribeToken()
+	if err != nil {
+		return nil, err
+	}
+	skills, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, input.Skills)
+	if err != nil {
+		return nil, err
+	}
+	search, err := domainSavedSearch.NewSavedSearch(
+		user.ID,
+		input.Name,
+		target,
+		strings.TrimSpace(input.Keyword),
+		input.Genres,
+		skills,
+		input.GraduationYear,
+		domainSavedSearch.Frequency(input.Frequency),
+		token,
+		time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.CreateSavedSearch(search); err != nil {
+		return nil, err
+	}
+	return search, nil
+}
+
+func (s *SavedSearchService) GetSavedSearches(userID uint) ([]*domainSavedSearch.SavedSearch, error) {
+	return s.repository.GetSavedSearchesByUserID(userID)
+}
+
+func (s *SavedSearchService) UpdateSavedSearch(userID uint, id uint, input dto.UpdateSavedSearchInput) (*domainSavedSearch.SavedSearch, error) {
+	search, err := s.getOwnedSavedSearch(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	skills, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, input.Skills)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := search.Update(
+		input.Name,
+		strings.TrimSpace(input.Keyword),
+		input.Genres,
+		skills,
+		input.GraduationYear,
+		domainSavedSearch.Frequency(input.Frequency),
+		now,
+	); err != nil {
+		return nil, err
+	}
+	if input.Active != nil {
+		if *input.Active {
+			search.Resubscribe(now)
+		} else {
+			search.Unsubscribe()
+		}
+	}
+	if err := s.repository.UpdateSavedSearch(search); err != nil {
+		return nil, err
+	}
+	return search, nil
+}
+
+func (s *SavedSearchService) DeleteSavedSearch(userID uint, id uint) error {
+	search, err := s.getOwnedSavedSearch(userID, id)
+	if err != nil {
+		return err
+	}
+	return s.repository.DeleteSavedSearch(search.ID)
+}
+
+func (s *SavedSearchService) Unsubscribe(token string) error {
+	search, err := s.repository.FindByUnsubscribeToken(token)
+	if err != nil {
+		return err
+	}
+	search.Unsubscribe()
+	return s.repository.UpdateSavedSearch(search)
+}
+
+// RunDigests は前回の実行以降に登録された作品・候補者を検索条件ごとに集め、1 通のメールにまとめて送ります
+// 1 件の失敗で他のユーザーへの配信が止まらないよう、エラーはログに残して続行します
+func (s *SavedSearchService) RunDigests(now time.Time) error {
+	searches, err := s.repository.GetActiveSavedSearches()
+	if err != nil {
+		return err
+	}
+	for _, search := range searches {
+		if !search.IsDue(now) {
+			continue
+		}
+		if err := s.runDigest(search, now); err != nil {
+			log.Printf("Error running saved search %d: %v", search.ID, err)
+		}
+	}
+	return nil
+}
+
+func (s *SavedSearchService) runDigest(search *domainSavedSearch.SavedSearch, now time.Time) error {
+	user, err := s.userRepository.FindByID(search.UserID)
+	if err != nil {
+		return err
+	}
+
+	var items []DigestItem
+	switch search.Target {
+	case domainSavedSearch.TargetPosts:
+		items, err = s.findNewPosts(search)
+	case domainSavedSearch.TargetCandidates:
+		// 採用担当でなくなったユーザーには候補者の情報を送らない
+		if !user.IsRecruiter() {
+			search.Unsubscribe()
+			return s.repository.UpdateSavedSearch(search)
+		}
+		items, err = s.findNewCandidates(search)
+	default:
+		err = fmt.Errorf("unknown saved search target: %s", search.Target)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(items) > 0 {
+		if len(items) > maxDigestItems {
+			items = items[:maxDigestItems]
+		}
+		if err := s.emailService.SendSavedSearchDigestEmail(user.Email, search.Name, items, unsubscribeURL(search.UnsubscribeToken)); err != nil {
+			// 送信に失敗した場合は LastRunAt を進めず、次回に再送する
+			return err
+		}
+	}
+
+	search.MarkRun(now)
+	return s.repository.UpdateSavedSearch(search)
+}
+
+func (s *SavedSearchService) findNewPosts(search *domainSavedSearch.SavedSearch) ([]DigestItem, error) {
+	posts, err := s.portfolioRepository.SearchPosts(domainPortfolio.SearchCriteria{
+		Keyword:        search.Keyword,
+		Genres:         search.Genres,
+		Skills:         search.Skills,
+		GraduationYear: search.GraduationYear,
+		PublishedAfter: search.LastRunAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	frontendURL := os.Getenv("FRONTEND_URL")
+	items := make([]DigestItem, 0, len(posts))
+	for _, p := range posts {
+		// 自分の投稿は通知しない
+		if p.UserID == search.UserID {
+			continue
+		}
+		items = append(items, DigestItem{
+			Title:   p.Title,
+			Summary: truncateRunes(p.Description, 120),
+			URL:     fmt.Sprintf("%s/Portfolio/%d", frontendURL, p.ID),
+		})
+	}
+	return items, nil
+}
+
+func (s *SavedSearchService) findNewCandidates(search *domainSavedSearch.SavedSearch) ([]DigestItem, error) {
+	candidates, err := s.candidateRepository.SearchCandidates(domainRecruit.SearchCriteria{
+		Keyword:         search.Keyword,
+		PostGenres:      search.Genres,
+		Skills:          search.Skills,
+		GraduationYear:  search.GraduationYear,
+		RegisteredAfter: search.LastRunAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	frontendURL := os.Getenv("FRONTEND_URL")
+	items := make([]DigestItem, 0, len(candidates))
+	for _, c := range candidates {
+		u := c.User
+		items = append(items, DigestItem{
+			Title:   fmt.Sprintf("%s %s（%s %s年卒）", u.LastName, u.FirstName, u.SchoolName, u.GraduationYear),
+			Summary: strings.Join(c.AllSkills(), " / "),
+			URL:     fmt.Sprintf("%s/recruit/candidates/%d", frontendURL, u.ID),
+		})
+	}
+	return items, nil
+}
+
+func (s *SavedSearchService) getOwnedSavedSearch(userID uint, id uint) (*domainSavedSearch.SavedSearch, error) {
+	search, err := s.repository.GetSavedSearchByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if search.UserID != userID {
+		return nil, ErrForbidden
+	}
+	return search, nil
+}
+
+func generateUnsubscribeToken() (string, error) {
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(tokenBytes), nil
+}
+
+func unsubscribeURL(token string) string {
+	return fmt.Sprintf("%s/saved-searches/unsubscribe?token=%s", os.Getenv("BACKEND_URL"), url.QueryEscape(token))
+}
+
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n]) + "…"
+}
@@ -0,0 +1,309 @@
+// services/site_export_service.go
+
+package services
+
+import (
+	domainMarkdown "backend/domain/markdown"
+	domainNotification "backend/domain/notification"
+	domainPortfolio "backend/domain/portfolio"
+	domainSiteExport "backend/domain/siteexport"
+	domainUser "backend/domain/user"
+	domainUserSkill "backend/domain/userskill"
+	"backend/dto"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrSiteExportInProgress = errors.New("a site export is already in progress")
+	ErrSiteExportNotReady   = errors.New("the site export is not ready for download")
+)
+
+type ISiteExportService interface {
+	// RequestExport は静的サイトの書き出しを受け付けます。ZIP はバックグラウンドのジョブ（ProcessPending）で作る
+	RequestExport(userID uint, input dto.SiteExportInput) (*domainSiteExport.Export, error)
+	// GetExports は本人の書き出し依頼を新しい順に返します
+	GetExports(userID uint) ([]*domainSiteExport.Export, error)
+	GetExport(userID, id uint) (*domainSiteExport.Export, error)
+	// GetDownload はダウンロードできる本人の書き出しを返します。ZIP の保存先は FilePath
+	GetDownload(userID, id uint) (*domainSiteExport.Export, error)
+	// ProcessPending は受け付けた書き出しを古い順に limit 件まで作り、作り終えた件数を返します
+	ProcessPending(limit int) (int, error)
+	// Purge は止まった書き出しを失敗にし、期限を過ぎた書き出しを ZIP ごと削除して、削除した件数を返します
+	Purge() (int, error)
+}
+
+type SiteExportService struct {
+	repository          domainSiteExport.Repository
+	userRepository      domainUser.IUserRepository
+	userSkillRepository domainUserSkill.Repository
+	externalLinkService IExternalLinkService
+	portfolioRepository domainPortfolio.Repository
+	markdownService     IMarkdownService
+	notificationService INotificationService
+	builder             domainSiteExport.Builder
+	dir                 string
+	siteURL             string
+	now                 func() time.Time
+}
+
+// NewSiteExportService は静的サイトの書き出しのサービスを生成します
+// dir は ZIP の保存先のディレクトリ（公開しない場所）、now は現在時刻の取得で nil なら time.Now
+func NewSiteExportService(
+	repository domainSiteExport.Repository,
+	userRepository domainUser.IUserRepository,
+	userSkillRepository domainUserSkill.Repository,
+	externalLinkService IExternalLinkService,
+	portfolioRepository domainPortfolio.Repository,
+	markdownService IMarkdownService,
+	notificationService INotificationService,
+	builder domainSiteExport.Builder,
+	dir string,
+	siteURL string,
+	now func() time.Time,
+) ISiteExportService {
+	if now == nil {
+		now = time.Now
+	}
+	return &SiteExportService{
+		repository:          repository,
+		userRepository:      userRepository,
+		userSkillRepository: userSkillRepository,
+		externalLinkService: externalLinkService,
+		portfolioRepository: portfolioRepository,
+		markdownService:     markdownService,
+		notificationService: notificationService,
+		builder:             builder,
+		dir:                 dir,
+		siteURL:             siteURL,
+		now:                 now,
+	}
+}
+
+func (s *SiteExportService) RequestExport(userID uint, input dto.SiteExportInput) (*domainSiteExport.Export, error) {
+	theme, err := domainSiteExport.ParseTheme(input.Theme)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	// 同じユーザーの書き出しは 1 件ずつ作る
+	exports, err := s.repository.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range exports {
+		if e.IsActive(now) {
+			return nil, ErrSiteExportInProgress
+		}
+	}
+	export, err := domainSiteExport.NewExport(userID, theme, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.Create(export); err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+func (s *SiteExportService) GetExports(userID uint) ([]*domainSiteExport.Export, error) {
+	return s.repository.GetByUserID(userID)
+}
+
+func (s *SiteExportService) GetExport(userID, id uint) (*domainSiteExport.Export, error) {
+	export, err := s.repository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	// 他人の書き出しは存在しないものとして扱う
+	if export.UserID != userID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return export, nil
+}
+
+func (s *SiteExportService) GetDownload(userID, id uint) (*domainSiteExport.Export, error) {
+	export, err := s.GetExport(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !export.IsDownloadable(s.now()) {
+		return nil, ErrSiteExportNotReady
+	}
+	return export, nil
+}
+
+func (s *SiteExportService) ProcessPending(limit int) (int, error) {
+	exports, err := s.repository.GetByStatus(domainSiteExport.StatusPending, limit)
+	if err != nil {
+		return 0, err
+	}
+	processed := 0
+	for _, e := range exports {
+		if err := e.Start(s.now()); err != nil {
+			continue
+		}
+		// 他のレプリカのジョブが先に拾った依頼は飛ばす
+		claimed, err := s.repository.UpdateStatus(e, domainSiteExport.StatusPending)
+		if err != nil {
+			return processed, err
+		}
+		if !claimed {
+			continue
+		}
+		if err := s.process(e); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// process は ZIP を作って結果を保存し、本人に知らせます。作れなかった場合は依頼を失敗にする
+func (s *SiteExportService) process(e *domainSiteExport.Export) error {
+	size, buildErr := s.build(e)
+	if buildErr != nil {
+		log.Printf("Error exporting site %d: %v", e.ID, buildErr)
+		if err := e.Fail(buildErr.Error(), s.now()); err != nil {
+			return err
+		}
+	} else if err := e.Complete(s.exportPath(e), size, s.now()); err != nil {
+		return err
+	}
+	if _, err := s.repository.UpdateStatus(e, domainSiteExport.StatusRunning); err != nil {
+		return err
+	}
+
+	notificationType := domainNotification.TypeSiteExportCompleted
+	title := "ポートフォリオのサイトを書き出しました"
+	body := fmt.Sprintf("%s までダウンロードできます", e.ExpiresAt.Format("2006/01/02 15:04"))
+	if buildErr != nil {
+		notificationType = domainNotification.TypeSiteExportFailed
+		title = "ポートフォリオのサイトを書き出せませんでした"
+		body = "時間をおいてもう一度お試しください"
+	}
+	if err := s.notificationService.Notify(e.UserID, notificationType, title, body, "/account"); err != nil {
+		log.Printf("Error notifying site export: %v", err)
+	}
+	return nil
+}
+
+// build は本人のプロフィールと公開中の作品（共同制作を含む）から ZIP を作り、バイト数を返します
+func (s *SiteExportService) build(e *domainSiteExport.Export) (int64, error) {
+	site, err := s.site(e.UserID)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(s.dir, os.ModePerm); err != nil {
+		return 0, fmt.Errorf("failed to create export directory: %v", err)
+	}
+	path := s.exportPath(e)
+	out, err := os.Create(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create file: %v", err)
+	}
+	if err := s.builder.Build(out, site, e.Theme); err != nil {
+		out.Close()
+		os.Remove(path)
+		return 0, err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(path)
+		return 0, fmt.Errorf("failed to save file: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// site はサイトに載せる内容を集めます。作品は新しく公開した順に並べる
+func (s *SiteExportService) site(userID uint) (*domainSiteExport.Site, error) {
+	user, err := s.userRepository.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	user.SelfIntroductionHTML = s.markdownService.RenderCached(domainMarkdown.SelfIntroductionKey(user.ID), user.SelfIntroduction)
+	skills, err := s.userSkillRepository.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.externalLinkService.GetLinks(userID)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := s.portfolioRepository.GetPostsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(posts, func(i, j int) bool {
+		a, b := posts[i].PublishedAt, posts[j].PublishedAt
+		return a != nil && (b == nil || a.After(*b))
+	})
+	for _, p := range posts {
+		p.DescriptionHTML = s.markdownService.RenderCached(domainMarkdown.PostDescriptionKey(p.ID), p.Description)
+	}
+	return &domainSiteExport.Site{
+		User:        user,
+		Skills:      skills,
+		Links:       links,
+		Posts:       posts,
+		SiteURL:     s.siteURL,
+		GeneratedAt: s.now(),
+	}, nil
+}
+
+// exportPath は依頼の ZIP の保存先です
+func (s *SiteExportService) exportPath(e *domainSiteExport.Export) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%d_%d.zip", e.UserID, e.ID))
+}
+
+func (s *SiteExportService) Purge() (int, error) {
+	now := s.now()
+	running, err := s.repository.GetByStatus(domainSiteExport.StatusRunning, 100)
+	if err != nil {
+		return 0, err
+	}
+	for _, e := range running {
+		if !e.IsStale(now) {
+			continue
+		}
+		if err := e.Fail("書き出しが時間内に終わりませんでした", now); err != nil {
+			continue
+		}
+		if _, err := s.repository.UpdateStatus(e, domainSiteExport.StatusRunning); err != nil {
+			return 0, err
+		}
+		// 作りかけの ZIP が残っていれば消す
+		removeFile(s.exportPath(e))
+	}
+
+	expired, err := s.repository.GetExpired(now)
+	if err != nil {
+		return 0, err
+	}
+	for _, e := range expired {
+		if e.FilePath != "" {
+			removeFile(e.FilePath)
+		}
+		if err := s.repository.Delete(e.ID); err != nil {
+			return 0, err
+		}
+	}
+	return len(expired), nil
+}
+
+// removeFile はファイルを削除します。すでにない場合は何もしない
+func removeFile(path string) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		log.Printf("Error removing %s: %v", path, err)
+	}
+}
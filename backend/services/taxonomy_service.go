@@ -0,0 +1,425 @@
+// services/taxonomy_service.go
+
+package services
+
+import (
+	domainAudit "backend/domain/audit"
+	domainTaxonomy "backend/domain/taxonomy"
+	"backend/dto"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ITaxonomyService interface {
+	// 入力候補用。廃止済みを除いて表示順に返す
+	GetOptions(kind domainTaxonomy.Kind) ([]*domainTaxonomy.Option, error)
+	// 前方一致・タイプミスを許した一致で入力補完の候補を返す（廃止済みは除く）
+	Suggest(kind domainTaxonomy.Kind, query string, limit int) ([]domainTaxonomy.Suggestion, error)
+	// 入力された値を正式名（別名なら読み替え先）に揃え、重複を取り除く。未登録の値はそのまま残す
+	Normalize(kind domainTaxonomy.Kind, values []string) ([]string, error)
+	// 入力された値のうち登録済み（廃止済みを除く）のものだけを正式名にして、重複なく返す
+	MatchRegistered(kind domainTaxonomy.Kind, values []string) ([]string, error)
+
+	// 以下は運営向け。操作はすべて監査ログに記録する
+	ListAllOptions(kind domainTaxonomy.Kind) ([]*domainTaxonomy.Option, error)
+	CreateOption(actor domainAudit.Actor, kind domainTaxonomy.Kind, input dto.TaxonomyOptionInput) (*domainTaxonomy.Option, error)
+	UpdateOption(actor domainAudit.Actor, kind domainTaxonomy.Kind, id uint, input dto.TaxonomyOptionInput) (*domainTaxonomy.Option, error)
+	DeleteOption(actor domainAudit.Actor, kind domainTaxonomy.Kind, id uint) error
+	// 統合先の選択肢と、書き換えたユーザー・投稿などの行数を返す
+	MergeOption(actor domainAudit.Actor, kind domainTaxonomy.Kind, sourceID, targetID uint) (*domainTaxonomy.Option, int64, error)
+	RetireOption(actor domainAudit.Actor, kind domainTaxonomy.Kind, id uint) (*domainTaxonomy.Option, error)
+	RestoreOption(actor domainAudit.Actor, kind domainTaxonomy.Kind, id uint) (*domainTaxonomy.Option, error)
+	ReorderOptions(actor domainAudit.Actor, kind domainTaxonomy.Kind, ids []uint) ([]*domainTaxonomy.Option, error)
+
+	// 未登録の選択肢だけを entries の順に末尾へ追加し、追加した件数を返す（何度実行しても結果は同じ）
+	// 登録済みの選択肢には、足りない別名と未設定の分類だけを補う
+	Seed(kind domainTaxonomy.Kind, entries []domainTaxonomy.SeedEntry) (int, error)
+	// ユーザー・投稿などに登録済みの値を正式名に揃え、書き換えた行数を返す
+	NormalizeStoredValues(kind domainTaxonomy.Kind) (int64, error)
+}
+
+var (
+	ErrDuplicateOption = errors.New("option already exists")
+	ErrInvalidMerge    = errors.New("cannot merge an option into itself or into a retired option")
+)
+
+// displayOrderStep は表示順の間隔です（間に差し込めるように 10 刻みで振る）
+const displayOrderStep = 10
+
+type TaxonomyService struct {
+	repository   domainTaxonomy.Repository
+	auditService IAuditService
+}
+
+func NewTaxonomyService(repository domainTaxonomy.Repository, auditService IAuditService) ITaxonomyService {
+	return &TaxonomyService{repository: repository, auditService: auditService}
+}
+
+func (s *TaxonomyService) GetOptions(kind domainTaxonomy.Kind) ([]*domainTaxonomy.Option, error) {
+	return s.repository.List(kind, false)
+}
+
+func (s *TaxonomyService) Suggest(kind domainTaxonomy.Kind, query string, limit int) ([]domainTaxonomy.Suggestion, error) {
+	options, err := s.repository.List(kind, false)
+	if err != nil {
+		return nil, err
+	}
+	return domainTaxonomy.Suggest(options, query, limit), nil
+}
+
+func (s *TaxonomyService) Normalize(kind domainTaxonomy.Kind, values []string) ([]string, error) {
+	if len(values) == 0 {
+		return values, nil
+	}
+	resolver, err := s.resolver(kind)
+	if err != nil {
+		return nil, err
+	}
+	return resolver.Normalize(values), nil
+}
+
+func (s *TaxonomyService) MatchRegistered(kind domainTaxonomy.Kind, values []string) ([]string, error) {
+	matched := []string{}
+	if len(values) == 0 {
+		return matched, nil
+	}
+	resolver, err := s.resolver(kind)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		option, ok := resolver.Lookup(v)
+		if !ok || option.IsRetired() || seen[option.Name] {
+			continue
+		}
+		seen[option.Name] = true
+		matched = append(matched, option.Name)
+	}
+	return matched, nil
+}
+
+func (s *TaxonomyService) ListAllOptions(kind domainTaxonomy.Kind) ([]*domainTaxonomy.Option, error) {
+	return s.repository.List(kind, true)
+}
+
+func (s *TaxonomyService) CreateOption(actor domainAudit.Actor, kind domainTaxonomy.Kind, input dto.TaxonomyOptionInput) (*domainTaxonomy.Option, error) {
+	order := 0
+	if input.DisplayOrder != nil {
+		order = *input.DisplayOrder
+	} else {
+		next, err := s.nextDisplayOrder(kind)
+		if err != nil {
+			return nil, err
+		}
+		order = next
+	}
+
+	option, err := domainTaxonomy.NewOption(kind, input.Name, order)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyOptionInput(option, input); err != nil {
+		return nil, err
+	}
+	if err := s.ensureKeysAvailable(option); err != nil {
+		return nil, err
+	}
+	if err := s.repository.Create(option); err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionOptionCreated, string(kind), option.ID,
+		map[string]interface{}{"name": option.Name, "category": option.Category, "aliases": option.Aliases})
+	return option, nil
+}
+
+// UpdateOption は名前と表示順を変更します
+// 名前を変えた場合は、ユーザー・投稿などに登録済みの値も新しい名前に書き換えます
+func (s *TaxonomyService) UpdateOption(actor domainAudit.Actor, kind domainTaxonomy.Kind, id uint, input dto.TaxonomyOptionInput) (*domainTaxonomy.Option, error) {
+	option, err := s.repository.GetByID(kind, id)
+	if err != nil {
+		return nil, err
+	}
+	before := optionSnapshot(option)
+	previousName := option.Name
+
+	if err := option.Rename(input.Name); err != nil {
+		return nil, err
+	}
+	if input.DisplayOrder != nil {
+		option.DisplayOrder = *input.DisplayOrder
+	}
+	if err := applyOptionInput(option, input); err != nil {
+		return nil, err
+	}
+	if err := s.ensureKeysAvailable(option); err != nil {
+		return nil, err
+	}
+	if err := s.repository.Update(option, previousName); err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionOptionUpdated, string(kind), option.ID,
+		domainAudit.Diff(before, optionSnapshot(option)))
+	return option, nil
+}
+
+// DeleteOption は選択肢を削除します。登録済みの値はそのまま残ります
+func (s *TaxonomyService) DeleteOption(actor domainAudit.Actor, kind domainTaxonomy.Kind, id uint) error {
+	option, err := s.repository.GetByID(kind, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repository.Delete(option); err != nil {
+		return err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionOptionDeleted, string(kind), option.ID,
+		map[string]interface{}{"name": option.Name})
+	return nil
+}
+
+// MergeOption は表記ゆれ（例: "golang"）を正式な選択肢（例: "Go"）にまとめます
+// 統合元は削除し、ユーザー・投稿・求人・保存した検索に登録済みの値を統合先の名前に書き換えます
+func (s *TaxonomyService) MergeOption(actor domainAudit.Actor, kind domainTaxonomy.Kind, sourceID, targetID uint) (*domainTaxonomy.Option, int64, error) {
+	if sourceID == targetID {
+		return nil, 0, ErrInvalidMerge
+	}
+	source, err := s.repository.GetByID(kind, sourceID)
+	if err != nil {
+		return nil, 0, err
+	}
+	target, err := s.repository.GetByID(kind, targetID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if target.IsRetired() {
+		return nil, 0, ErrInvalidMerge
+	}
+	// 統合元の名前と別名は統合先の別名として残し、以後の入力も統合先に読み替える
+	for _, alias := range append([]string{source.Name}, source.Aliases...) {
+		if err := target.AddAlias(alias); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	rewritten, err := s.repository.Merge(source, target)
+	if err != nil {
+		return nil, 0, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionOptionMerged, string(kind), target.ID,
+		map[string]interface{}{"sourceId": source.ID, "source": source.Name, "target": target.Name, "rewrittenRows": rewritten})
+	return target, rewritten, nil
+}
+
+func (s *TaxonomyService) RetireOption(actor domainAudit.Actor, kind domainTaxonomy.Kind, id uint) (*domainTaxonomy.Option, error) {
+	option, err := s.repository.GetByID(kind, id)
+	if err != nil {
+		return nil, err
+	}
+	option.Retire(time.Now())
+	if err := s.repository.Update(option, ""); err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionOptionRetired, string(kind), option.ID,
+		map[string]interface{}{"name": option.Name})
+	return option, nil
+}
+
+func (s *TaxonomyService) RestoreOption(actor domainAudit.Actor, kind domainTaxonomy.Kind, id uint) (*domainTaxonomy.Option, error) {
+	option, err := s.repository.GetByID(kind, id)
+	if err != nil {
+		return nil, err
+	}
+	option.Restore()
+	if err := s.repository.Update(option, ""); err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionOptionRestored, string(kind), option.ID,
+		map[string]interface{}{"name": option.Name})
+	return option, nil
+}
+
+// ReorderOptions は ids の順に表示順を振り直します。ids に含めなかった選択肢は今の順のまま後ろに続けます
+func (s *TaxonomyService) ReorderOptions(actor domainAudit.Actor, kind domainTaxonomy.Kind, ids []uint) ([]*domainTaxonomy.Option, error) {
+	all, err := s.repository.List(kind, true)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[uint]bool, len(all))
+	for _, o := range all {
+		known[o.ID] = true
+	}
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		if !known[id] || seen[id] {
+			return nil, gorm.ErrRecordNotFound
+		}
+		seen[id] = true
+	}
+	order := append([]uint{}, ids...)
+	for _, o := range all {
+		if !seen[o.ID] {
+			order = append(order, o.ID)
+		}
+	}
+
+	if err := s.repository.Reorder(kind, order); err != nil {
+		return nil, err
+	}
+	recordAudit(s.auditService, actor, domainAudit.ActionOptionsReordered, string(kind), 0,
+		map[string]interface{}{"ids": ids})
+	return s.repository.List(kind, true)
+}
+
+func (s *TaxonomyService) Seed(kind domainTaxonomy.Kind, entries []domainTaxonomy.SeedEntry) (int, error) {
+	next, err := s.nextDisplayOrder(kind)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	for _, entry := range entries {
+		resolver, err := s.resolver(kind)
+		if err != nil {
+			return created, err
+		}
+
+		// 既にある（廃止済み・別名を含む）名前は、運営の変更を上書きしないよう足りない情報だけを補う
+		if existing, ok := resolver.Lookup(entry.Name); ok {
+			if err := s.fillSeedEntry(existing, entry, resolver); err != nil {
+				return created, err
+			}
+			continue
+		}
+
+		option, err := domainTaxonomy.NewOption(kind, entry.Name, next)
+		if err != nil {
+			return created, err
+		}
+		if kind == domainTaxonomy.KindSkill {
+			category, err := domainTaxonomy.ParseSkillCategory(entry.Category)
+			if err != nil {
+				return created, err
+			}
+			option.Category = category
+		}
+		if err := option.SetAliases(unusedAliases(entry.Aliases, resolver)); err != nil {
+			return created, err
+		}
+		if err := s.repository.Create(option); err != nil {
+			return created, err
+		}
+		created++
+		next += displayOrderStep
+	}
+	return created, nil
+}
+
+func (s *TaxonomyService) fillSeedEntry(existing *domainTaxonomy.Option, entry domainTaxonomy.SeedEntry, resolver *domainTaxonomy.Resolver) error {
+	changed := false
+	if existing.Kind == domainTaxonomy.KindSkill && entry.Category != "" &&
+		(existing.Category == "" || existing.Category == domainTaxonomy.CategoryOther) {
+		category, err := domainTaxonomy.ParseSkillCategory(entry.Category)
+		if err != nil {
+			return err
+		}
+		if category != existing.Category {
+			existing.Category = category
+			changed = true
+		}
+	}
+	if added := unusedAliases(entry.Aliases, resolver); len(added) > 0 {
+		if err := existing.SetAliases(append(append([]string{}, existing.Aliases...), added...)); err != nil {
+			return err
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return s.repository.Update(existing, "")
+}
+
+// unusedAliases は aliases のうち、まだどの選択肢の名前・別名にもなっていないものを返します
+func unusedAliases(aliases []string, resolver *domainTaxonomy.Resolver) []string {
+	var unused []string
+	for _, a := range aliases {
+		if _, ok := resolver.Lookup(a); ok {
+			continue
+		}
+		unused = append(unused, a)
+	}
+	return unused
+}
+
+func (s *TaxonomyService) NormalizeStoredValues(kind domainTaxonomy.Kind) (int64, error) {
+	resolver, err := s.resolver(kind)
+	if err != nil {
+		return 0, err
+	}
+	return s.repository.NormalizeStoredValues(kind, resolver.Normalize)
+}
+
+func (s *TaxonomyService) resolver(kind domainTaxonomy.Kind) (*domainTaxonomy.Resolver, error) {
+	options, err := s.repository.List(kind, true)
+	if err != nil {
+		return nil, err
+	}
+	return domainTaxonomy.NewResolver(options), nil
+}
+
+func (s *TaxonomyService) nextDisplayOrder(kind domainTaxonomy.Kind) (int, error) {
+	all, err := s.repository.List(kind, true)
+	if err != nil {
+		return 0, err
+	}
+	max := 0
+	for _, o := range all {
+		if o.DisplayOrder > max {
+			max = o.DisplayOrder
+		}
+	}
+	return max + displayOrderStep, nil
+}
+
+// ensureKeysAvailable は名前・別名が、正規化したうえで他の選択肢の名前・別名と重ならないことを確認します
+func (s *TaxonomyService) ensureKeysAvailable(option *domainTaxonomy.Option) error {
+	resolver, err := s.resolver(option.Kind)
+	if err != nil {
+		return err
+	}
+	for _, k := range option.Keys() {
+		if existing, ok := resolver.Lookup(k); ok && existing.ID != option.ID {
+			return ErrDuplicateOption
+		}
+	}
+	return nil
+}
+
+// applyOptionInput は分類と別名の入力を反映します（省略された項目は変更しない）
+func applyOptionInput(option *domainTaxonomy.Option, input dto.TaxonomyOptionInput) error {
+	if input.Category != nil {
+		category, err := domainTaxonomy.ParseSkillCategory(*input.Category)
+		if err != nil {
+			return err
+		}
+		if err := option.SetCategory(category); err != nil {
+			return err
+		}
+	}
+	if input.Aliases != nil {
+		return option.SetAliases(*input.Aliases)
+	}
+	// 名前を変えた結果、同じ表記になった別名は取り除く
+	return option.SetAliases(option.Aliases)
+}
+
+func optionSnapshot(o *domainTaxonomy.Option) map[string]interface{} {
+	return map[string]interface{}{
+		"name":         o.Name,
+		"displayOrder": o.DisplayOrder,
+		"category":     o.Category,
+		"aliases":      append([]string{}, o.Aliases...),
+	}
+}
@@ -3,6 +3,10 @@
 package services
 
 import (
+	domainAudit "backend/domain/audit"
+	domainMarkdown "backend/domain/markdown"
+	domainTaxonomy "backend/domain/taxonomy"
+	domainUnitOfWork "backend/domain/unitofwork"
 	domainUser "backend/domain/user"
 	"backend/dto"
 	"fmt"
@@ -10,31 +14,67 @@ import (
 	"mime/multipart"
 	"os"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type IUserService interface {
 	GetUserByID(userID uint) (*domainUser.UserModel, error)
-	UpdateMinimumUserInfo(userID uint, input dto.MinimumUserInfoInput, files []*multipart.FileHeader) (*domainUser.UserModel, error)
+	// UpdateMinimumUserInfo・UpdatePrivacySettings の version は読み込んだときの版番号（If-Match）で、
+	// ほかの操作が先に保存していたら domainUser.ErrVersionConflict を返す。0 なら版を問わない
+	UpdateMinimumUserInfo(actor domainAudit.Actor, version int, input dto.MinimumUserInfoInput, files []*multipart.FileHeader) (*domainUser.UserModel, error)
+	UpdatePrivacySettings(actor domainAudit.Actor, version int, input dto.PrivacySettingsInput) (*domainUser.UserModel, error)
+	// GetProfile は viewerID のユーザーから見た userID の公開プロフィールを返します
+	GetProfile(viewerID, userID uint) (*domainUser.UserModel, error)
 }
 
 type UserService struct {
-	repository domainUser.IUserRepository
+	repository      domainUser.IUserRepository
+	unitOfWork      domainUnitOfWork.UnitOfWork
+	auditService    IAuditService
+	taxonomyService ITaxonomyService
+	careerService   ICareerService
+	markdownService IMarkdownService
 }
 
-func NewUserService(repository domainUser.IUserRepository) IUserService {
-	return &UserService{repository: repository}
+func NewUserService(
+	repository domainUser.IUserRepository,
+	unitOfWork domainUnitOfWork.UnitOfWork,
+	auditService IAuditService,
+	taxonomyService ITaxonomyService,
+	careerService ICareerService,
+	markdownService IMarkdownService,
+) IUserService {
+	return &UserService{
+		repository:      repository,
+		unitOfWork:      unitOfWork,
+		auditService:    auditService,
+		taxonomyService: taxonomyService,
+		careerService:   careerService,
+		markdownService: markdownService,
+	}
 }
 
 func (s *UserService) GetUserByID(userID uint) (*domainUser.UserModel, error) {
-	return s.repository.FindByID(userID)
+	user, err := s.repository.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	s.renderSelfIntroduction(user)
+	return user, nil
 }
 
-func (s *UserService) UpdateMinimumUserInfo(userID uint, input dto.MinimumUserInfoInput, files []*multipart.FileHeader) (*domainUser.UserModel, error) {
+func (s *UserService) UpdateMinimumUserInfo(actor domainAudit.Actor, version int, input dto.MinimumUserInfoInput, files []*multipart.FileHeader) (*domainUser.UserModel, error) {
 	// DBからユーザーを取得
-	user, err := s.repository.FindByID(userID)
+	user, err := s.repository.FindByID(actor.UserID)
 	if err != nil {
 		return nil, err
 	}
+	// 古い内容からの更新は、検証や画像の保存をする前に止める
+	if err := user.CheckVersion(version); err != nil {
+		return nil, err
+	}
+	before := profileSnapshot(user)
 
 	// 各フィールドが nil でなければ上書き
 	if input.FirstName != nil {
@@ -49,51 +89,144 @@ func (s *UserService) UpdateMinimumUserInfo(userID uint, input dto.MinimumUserIn
 	if input.LastNameKana != nil {
 		user.LastNameKana = *input.LastNameKana
 	}
-	if input.SchoolName != nil {
-		user.SchoolName = *input.SchoolName
-	}
-	if input.Department != nil {
-		user.Department = *input.Department
-	}
-	if input.Laboratory != nil {
-		user.Laboratory = *input.Laboratory
-	}
-	if input.GraduationYear != nil {
-		user.GraduationYear = *input.GraduationYear
+	// 学校名・卒業年などは先頭の学歴として保存し、プロフィールにはそこから求めた値を入れる
+	// 学歴の保存はすべての検証が済んでから、ユーザーと同じトランザクションで行う
+	education, err := s.careerService.ApplyProfileEducation(user, input)
+	if err != nil {
+		return nil, err
 	}
 	if input.DesiredJobTypes != nil {
 		user.DesiredJobTypes = *input.DesiredJobTypes
 	}
 	if input.Skills != nil {
-		user.Skills = *input.Skills
+		// "react" や "React.js" などの表記ゆれは登録済みのスキル名に揃える
+		skills, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, *input.Skills)
+		if err != nil {
+			return nil, err
+		}
+		user.Skills = skills
 	}
 	if input.SelfIntroduction != nil {
+		if err := domainMarkdown.Validate(*input.SelfIntroduction); err != nil {
+			return nil, err
+		}
 		user.SelfIntroduction = *input.SelfIntroduction
 	}
 
-	if len(files) > 0 {
-		fileHeader := files[0] // 一枚だけの場合
-		if fileHeader.Size > 8*1024*1024 {
-			return nil, fmt.Errorf("file %s is too large", fileHeader.Filename)
-		}
+	if len(files) > 0 && files[0].Size > 8*1024*1024 { // 一枚だけの場合
+		return nil, fmt.Errorf("file %s is too large", files[0].Filename)
+	}
 
-		// 実際の保存ロジックをこのサービス内(またはprivate関数)で呼ぶ
-		savedPath, err := saveUserImage(fileHeader)
-		if err != nil {
-			return nil, err
+	// 画像・ユーザー・学歴・スキルの一覧を 1 つのトランザクションで保存する。保存できなければ保存した画像も消す
+	err = s.unitOfWork.Do(func(tx domainUnitOfWork.Tx) error {
+		if len(files) > 0 {
+			// 実際の保存ロジックをこのサービス内(またはprivate関数)で呼ぶ
+			savedPath, err := saveUserImage(files[0])
+			if err != nil {
+				return err
+			}
+			removeOnRollback(tx, savedPath)
+			user.ProfileImageURL = savedPath
 		}
-		user.ProfileImageURL = savedPath
+		if err := tx.Users().UpdateUser(user); err != nil {
+			return err
+		}
+		if education != nil {
+			if err := saveEducation(tx.Careers(), education); err != nil {
+				return err
+			}
+		}
+		if input.Skills != nil {
+			// 残したスキルの習熟度・推薦は引き継ぎ、外したスキルは推薦ごと削除する
+			return syncUserSkillNames(tx.UserSkills(), user.ID, user.Skills)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if input.SelfIntroduction != nil {
+		s.markdownService.Invalidate(domainMarkdown.SelfIntroductionKey(user.ID))
 	}
+	s.renderSelfIntroduction(user)
 
-	// データベースに保存
-	if err := s.repository.UpdateUser(user); err != nil {
-		return nil, err
+	// 変更された項目だけを監査ログに残す
+	if changes := domainAudit.Diff(before, profileSnapshot(user)); len(changes) > 0 {
+		recordAudit(s.auditService, actor, domainAudit.ActionProfileUpdated, "user", user.ID,
+			map[string]interface{}{"changes": changes})
 	}
 
 	// 成功時、更新後の user を返す
 	return user, nil
 }
 
+// UpdatePrivacySettings はプロフィールの公開範囲と企業検索への表示可否を更新します
+func (s *UserService) UpdatePrivacySettings(actor domainAudit.Actor, version int, input dto.PrivacySettingsInput) (*domainUser.UserModel, error) {
+	user, err := s.repository.FindByID(actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := user.CheckVersion(version); err != nil {
+		return nil, err
+	}
+	before := privacySnapshot(user)
+	if err := user.UpdatePrivacy(domainUser.ProfileVisibility(input.ProfileVisibility), input.HiddenFromRecruiters); err != nil {
+		return nil, err
+	}
+	if err := s.repository.UpdateUser(user); err != nil {
+		return nil, err
+	}
+	if changes := domainAudit.Diff(before, privacySnapshot(user)); len(changes) > 0 {
+		recordAudit(s.auditService, actor, domainAudit.ActionPrivacyUpdated, "user", user.ID,
+			map[string]interface{}{"changes": changes})
+	}
+	s.renderSelfIntroduction(user)
+	return user, nil
+}
+
+func (s *UserService) GetProfile(viewerID, userID uint) (*domainUser.UserModel, error) {
+	user, err := s.repository.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsVisibleTo(viewerID) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	s.renderSelfIntroduction(user)
+	profile := user.PublicProfile()
+	return &profile, nil
+}
+
+// renderSelfIntroduction は自己紹介の Markdown を表示用の HTML に変換して設定します
+func (s *UserService) renderSelfIntroduction(user *domainUser.UserModel) {
+	user.SelfIntroductionHTML = s.markdownService.RenderCached(domainMarkdown.SelfIntroductionKey(user.ID), user.SelfIntroduction)
+}
+
+// profileSnapshot は UpdateMinimumUserInfo で変更できる項目を監査ログ用に取り出します
+func profileSnapshot(u *domainUser.UserModel) map[string]interface{} {
+	return map[string]interface{}{
+		"firstName":        u.FirstName,
+		"lastName":         u.LastName,
+		"firstNameKana":    u.FirstNameKana,
+		"lastNameKana":     u.LastNameKana,
+		"schoolName":       u.SchoolName,
+		"department":       u.Department,
+		"laboratory":       u.Laboratory,
+		"graduationYear":   u.GraduationYear,
+		"desiredJobTypes":  append([]string{}, u.DesiredJobTypes...),
+		"skills":           append([]string{}, u.Skills...),
+		"selfIntroduction": u.SelfIntroduction,
+		"profileImageUrl":  u.ProfileImageURL,
+	}
+}
+
+func privacySnapshot(u *domainUser.UserModel) map[string]interface{} {
+	return map[string]interface{}{
+		"profileVisibility":    string(u.ProfileVisibility),
+		"hiddenFromRecruiters": u.HiddenFromRecruiters,
+	}
+}
+
 func saveUserImage(fileHeader *multipart.FileHeader) (string, error) {
 	file, err := fileHeader.Open()
 	if err != nil {
@@ -120,6 +253,9 @@ func saveUserImage(fileHeader *multipart.FileHeader) (string, error) {
 	defer out.Close()
 
 	if _, err = io.Copy(out, file); err != nil {
+		// 書きかけのファイルは残さない
+		out.Close()
+		removeFile(savePath)
 		return "", fmt.Errorf("failed to save file: %v", err)
 	}
 
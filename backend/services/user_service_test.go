@@ -0,0 +1,123 @@
+// backend/services/user_service_test.go
+package services
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	domainAudit "backend/domain/audit"
+	domainCareer "backend/domain/career"
+	domainMarkdown "backend/domain/markdown"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	careerInfra "backend/infrastructure/career"
+	unitOfWorkInfra "backend/infrastructure/unitofwork"
+	userInfra "backend/infrastructure/user"
+	"backend/migrations"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	m, err := migrations.New(db)
+	if err != nil {
+		t.Fatalf("migrator: %v", err)
+	}
+	if _, err := m.Up(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+// --- 読み込んだ直後にほかの操作がユーザーを保存したことにするリポジトリ ---
+type racingUserRepo struct {
+	domainUser.IUserRepository
+	db *gorm.DB
+}
+
+func (r *racingUserRepo) FindByID(id uint) (*domainUser.UserModel, error) {
+	user, err := r.IUserRepository.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	err = r.db.Exec("UPDATE user_models SET version = version + 1 WHERE id = ?", id).Error
+	return user, err
+}
+
+// newProfileTestService は学歴「A大学」のあるユーザーと、プロフィールを更新する UserService を用意します
+func newProfileTestService(t *testing.T, race bool) (IUserService, domainCareer.Repository, *domainUser.UserModel) {
+	t.Helper()
+	db := openTestDB(t)
+	userRepo := userInfra.NewUserRepository(db)
+	careerRepo := careerInfra.NewCareerRepo(db)
+	if err := userRepo.CreateUser(&domainUser.UserModel{Email: "a@example.com"}); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	user, err := userRepo.FindUserByEmail("a@example.com")
+	if err != nil {
+		t.Fatalf("FindUserByEmail: %v", err)
+	}
+	education, err := domainCareer.NewEducation(user.ID, domainCareer.DegreeBachelor, "A大学", "", "", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := careerRepo.CreateEducation(education); err != nil {
+		t.Fatalf("CreateEducation: %v", err)
+	}
+
+	var repo domainUser.IUserRepository = userRepo
+	if race {
+		repo = &racingUserRepo{IUserRepository: userRepo, db: db}
+	}
+	unitOfWork := unitOfWorkInfra.NewUnitOfWork(db)
+	careerService := NewCareerService(careerRepo, unitOfWork, fakeAuditService{}, fakeTaxonomyService{})
+	svc := NewUserService(repo, unitOfWork, fakeAuditService{}, fakeTaxonomyService{}, careerService, nil)
+	return svc, careerRepo, user
+}
+
+func assertSchoolName(t *testing.T, repo domainCareer.Repository, userID uint, want string) {
+	t.Helper()
+	educations, err := repo.GetEducationsByUserID(userID)
+	if err != nil {
+		t.Fatalf("GetEducationsByUserID: %v", err)
+	}
+	if len(educations) != 1 || educations[0].SchoolName != want {
+		t.Errorf("educations = %+v, want only %q", educations, want)
+	}
+}
+
+// --- テスト: 自己紹介の検証で断られたら学歴も変えない ---
+func TestUserService_UpdateMinimumUserInfoKeepsEducationOnValidationError(t *testing.T) {
+	svc, careerRepo, user := newProfileTestService(t, false)
+
+	school := "B大学"
+	intro := strings.Repeat("あ", domainMarkdown.MaxSourceLength+1)
+	_, err := svc.UpdateMinimumUserInfo(domainAudit.Actor{UserID: user.ID}, user.Version,
+		dto.MinimumUserInfoInput{SchoolName: &school, SelfIntroduction: &intro}, nil)
+	if err == nil {
+		t.Fatal("expected the self introduction to be rejected")
+	}
+	assertSchoolName(t, careerRepo, user.ID, "A大学")
+}
+
+// --- テスト: 保存する直前にほかの操作と重なったら学歴も変えない ---
+func TestUserService_UpdateMinimumUserInfoKeepsEducationOnVersionConflict(t *testing.T) {
+	svc, careerRepo, user := newProfileTestService(t, true)
+
+	school := "B大学"
+	_, err := svc.UpdateMinimumUserInfo(domainAudit.Actor{UserID: user.ID}, user.Version,
+		dto.MinimumUserInfoInput{SchoolName: &school}, nil)
+	if !errors.Is(err, domainUser.ErrVersionConflict) {
+		t.Fatalf("UpdateMinimumUserInfo = %v, want ErrVersionConflict", err)
+	}
+	assertSchoolName(t, careerRepo, user.ID, "A大学")
+}
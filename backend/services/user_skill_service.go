@@ -0,0 +1,307 @@
+// services/user_skill_service.go
+
+package services
+
+import (
+	domainAudit "backend/domain/audit"
+	domainNotification "backend/domain/notification"
+	domainPortfolio "backend/domain/portfolio"
+	domainTaxonomy "backend/domain/taxonomy"
+	domainUnitOfWork "backend/domain/unitofwork"
+	domainUser "backend/domain/user"
+	domainUserSkill "backend/domain/userskill"
+	"backend/dto"
+	"errors"
+	"fmt"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrAlreadyEndorsed  = errors.New("skill already endorsed")
+	ErrDuplicateSkill   = errors.New("the same skill is listed more than once")
+	ErrInvalidSkillPost = errors.New("linked post does not belong to the user")
+)
+
+type IUserSkillService interface {
+	// GetSkills は viewerID のユーザーから見た userID のスキルを推薦の多い順に返します
+	GetSkills(viewerID, userID uint) ([]*domainUserSkill.UserSkill, error)
+	// UpdateSkills はスキルの一覧を置き換え、保存後のスキルとプロフィールの版番号を返します
+	UpdateSkills(actor domainAudit.Actor, version int, input dto.UpdateUserSkillsInput) ([]*domainUserSkill.UserSkill, int, error)
+	Endorse(endorserID, userID, skillID uint) (*domainUserSkill.UserSkill, error)
+	WithdrawEndorsement(endorserID, userID, skillID uint) (*domainUserSkill.UserSkill, error)
+}
+
+type UserSkillService struct {
+	repository          domainUserSkill.Repository
+	userRepository      domainUser.IUserRepository
+	portfolioRepository domainPortfolio.Repository
+	unitOfWork          domainUnitOfWork.UnitOfWork
+	notificationService INotificationService
+	auditService        IAuditService
+	taxonomyService     ITaxonomyService
+}
+
+func NewUserSkillService(
+	repository domainUserSkill.Repository,
+	userRepository domainUser.IUserRepository,
+	portfolioRepository domainPortfolio.Repository,
+	unitOfWork domainUnitOfWork.UnitOfWork,
+	notificationService INotificationService,
+	auditService IAuditService,
+	taxonomyService ITaxonomyService,
+) IUserSkillService {
+	return &UserSkillService{
+		repository:          repository,
+		userRepository:      userRepository,
+		portfolioRepository: portfolioRepository,
+		unitOfWork:          unitOfWork,
+		notificationService: notificationService,
+		auditService:        auditService,
+		taxonomyService:     taxonomyService,
+	}
+}
+
+func (s *UserSkillService) GetSkills(viewerID, userID uint) ([]*domainUserSkill.UserSkill, error) {
+	user, err := s.userRepository.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsVisibleTo(viewerID) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	skills, err := s.repository.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachPosts(userID, skills); err != nil {
+		return nil, err
+	}
+	if viewerID != userID {
+		if err := s.markEndorsed(viewerID, skills); err != nil {
+			return nil, err
+		}
+	}
+	domainUserSkill.SortByEndorsements(skills)
+	return skills, nil
+}
+
+// UpdateSkills はスキルの一覧を習熟度・経験年数・作品ごと置き換えます
+// 一覧から外したスキルの推薦は削除され、UserModel.Skills も同じ並びに更新します
+func (s *UserSkillService) UpdateSkills(actor domainAudit.Actor, version int, input dto.UpdateUserSkillsInput) ([]*domainUserSkill.UserSkill, int, error) {
+	user, err := s.userRepository.FindByID(actor.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+	// UserModel.Skills も書き換えるので、古いプロフィールからの更新は検証の前に止める
+	if err := user.CheckVersion(version); err != nil {
+		return nil, 0, err
+	}
+
+	names := make([]string, len(input.Skills))
+	for i, in := range input.Skills {
+		names[i] = in.Name
+	}
+	// 表記ゆれを揃えた結果、同じスキルが 2 回現れる場合は指定ミスとして扱う
+	normalized, err := s.taxonomyService.Normalize(domainTaxonomy.KindSkill, names)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(normalized) != len(names) {
+		return nil, 0, ErrDuplicateSkill
+	}
+
+	ownPosts, err := s.ownPostIDs(user.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	existing, err := s.repository.GetByUserID(user.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+	skills, removed, err := domainUserSkill.Reconcile(user.ID, existing, normalized)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i, in := range input.Skills {
+		level, err := domainUserSkill.ParseLevel(in.Level)
+		if err != nil {
+			return nil, 0, err
+		}
+		if err := skills[i].SetProficiency(level, in.YearsOfExperience); err != nil {
+			return nil, 0, err
+		}
+		for _, id := range in.PostIDs {
+			if !ownPosts[id] {
+				return nil, 0, ErrInvalidSkillPost
+			}
+		}
+		if err := skills[i].SetPosts(in.PostIDs); err != nil {
+			return nil, 0, err
+		}
+	}
+	// スキルの一覧とプロフィールのスキル名は 1 つのトランザクションで揃える
+	before := profileSnapshot(user)
+	user.Skills = normalized
+	err = s.unitOfWork.Do(func(tx domainUnitOfWork.Tx) error {
+		if err := tx.UserSkills().Save(skills, removed); err != nil {
+			return err
+		}
+		return tx.Users().UpdateUser(user)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if changes := domainAudit.Diff(before, profileSnapshot(user)); len(changes) > 0 {
+		recordAudit(s.auditService, actor, domainAudit.ActionProfileUpdated, "user", user.ID,
+			map[string]interface{}{"changes": changes})
+	}
+
+	saved, err := s.GetSkills(user.ID, user.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return saved, user.Version, nil
+}
+
+// syncUserSkillNames はプロフィール更新で UserModel.Skills が変わったときにスキルの一覧を揃えます
+// プロフィールと同じトランザクションで保存できるよう、リポジトリは呼び出し側から受け取る
+func syncUserSkillNames(repo domainUserSkill.Repository, userID uint, names []string) error {
+	existing, err := repo.GetByUserID(userID)
+	if err != nil {
+		return err
+	}
+	skills, removed, err := domainUserSkill.Reconcile(userID, existing, names)
+	if err != nil {
+		return err
+	}
+	return repo.Save(skills, removed)
+}
+
+// Endorse は他のユーザーのスキルを推薦し、スキルの持ち主に通知します
+func (s *UserSkillService) Endorse(endorserID, userID, skillID uint) (*domainUserSkill.UserSkill, error) {
+	skill, err := s.getVisibleSkill(endorserID, userID, skillID)
+	if err != nil {
+		return nil, err
+	}
+	endorsement, err := domainUserSkill.NewEndorsement(skill, endorserID)
+	if err != nil {
+		return nil, err
+	}
+	endorsed, err := s.repository.HasEndorsed(skill.ID, endorserID)
+	if err != nil {
+		return nil, err
+	}
+	if endorsed {
+		return nil, ErrAlreadyEndorsed
+	}
+	if err := s.repository.CreateEndorsement(endorsement); err != nil {
+		return nil, err
+	}
+	skill.EndorsementCount++
+	skill.Endorsed = true
+
+	if err := s.notificationService.Notify(
+		skill.UserID,
+		domainNotification.TypeSkillEndorsed,
+		fmt.Sprintf("スキル「%s」が推薦されました", skill.Name),
+		"",
+		fmt.Sprintf("/users/%d", skill.UserID),
+	); err != nil {
+		log.Printf("Error notifying skill endorsement: %v", err)
+	}
+	return skill, nil
+}
+
+func (s *UserSkillService) WithdrawEndorsement(endorserID, userID, skillID uint) (*domainUserSkill.UserSkill, error) {
+	skill, err := s.getVisibleSkill(endorserID, userID, skillID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.DeleteEndorsement(skill.ID, endorserID); err != nil {
+		return nil, err
+	}
+	skill.EndorsementCount--
+	skill.Endorsed = false
+	return skill, nil
+}
+
+// getVisibleSkill は URL の userID と一致し、閲覧者から見えるスキルだけを返します
+func (s *UserSkillService) getVisibleSkill(viewerID, userID, skillID uint) (*domainUserSkill.UserSkill, error) {
+	skill, err := s.repository.GetByID(skillID)
+	if err != nil {
+		return nil, err
+	}
+	if skill.UserID != userID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	owner, err := s.userRepository.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !owner.IsVisibleTo(viewerID) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return skill, nil
+}
+
+// attachPosts は PostIDs を表示用の作品概要に解決します
+// 非表示・削除済みの作品は一覧から外します（紐付け自体は残す）
+func (s *UserSkillService) attachPosts(userID uint, skills []*domainUserSkill.UserSkill) error {
+	posts, err := s.portfolioRepository.GetPostsByUserID(userID)
+	if err != nil {
+		return err
+	}
+	byID := make(map[uint]*domainPortfolio.Post, len(posts))
+	for _, p := range posts {
+		byID[p.ID] = p
+	}
+	for _, skill := range skills {
+		skill.Posts = []domainUserSkill.DemoPost{}
+		for _, id := range skill.PostIDs {
+			p, ok := byID[id]
+			if !ok {
+				continue
+			}
+			demo := domainUserSkill.DemoPost{ID: p.ID, Title: p.Title}
+			if len(p.Images) > 0 {
+				demo.ThumbnailURL = p.Images[0].URL
+			}
+			skill.Posts = append(skill.Posts, demo)
+		}
+	}
+	return nil
+}
+
+func (s *UserSkillService) markEndorsed(viewerID uint, skills []*domainUserSkill.UserSkill) error {
+	ids := make([]uint, len(skills))
+	for i, skill := range skills {
+		ids[i] = skill.ID
+	}
+	endorsed, err := s.repository.EndorsedSkillIDs(viewerID, ids)
+	if err != nil {
+		return err
+	}
+	set := make(map[uint]bool, len(endorsed))
+	for _, id := range endorsed {
+		set[id] = true
+	}
+	for _, skill := range skills {
+		skill.Endorsed = set[skill.ID]
+	}
+	return nil
+}
+
+func (s *UserSkillService) ownPostIDs(userID uint) (map[uint]bool, error) {
+	posts, err := s.portfolioRepository.GetPostsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[uint]bool, len(posts))
+	for _, p := range posts {
+		ids[p.ID] = true
+	}
+	return ids, nil
+}
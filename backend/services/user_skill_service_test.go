@@ -0,0 +1,61 @@
+// backend/services/user_skill_service_test.go
+package services
+
+import (
+	"errors"
+	"testing"
+
+	domainAudit "backend/domain/audit"
+	domainUser "backend/domain/user"
+	"backend/dto"
+	portfolioInfra "backend/infrastructure/portfolio"
+	unitOfWorkInfra "backend/infrastructure/unitofwork"
+	userInfra "backend/infrastructure/user"
+	userSkillInfra "backend/infrastructure/userskill"
+)
+
+// --- テスト: 古い版番号からのスキル更新は断り、スキルもプロフィールも変えない ---
+func TestUserSkillService_UpdateSkillsRejectsStaleVersion(t *testing.T) {
+	db := openTestDB(t)
+	userRepo := userInfra.NewUserRepository(db)
+	skillRepo := userSkillInfra.NewUserSkillRepo(db)
+	if err := userRepo.CreateUser(&domainUser.UserModel{Email: "a@example.com"}); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	user, err := userRepo.FindUserByEmail("a@example.com")
+	if err != nil {
+		t.Fatalf("FindUserByEmail: %v", err)
+	}
+	svc := NewUserSkillService(skillRepo, userRepo, portfolioInfra.NewPostRepo(db),
+		unitOfWorkInfra.NewUnitOfWork(db), nil, fakeAuditService{}, fakeTaxonomyService{})
+
+	input := dto.UpdateUserSkillsInput{Skills: []dto.UserSkillInput{{Name: "Go"}}}
+	_, _, err = svc.UpdateSkills(domainAudit.Actor{UserID: user.ID}, user.Version+1, input)
+	if !errors.Is(err, domainUser.ErrVersionConflict) {
+		t.Fatalf("UpdateSkills = %v, want ErrVersionConflict", err)
+	}
+
+	skills, err := skillRepo.GetByUserID(user.ID)
+	if err != nil {
+		t.Fatalf("GetByUserID: %v", err)
+	}
+	if len(skills) != 0 {
+		t.Errorf("skills = %+v, want none", skills)
+	}
+	after, err := userRepo.FindByID(user.ID)
+	if err != nil {
+		t.Fatalf("FindByID: %v", err)
+	}
+	if after.Version != user.Version || len(after.Skills) != 0 {
+		t.Errorf("user = version %d skills %v, want version %d and no skills", after.Version, after.Skills, user.Version)
+	}
+
+	// 今の版番号なら保存でき、版番号が 1 つ進む
+	_, version, err := svc.UpdateSkills(domainAudit.Actor{UserID: user.ID}, user.Version, input)
+	if err != nil {
+		t.Fatalf("UpdateSkills: %v", err)
+	}
+	if version != user.Version+1 {
+		t.Errorf("version = %d, want %d", version, user.Version+1)
+	}
+}